@@ -0,0 +1,5 @@
+BEGIN;
+
+UPDATE auth.users SET rights = array_remove(rights, 'manage_questions');
+
+END;
@@ -0,0 +1,8 @@
+BEGIN;
+
+UPDATE auth.users
+SET rights = array_append(rights, 'manage_questions')
+WHERE ('admin' = ANY(rights) OR 'mentor' = ANY(rights))
+    AND NOT ('manage_questions' = ANY(rights));
+
+END;
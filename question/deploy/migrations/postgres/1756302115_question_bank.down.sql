@@ -0,0 +1,6 @@
+BEGIN;
+
+ALTER TABLE kvs.questions DROP COLUMN IF EXISTS is_active;
+ALTER TABLE kvs.topics DROP COLUMN IF EXISTS is_active;
+
+END;
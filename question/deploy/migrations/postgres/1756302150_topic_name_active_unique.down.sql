@@ -0,0 +1,7 @@
+BEGIN;
+
+DROP INDEX IF EXISTS kvs.topics_name_active_idx;
+
+ALTER TABLE kvs.topics ADD CONSTRAINT topics_name_key UNIQUE (name);
+
+END;
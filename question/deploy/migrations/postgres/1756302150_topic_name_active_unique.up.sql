@@ -0,0 +1,10 @@
+BEGIN;
+
+-- topics are deleted softly, so the name has to be unique among active topics only,
+-- otherwise the name of a deleted topic could never be used again
+ALTER TABLE kvs.topics DROP CONSTRAINT IF EXISTS topics_name_key;
+
+CREATE UNIQUE INDEX IF NOT EXISTS topics_name_active_idx
+    ON kvs.topics (name) WHERE is_active;
+
+END;
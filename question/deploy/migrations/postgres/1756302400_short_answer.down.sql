@@ -0,0 +1,9 @@
+BEGIN;
+
+DELETE FROM kvs.questions
+WHERE question_type_id IN (SELECT id FROM kvs.question_types WHERE name = 'short answer');
+DELETE FROM kvs.question_types WHERE name = 'short answer';
+
+ALTER TABLE kvs.questions DROP COLUMN IF EXISTS options;
+
+END;
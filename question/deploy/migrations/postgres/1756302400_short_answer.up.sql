@@ -0,0 +1,7 @@
+BEGIN;
+
+ALTER TABLE kvs.questions ADD COLUMN IF NOT EXISTS options JSONB NOT NULL DEFAULT '{}';
+
+INSERT INTO kvs.question_types (name) VALUES ('short answer') ON CONFLICT (name) DO NOTHING;
+
+END;
@@ -0,0 +1,7 @@
+BEGIN;
+
+DELETE FROM kvs.questions
+WHERE question_type_id IN (SELECT id FROM kvs.question_types WHERE name = 'ordering');
+DELETE FROM kvs.question_types WHERE name = 'ordering';
+
+END;
@@ -0,0 +1,5 @@
+BEGIN;
+
+INSERT INTO kvs.question_types (name) VALUES ('matching') ON CONFLICT (name) DO NOTHING;
+
+END;
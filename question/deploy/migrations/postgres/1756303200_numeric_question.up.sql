@@ -0,0 +1,5 @@
+BEGIN;
+
+INSERT INTO kvs.question_types (name) VALUES ('numeric') ON CONFLICT (name) DO NOTHING;
+
+END;
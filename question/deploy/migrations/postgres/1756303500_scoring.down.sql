@@ -0,0 +1,6 @@
+BEGIN;
+
+ALTER TABLE kvs.sessions DROP COLUMN IF EXISTS policy;
+ALTER TABLE kvs.questions DROP COLUMN IF EXISTS weight;
+
+END;
@@ -0,0 +1,11 @@
+BEGIN;
+
+ALTER TABLE kvs.questions ADD COLUMN IF NOT EXISTS weight DOUBLE PRECISION NOT NULL DEFAULT 1
+    CHECK (weight > 0);
+
+ALTER TABLE kvs.sessions ADD COLUMN IF NOT EXISTS policy JSONB;
+
+-- sessions completed before scoring strategies were introduced were graded all or nothing
+UPDATE kvs.sessions SET policy = '{"scoring": {"strategy": "all or nothing"}}' WHERE policy IS NULL;
+
+END;
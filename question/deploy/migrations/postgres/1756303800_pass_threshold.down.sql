@@ -0,0 +1,6 @@
+BEGIN;
+
+ALTER TABLE kvs.sessions DROP COLUMN IF EXISTS pass_threshold;
+ALTER TABLE kvs.topics DROP COLUMN IF EXISTS pass_threshold;
+
+END;
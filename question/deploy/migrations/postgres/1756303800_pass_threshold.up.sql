@@ -0,0 +1,11 @@
+BEGIN;
+
+ALTER TABLE kvs.topics ADD COLUMN IF NOT EXISTS pass_threshold DOUBLE PRECISION NOT NULL DEFAULT 60
+    CHECK (pass_threshold >= 0 AND pass_threshold <= 100);
+
+ALTER TABLE kvs.sessions ADD COLUMN IF NOT EXISTS pass_threshold DOUBLE PRECISION;
+
+-- sessions completed before thresholds became configurable were graded against 60 percents
+UPDATE kvs.sessions SET pass_threshold = 60 WHERE state = 'completed state' AND pass_threshold IS NULL;
+
+END;
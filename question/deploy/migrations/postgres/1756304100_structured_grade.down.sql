@@ -0,0 +1,10 @@
+BEGIN;
+
+DROP INDEX IF EXISTS kvs.sessions_outcome_idx;
+
+ALTER TABLE kvs.sessions DROP COLUMN IF EXISTS outcome;
+ALTER TABLE kvs.sessions DROP COLUMN IF EXISTS total_count;
+ALTER TABLE kvs.sessions DROP COLUMN IF EXISTS correct_count;
+ALTER TABLE kvs.sessions DROP COLUMN IF EXISTS score;
+
+END;
@@ -0,0 +1,33 @@
+BEGIN;
+
+ALTER TABLE kvs.sessions ADD COLUMN IF NOT EXISTS score DOUBLE PRECISION;
+ALTER TABLE kvs.sessions ADD COLUMN IF NOT EXISTS correct_count INTEGER;
+ALTER TABLE kvs.sessions ADD COLUMN IF NOT EXISTS total_count INTEGER;
+ALTER TABLE kvs.sessions ADD COLUMN IF NOT EXISTS outcome TEXT
+    CHECK (outcome IN ('passed', 'failed', 'expired'));
+
+UPDATE kvs.sessions
+SET
+    score = CASE
+        WHEN comment ~ '^[0-9]+(\.[0-9]+)? percents$'
+            THEN split_part(comment, ' ', 1)::DOUBLE PRECISION
+        ELSE 0
+    END,
+    total_count = COALESCE(cardinality(questions), 0),
+    outcome = CASE
+        WHEN COALESCE(is_expired, FALSE) THEN 'expired'
+        WHEN COALESCE(is_passed, FALSE) THEN 'passed'
+        ELSE 'failed'
+    END
+WHERE state = 'completed state' AND outcome IS NULL;
+
+-- sessions completed before scoring strategies were graded all or nothing with equal weights,
+-- so the number of correct answers follows from the score
+UPDATE kvs.sessions
+SET correct_count = round(score * total_count / 100)::INTEGER
+WHERE state = 'completed state' AND correct_count IS NULL;
+
+CREATE INDEX IF NOT EXISTS sessions_outcome_idx ON kvs.sessions (outcome)
+    WHERE state = 'completed state';
+
+END;
@@ -0,0 +1,12 @@
+BEGIN;
+
+DROP INDEX IF EXISTS kvs.sessions_session_id_updated_at_idx;
+DROP INDEX IF EXISTS kvs.sessions_completed_session_id_uniq;
+
+INSERT INTO kvs.sessions
+SELECT backup.* FROM kvs.sessions_duplicate_completions backup
+ON CONFLICT (id) DO NOTHING;
+
+DROP TABLE IF EXISTS kvs.sessions_duplicate_completions;
+
+END;
@@ -0,0 +1,28 @@
+BEGIN;
+
+-- duplicate completions are kept in a backup table, so the down migration can restore them
+CREATE TABLE IF NOT EXISTS kvs.sessions_duplicate_completions (LIKE kvs.sessions);
+
+INSERT INTO kvs.sessions_duplicate_completions
+SELECT duplicate.*
+FROM kvs.sessions duplicate
+WHERE duplicate.state = 'completed state'
+    AND EXISTS (
+        SELECT 1 FROM kvs.sessions origin
+        WHERE origin.state = 'completed state'
+            AND origin.session_id = duplicate.session_id
+            AND origin.id < duplicate.id
+    );
+
+-- a session is completed only once: keep the first completion of sessions completed twice
+DELETE FROM kvs.sessions duplicate
+USING kvs.sessions_duplicate_completions backup
+WHERE duplicate.id = backup.id;
+
+CREATE UNIQUE INDEX IF NOT EXISTS sessions_completed_session_id_uniq ON kvs.sessions (session_id)
+    WHERE state = 'completed state';
+
+CREATE INDEX IF NOT EXISTS sessions_session_id_updated_at_idx
+    ON kvs.sessions (session_id, updated_at DESC);
+
+END;
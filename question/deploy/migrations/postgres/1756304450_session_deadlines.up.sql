@@ -0,0 +1,23 @@
+BEGIN;
+
+-- deadlines of running sessions: the expiry sweeper reads this table instead of looking for the
+-- latest state of every session, so its cost depends on running sessions only
+CREATE TABLE IF NOT EXISTS kvs.session_deadlines (
+    session_id TEXT PRIMARY KEY,
+    deadline TIMESTAMP NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS session_deadlines_deadline_idx ON kvs.session_deadlines (deadline);
+
+INSERT INTO kvs.session_deadlines (session_id, deadline)
+SELECT latest.session_id,
+    latest.created_at + latest.duration_limit / 1000 * INTERVAL '1 microsecond'
+FROM (
+    SELECT DISTINCT ON (s.session_id) s.session_id, s.state, s.created_at, s.duration_limit
+    FROM kvs.sessions s
+    ORDER BY s.session_id, s.updated_at DESC
+) latest
+WHERE latest.state = 'active state'
+ON CONFLICT (session_id) DO NOTHING;
+
+END;
@@ -0,0 +1,5 @@
+BEGIN;
+
+DROP TABLE IF EXISTS kvs.session_drafts;
+
+END;
@@ -0,0 +1,11 @@
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS kvs.session_drafts (
+    session_id TEXT NOT NULL,
+    question_id TEXT NOT NULL,
+    answers TEXT[] NOT NULL DEFAULT '{}',
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (session_id, question_id)
+);
+
+END;
@@ -0,0 +1,8 @@
+BEGIN;
+
+ALTER TABLE kvs.sessions DROP COLUMN IF EXISTS pause_count;
+ALTER TABLE kvs.sessions DROP COLUMN IF EXISTS resumed_at;
+ALTER TABLE kvs.sessions DROP COLUMN IF EXISTS elapsed;
+ALTER TABLE kvs.topics DROP COLUMN IF EXISTS max_pauses;
+
+END;
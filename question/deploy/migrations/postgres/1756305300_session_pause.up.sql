@@ -0,0 +1,11 @@
+BEGIN;
+
+ALTER TABLE kvs.topics ADD COLUMN IF NOT EXISTS max_pauses INTEGER NOT NULL DEFAULT 0
+    CHECK (max_pauses >= 0);
+
+-- elapsed keeps active time in nanoseconds accumulated before resumed_at, like duration_limit
+ALTER TABLE kvs.sessions ADD COLUMN IF NOT EXISTS elapsed BIGINT NOT NULL DEFAULT 0;
+ALTER TABLE kvs.sessions ADD COLUMN IF NOT EXISTS resumed_at TIMESTAMP;
+ALTER TABLE kvs.sessions ADD COLUMN IF NOT EXISTS pause_count INTEGER NOT NULL DEFAULT 0;
+
+END;
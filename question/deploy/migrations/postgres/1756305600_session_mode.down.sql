@@ -0,0 +1,5 @@
+BEGIN;
+
+ALTER TABLE kvs.sessions DROP COLUMN IF EXISTS mode;
+
+END;
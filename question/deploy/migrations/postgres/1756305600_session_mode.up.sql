@@ -0,0 +1,7 @@
+BEGIN;
+
+-- sessions created before modes were introduced are exams
+ALTER TABLE kvs.sessions ADD COLUMN IF NOT EXISTS mode VARCHAR(32) NOT NULL DEFAULT 'exam'
+    CHECK (mode IN ('exam', 'practice'));
+
+END;
@@ -0,0 +1,5 @@
+BEGIN;
+
+ALTER TABLE kvs.questions DROP COLUMN IF EXISTS explanation;
+
+END;
@@ -0,0 +1,12 @@
+BEGIN;
+
+ALTER TABLE kvs.questions ADD COLUMN IF NOT EXISTS explanation TEXT NOT NULL DEFAULT '';
+
+-- completed rows used to get duration_limit from the column sequence, the review needs the
+-- limit the session was active with
+UPDATE kvs.sessions c SET duration_limit = a.duration_limit
+FROM kvs.sessions a
+WHERE c.state = 'completed state' AND a.session_id = c.session_id
+    AND a.state IN ('active state', 'paused state');
+
+END;
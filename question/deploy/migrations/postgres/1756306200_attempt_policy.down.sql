@@ -0,0 +1,6 @@
+BEGIN;
+
+DROP TABLE IF EXISTS kvs.user_timezones;
+DROP TABLE IF EXISTS kvs.attempt_grants;
+
+END;
@@ -0,0 +1,20 @@
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS kvs.attempt_grants (
+    id SERIAL PRIMARY KEY,
+    student_id TEXT NOT NULL,
+    topic TEXT NOT NULL,
+    attempts INTEGER NOT NULL CHECK (attempts > 0),
+    granted_by TEXT NOT NULL,
+    granted_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_attempt_grants_student_id ON kvs.attempt_grants (student_id);
+
+CREATE TABLE IF NOT EXISTS kvs.user_timezones (
+    user_id TEXT PRIMARY KEY,
+    timezone TEXT NOT NULL,
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+END;
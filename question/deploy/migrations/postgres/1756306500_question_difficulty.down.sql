@@ -0,0 +1,6 @@
+BEGIN;
+
+ALTER TABLE kvs.topics DROP COLUMN IF EXISTS selection_strategy;
+ALTER TABLE kvs.questions DROP COLUMN IF EXISTS difficulty;
+
+END;
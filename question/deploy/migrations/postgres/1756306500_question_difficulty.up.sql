@@ -0,0 +1,9 @@
+BEGIN;
+
+-- existing questions are of medium difficulty and topics keep random selection
+ALTER TABLE kvs.questions ADD COLUMN IF NOT EXISTS difficulty VARCHAR(16) NOT NULL
+    DEFAULT 'medium' CHECK (difficulty IN ('easy', 'medium', 'hard'));
+ALTER TABLE kvs.topics ADD COLUMN IF NOT EXISTS selection_strategy VARCHAR(16) NOT NULL
+    DEFAULT 'uniform' CHECK (selection_strategy IN ('uniform', 'mixed', 'adaptive'));
+
+END;
@@ -0,0 +1,6 @@
+BEGIN;
+
+DROP INDEX IF EXISTS kvs.idx_answer_outcomes_user_id;
+DROP TABLE IF EXISTS kvs.answer_outcomes;
+
+END;
@@ -0,0 +1,18 @@
+BEGIN;
+
+-- correctness of every answer is stored on session completion, so repetition cards are built
+-- without recovering sessions; sessions completed earlier are backfilled at startup
+CREATE TABLE IF NOT EXISTS kvs.answer_outcomes (
+    session_id TEXT NOT NULL,
+    user_id TEXT NOT NULL,
+    question_id INTEGER NOT NULL,
+    topic TEXT NOT NULL,
+    is_correct BOOLEAN NOT NULL,
+    mode VARCHAR(32) NOT NULL,
+    completed_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (session_id, question_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_answer_outcomes_user_id ON kvs.answer_outcomes (user_id);
+
+END;
@@ -0,0 +1,5 @@
+BEGIN;
+
+DROP INDEX IF EXISTS kvs.sessions_completed_user_id_idx;
+
+END;
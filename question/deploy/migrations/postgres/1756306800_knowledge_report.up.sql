@@ -0,0 +1,7 @@
+BEGIN;
+
+-- reports count sessions of one user, answers are aggregated from kvs.answer_outcomes
+CREATE INDEX IF NOT EXISTS sessions_completed_user_id_idx ON kvs.sessions (user_id)
+    WHERE state = 'completed state';
+
+END;
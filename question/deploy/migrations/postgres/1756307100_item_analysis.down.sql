@@ -0,0 +1,6 @@
+BEGIN;
+
+DROP INDEX IF EXISTS kvs.sessions_completed_exam_idx;
+DROP TABLE IF EXISTS kvs.item_statistics;
+
+END;
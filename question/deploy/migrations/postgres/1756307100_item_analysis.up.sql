@@ -0,0 +1,22 @@
+BEGIN;
+
+-- item statistics are recomputed by the item analysis job and replaced per topic
+CREATE TABLE IF NOT EXISTS kvs.item_statistics (
+    question_id INTEGER PRIMARY KEY,
+    topic_id INTEGER NOT NULL,
+    responses INTEGER NOT NULL,
+    correct INTEGER NOT NULL,
+    omitted INTEGER NOT NULL,
+    difficulty DOUBLE PRECISION NOT NULL,
+    discrimination DOUBLE PRECISION NOT NULL,
+    distractors JSONB NOT NULL DEFAULT '[]',
+    flags TEXT[] NOT NULL DEFAULT '{}',
+    computed_at TIMESTAMP NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_item_statistics_topic_id ON kvs.item_statistics (topic_id);
+
+CREATE INDEX IF NOT EXISTS sessions_completed_exam_idx ON kvs.sessions USING GIN (topics)
+    WHERE state = 'completed state' AND mode = 'exam';
+
+END;
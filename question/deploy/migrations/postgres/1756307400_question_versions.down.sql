@@ -0,0 +1,7 @@
+BEGIN;
+
+ALTER TABLE kvs.sessions DROP COLUMN IF EXISTS question_versions;
+DROP TABLE IF EXISTS kvs.question_versions;
+ALTER TABLE kvs.questions DROP COLUMN IF EXISTS version;
+
+END;
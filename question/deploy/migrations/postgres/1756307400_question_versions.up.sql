@@ -0,0 +1,36 @@
+BEGIN;
+
+-- every edit of a question adds a version, sessions reference the versions they were graded
+-- with; kvs.questions keeps the current version for the question bank and new sessions
+ALTER TABLE kvs.questions ADD COLUMN IF NOT EXISTS version INTEGER NOT NULL DEFAULT 1;
+
+CREATE TABLE IF NOT EXISTS kvs.question_versions (
+    question_id INTEGER NOT NULL REFERENCES kvs.questions(question_id),
+    version INTEGER NOT NULL,
+    question_type_id INTEGER NOT NULL REFERENCES kvs.question_types(id),
+    subject VARCHAR(255) NOT NULL,
+    variants TEXT[] NOT NULL,
+    correct_answers TEXT[] NOT NULL,
+    options JSONB NOT NULL DEFAULT '{}',
+    weight DOUBLE PRECISION NOT NULL CHECK (weight > 0),
+    explanation TEXT NOT NULL DEFAULT '',
+    difficulty VARCHAR(16) NOT NULL CHECK (difficulty IN ('easy', 'medium', 'hard')),
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (question_id, version)
+);
+
+INSERT INTO kvs.question_versions (question_id, version, question_type_id, subject, variants,
+    correct_answers, options, weight, explanation, difficulty)
+SELECT question_id, version, question_type_id, subject, variants, correct_answers, options,
+    weight, explanation, difficulty
+FROM kvs.questions
+ON CONFLICT (question_id, version) DO NOTHING;
+
+-- question_versions follows the order of questions; existing sessions were graded with the only
+-- version the questions had
+ALTER TABLE kvs.sessions ADD COLUMN IF NOT EXISTS question_versions INTEGER[];
+
+UPDATE kvs.sessions SET question_versions = array_fill(1, ARRAY[cardinality(questions)])
+WHERE questions IS NOT NULL AND question_versions IS NULL;
+
+END;
@@ -0,0 +1,6 @@
+BEGIN;
+
+DROP TABLE IF EXISTS kvs.rescore_jobs;
+DROP TABLE IF EXISTS kvs.session_question_versions;
+
+END;
@@ -0,0 +1,40 @@
+BEGIN;
+
+-- versions of the questions of completed sessions: sessions to rescore are found by the index
+-- instead of unnesting questions of every completed session
+CREATE TABLE IF NOT EXISTS kvs.session_question_versions (
+    session_id TEXT NOT NULL,
+    question_id INTEGER NOT NULL,
+    version INTEGER NOT NULL,
+    PRIMARY KEY (session_id, question_id)
+);
+
+CREATE INDEX IF NOT EXISTS session_question_versions_question_idx
+    ON kvs.session_question_versions (question_id, session_id, version);
+
+INSERT INTO kvs.session_question_versions (session_id, question_id, version)
+SELECT s.session_id, sv.question_id, COALESCE(sv.version, 1)
+FROM kvs.sessions s, unnest(s.questions, s.question_versions) AS sv(question_id, version)
+WHERE s.state = 'completed state' AND sv.question_id IS NOT NULL
+ON CONFLICT (session_id, question_id) DO NOTHING;
+
+-- rescoring of the question runs in the background; the task keeps its progress, so it resumes
+-- after a restart from the last rescored session
+CREATE TABLE IF NOT EXISTS kvs.rescore_jobs (
+    question_id INTEGER PRIMARY KEY REFERENCES kvs.questions(question_id),
+    version INTEGER NOT NULL,
+    status VARCHAR(16) NOT NULL
+        CHECK (status IN ('pending', 'running', 'completed', 'failed')),
+    last_session_id TEXT NOT NULL DEFAULT '',
+    rescored INTEGER NOT NULL DEFAULT 0,
+    failed INTEGER NOT NULL DEFAULT 0,
+    attempts INTEGER NOT NULL DEFAULT 0,
+    error TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS rescore_jobs_unfinished_idx ON kvs.rescore_jobs (updated_at)
+    WHERE status IN ('pending', 'running');
+
+END;
@@ -0,0 +1,29 @@
+BEGIN;
+
+-- result of the completed session is stored as it was graded, history is served from it in one
+-- query and does not change with questions and thresholds; only rescoring rewrites the result.
+-- Sessions completed before this migration get their results from the backfill of the service
+CREATE TABLE IF NOT EXISTS kvs.session_results (
+    session_id TEXT PRIMARY KEY,
+    user_id TEXT NOT NULL,
+    mode VARCHAR(32) NOT NULL,
+    topics TEXT[] NOT NULL,
+    started_at TIMESTAMP NOT NULL,
+    completed_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    is_expired BOOLEAN NOT NULL,
+    is_passed BOOLEAN NOT NULL,
+    grade TEXT NOT NULL,
+    score DOUBLE PRECISION NOT NULL,
+    pass_threshold DOUBLE PRECISION NOT NULL,
+    correct_count INTEGER NOT NULL,
+    total_count INTEGER NOT NULL,
+    outcome VARCHAR(16) NOT NULL,
+    topic_scores JSONB NOT NULL DEFAULT '[]',
+    items JSONB NOT NULL DEFAULT '[]',
+    rescored_at TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS session_results_user_id_completed_at_idx
+    ON kvs.session_results (user_id, completed_at DESC, session_id DESC);
+
+END;
@@ -0,0 +1,6 @@
+BEGIN;
+
+DROP INDEX IF EXISTS kvs.session_results_topics_idx;
+DROP INDEX IF EXISTS kvs.session_results_user_id_score_idx;
+
+END;
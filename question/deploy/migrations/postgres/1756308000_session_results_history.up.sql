@@ -0,0 +1,11 @@
+BEGIN;
+
+-- the history is paged by a cursor over the sort columns, session_id makes the order unique;
+-- sorting by date uses session_results_user_id_completed_at_idx
+CREATE INDEX IF NOT EXISTS session_results_user_id_score_idx
+    ON kvs.session_results (user_id, score DESC, completed_at DESC, session_id DESC);
+
+CREATE INDEX IF NOT EXISTS session_results_topics_idx
+    ON kvs.session_results USING GIN (topics);
+
+END;
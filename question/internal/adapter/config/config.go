@@ -96,3 +96,161 @@ func (cfg *Config) GetNatsURL() string {
 func (cfg *Config) GetNatsSubject() string {
 	return cfg.viper.GetString("nats.subject")
 }
+
+func (cfg *Config) GetScoringStrategy() string {
+	strategy := cfg.viper.GetString("kvs.scoring.strategy")
+	if strategy == "" {
+		return "all or nothing"
+	}
+
+	return strategy
+}
+
+// GetNegativeMarkingPenalty returns nil when the penalty is not configured, so an explicit
+// zero penalty is kept.
+func (cfg *Config) GetNegativeMarkingPenalty() *float64 {
+	if !cfg.viper.IsSet("kvs.scoring.penalty") {
+		return nil
+	}
+
+	penalty := cfg.viper.GetFloat64("kvs.scoring.penalty")
+	return &penalty
+}
+
+func (cfg *Config) GetExpirySweepInterval() time.Duration {
+	return cfg.viper.GetDuration("kvs.expiry_sweeper.interval")
+}
+
+func (cfg *Config) GetExpirySweepBatchSize() int {
+	return cfg.viper.GetInt("kvs.expiry_sweeper.batch_size")
+}
+
+func (cfg *Config) GetItemAnalysisInterval() time.Duration {
+	return cfg.viper.GetDuration("kvs.item_analysis.interval")
+}
+
+func (cfg *Config) GetRescoreInterval() time.Duration {
+	return cfg.viper.GetDuration("kvs.rescore.interval")
+}
+
+func (cfg *Config) GetRescoreBatchSize() int {
+	return cfg.viper.GetInt("kvs.rescore.batch_size")
+}
+
+func (cfg *Config) GetPassThresholdResolution() string {
+	resolution := cfg.viper.GetString("kvs.pass_threshold.resolution")
+	if resolution == "" {
+		return "strictest"
+	}
+
+	return resolution
+}
+
+func (cfg *Config) GetReviewVisibility() string {
+	visibility := cfg.viper.GetString("kvs.review.visibility")
+	if visibility == "" {
+		return "immediately"
+	}
+
+	return visibility
+}
+
+// GetMaxAttempts returns number of attempts of a topic per window, 0 means unlimited.
+func (cfg *Config) GetMaxAttempts() int {
+	if !cfg.viper.IsSet("kvs.attempts.max_attempts") {
+		return 1
+	}
+
+	return cfg.viper.GetInt("kvs.attempts.max_attempts")
+}
+
+// GetAttemptsWindow returns rolling window attempts are counted in, 0 means calendar day.
+func (cfg *Config) GetAttemptsWindow() time.Duration {
+	return cfg.viper.GetDuration("kvs.attempts.window")
+}
+
+func (cfg *Config) GetAttemptsCooldown() time.Duration {
+	return cfg.viper.GetDuration("kvs.attempts.cooldown")
+}
+
+// GetTimezoneChangeInterval returns time the user waits between changes of own timezone, 0 means
+// no limit. It is 30 days if not set.
+func (cfg *Config) GetTimezoneChangeInterval() time.Duration {
+	if !cfg.viper.IsSet("kvs.attempts.timezone_change_interval") {
+		return 30 * 24 * time.Hour
+	}
+
+	return cfg.viper.GetDuration("kvs.attempts.timezone_change_interval")
+}
+
+func (cfg *Config) GetUnlimitedAttemptsAfterPass() bool {
+	return cfg.viper.GetBool("kvs.attempts.unlimited_after_pass")
+}
+
+// GetShuffleVariants tells whether sessions show variants in a random order, variants are
+// shuffled unless disabled.
+func (cfg *Config) GetShuffleVariants() bool {
+	if !cfg.viper.IsSet("kvs.shuffle.variants") {
+		return true
+	}
+
+	return cfg.viper.GetBool("kvs.shuffle.variants")
+}
+
+// GetShuffleQuestions tells whether sessions show questions in a random order.
+func (cfg *Config) GetShuffleQuestions() bool {
+	return cfg.viper.GetBool("kvs.shuffle.questions")
+}
+
+// GetQuestionsPerTopic returns how many questions of every topic sessions consist of, 0 means
+// the service default.
+func (cfg *Config) GetQuestionsPerTopic() int {
+	return cfg.viper.GetInt("kvs.question_selection.questions_per_topic")
+}
+
+// GetDifficultyMix returns percents of easy, medium and hard questions of the mixed selection.
+func (cfg *Config) GetDifficultyMix() (int, int, int) {
+	if !cfg.viper.IsSet("kvs.question_selection.difficulty_mix") {
+		return 30, 50, 20
+	}
+
+	return cfg.viper.GetInt("kvs.question_selection.difficulty_mix.easy"),
+		cfg.viper.GetInt("kvs.question_selection.difficulty_mix.medium"),
+		cfg.viper.GetInt("kvs.question_selection.difficulty_mix.hard")
+}
+
+// GetLeitnerIntervals returns repetition intervals of the Leitner boxes, empty intervals mean
+// the service default.
+func (cfg *Config) GetLeitnerIntervals() ([]time.Duration, error) {
+	raw := cfg.viper.GetStringSlice("kvs.spaced_repetition.box_intervals")
+
+	intervals := make([]time.Duration, 0, len(raw))
+	for _, item := range raw {
+		interval, err := time.ParseDuration(item)
+		if err != nil {
+			return nil, errors.Wrapf(ErrConfig, "invalid box interval %s: %v",
+				item, err)
+		}
+		intervals = append(intervals, interval)
+	}
+
+	return intervals, nil
+}
+
+// GetReviewSessionSize returns the maximum number of questions of review sessions, 0 means the
+// service default.
+func (cfg *Config) GetReviewSessionSize() int {
+	return cfg.viper.GetInt("kvs.spaced_repetition.review_session_size")
+}
+
+// GetReportFailedQuestionsLimit returns how many failed questions knowledge reports list, 0 means
+// the service default.
+func (cfg *Config) GetReportFailedQuestionsLimit() int {
+	return cfg.viper.GetInt("kvs.report.failed_questions_limit")
+}
+
+// GetReportTrendDepth returns how many recent exam sessions the score trend is determined by, 0
+// means the service default.
+func (cfg *Config) GetReportTrendDepth() int {
+	return cfg.viper.GetInt("kvs.report.trend_depth")
+}
@@ -42,13 +42,18 @@ func (p *Publisher) SessionFinishedEvent(ctx context.Context,
 	event := dto.EventDTO{
 		EventType: SessionFinishedEventType,
 		Payload: dto.PayloadDTO{
-			UserID:      sessionResult.UserID,
-			Topics:      sessionResult.Topics,
-			Questions:   sessionResult.Questions,
-			UserAnswers: sessionResult.UserAnswers,
-			IsExpire:    sessionResult.IsExpire,
-			IsSuccess:   sessionResult.IsSuccess,
-			Grade:       sessionResult.Grade,
+			UserID:       sessionResult.UserID,
+			Topics:       sessionResult.Topics,
+			Questions:    sessionResult.Questions,
+			UserAnswers:  sessionResult.UserAnswers,
+			IsExpire:     sessionResult.IsExpire,
+			IsSuccess:    sessionResult.IsSuccess,
+			Grade:        sessionResult.Grade,
+			Score:        sessionResult.Score,
+			CorrectCount: sessionResult.CorrectCount,
+			TotalCount:   sessionResult.TotalCount,
+			Outcome:      string(sessionResult.Outcome),
+			TopicScores:  dto.NewTopicScoresDTO(sessionResult.TopicScores),
 		},
 	}
 
@@ -0,0 +1,169 @@
+package postgres
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/pkg/errors"
+
+	"github.com/parta4ok/kvs/question/internal/entities"
+)
+
+// GetAttempts returns completed exam sessions of the user sharing a topic with the requested
+// ones. Practice sessions are not attempts.
+func (s *Storage) GetAttempts(ctx context.Context, userID string, topics []string) (
+	[]entities.Attempt, error) {
+	slog.Info("GetAttempts started")
+
+	query := `
+	SELECT s.topics, s.updated_at, s.outcome
+	FROM kvs.sessions s
+	WHERE s.user_id = $1 AND s.state = 'completed state' AND s.mode = 'exam'
+	AND $2::text[] && s.topics
+	ORDER BY s.updated_at;`
+
+	rows, err := s.db.Query(ctx, query, userID, topics)
+	if err != nil {
+		err := errors.Wrapf(entities.ErrInternal, "get attempts failure: %v", err)
+		slog.Error(err.Error())
+		return nil, err
+	}
+	defer rows.Close()
+
+	attempts := make([]entities.Attempt, 0)
+	for rows.Next() {
+		var (
+			attemptTopics []string
+			completedAt   time.Time
+			outcome       string
+		)
+
+		if err := rows.Scan(&attemptTopics, &completedAt, &outcome); err != nil {
+			err := errors.Wrapf(entities.ErrInternal, "scan attempt failure: %v", err)
+			slog.Error(err.Error())
+			return nil, err
+		}
+
+		attempts = append(attempts, entities.Attempt{
+			Topics:      attemptTopics,
+			CompletedAt: completedAt,
+			Outcome:     entities.Outcome(outcome),
+		})
+	}
+
+	slog.Info("GetAttempts completed")
+	return attempts, nil
+}
+
+func (s *Storage) GetAttemptGrants(ctx context.Context, userID string, topics []string,
+	since time.Time) ([]entities.AttemptGrant, error) {
+	slog.Info("GetAttemptGrants started")
+
+	query := `
+	SELECT g.topic, g.attempts, g.granted_by, g.granted_at
+	FROM kvs.attempt_grants g
+	WHERE g.student_id = $1 AND g.topic = ANY($2) AND g.granted_at >= $3
+	ORDER BY g.granted_at;`
+
+	rows, err := s.db.Query(ctx, query, userID, topics, since)
+	if err != nil {
+		err := errors.Wrapf(entities.ErrInternal, "get attempt grants failure: %v", err)
+		slog.Error(err.Error())
+		return nil, err
+	}
+	defer rows.Close()
+
+	grants := make([]entities.AttemptGrant, 0)
+	for rows.Next() {
+		var grant entities.AttemptGrant
+		if err := rows.Scan(&grant.Topic, &grant.Attempts, &grant.GrantedBy,
+			&grant.GrantedAt); err != nil {
+			err := errors.Wrapf(entities.ErrInternal, "scan attempt grant failure: %v", err)
+			slog.Error(err.Error())
+			return nil, err
+		}
+
+		grants = append(grants, grant)
+	}
+
+	slog.Info("GetAttemptGrants completed")
+	return grants, nil
+}
+
+func (s *Storage) StoreAttemptGrant(ctx context.Context, studentID string,
+	grant entities.AttemptGrant) error {
+	slog.Info("StoreAttemptGrant started")
+
+	if err := s.checkTopics(ctx, []string{grant.Topic}); err != nil {
+		slog.Error(err.Error())
+		return err
+	}
+
+	query := `
+	INSERT INTO kvs.attempt_grants (student_id, topic, attempts, granted_by, granted_at)
+	VALUES ($1, $2, $3, $4, $5);`
+
+	if _, err := s.db.Exec(ctx, query, studentID, grant.Topic, grant.Attempts, grant.GrantedBy,
+		grant.GrantedAt); err != nil {
+		err := errors.Wrapf(entities.ErrInternal, "store attempt grant failure: %v", err)
+		slog.Error(err.Error())
+		return err
+	}
+
+	slog.Info("StoreAttemptGrant completed")
+	return nil
+}
+
+func (s *Storage) GetUserTimezone(ctx context.Context, userID string) (string, error) {
+	slog.Info("GetUserTimezone started")
+
+	query := `SELECT t.timezone FROM kvs.user_timezones t WHERE t.user_id = $1;`
+
+	var timezone string
+	if err := s.db.QueryRow(ctx, query, userID).Scan(&timezone); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			slog.Info("GetUserTimezone completed")
+			return "", nil
+		}
+		err := errors.Wrapf(entities.ErrInternal, "get user timezone failure: %v", err)
+		slog.Error(err.Error())
+		return "", err
+	}
+
+	slog.Info("GetUserTimezone completed")
+	return timezone, nil
+}
+
+// StoreUserTimezone saves the timezone unless it was changed after changedBefore. The check and
+// the change are one statement, so concurrent requests can not both pass the check.
+func (s *Storage) StoreUserTimezone(ctx context.Context, userID string, timezone string,
+	changedBefore *time.Time) error {
+	slog.Info("StoreUserTimezone started")
+
+	query := `
+	INSERT INTO kvs.user_timezones (user_id, timezone, updated_at) VALUES ($1, $2, $4)
+	ON CONFLICT (user_id) DO UPDATE SET timezone = EXCLUDED.timezone,
+	updated_at = CASE WHEN kvs.user_timezones.timezone = EXCLUDED.timezone
+		THEN kvs.user_timezones.updated_at ELSE EXCLUDED.updated_at END
+	WHERE $3::TIMESTAMP IS NULL OR kvs.user_timezones.timezone = EXCLUDED.timezone
+		OR kvs.user_timezones.updated_at <= $3::TIMESTAMP;`
+
+	tag, err := s.db.Exec(ctx, query, userID, timezone, changedBefore, time.Now().UTC())
+	if err != nil {
+		err := errors.Wrapf(entities.ErrInternal, "store user timezone failure: %v", err)
+		slog.Error(err.Error())
+		return err
+	}
+
+	if tag.RowsAffected() == 0 {
+		err := errors.Wrapf(entities.ErrConflict, "timezone of user %s was changed recently",
+			userID)
+		slog.Warn(err.Error())
+		return err
+	}
+
+	slog.Info("StoreUserTimezone completed")
+	return nil
+}
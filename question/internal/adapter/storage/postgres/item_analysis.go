@@ -0,0 +1,301 @@
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"strconv"
+
+	"github.com/pkg/errors"
+
+	"github.com/parta4ok/kvs/question/internal/entities"
+	"github.com/parta4ok/kvs/question/pkg/dto"
+)
+
+func (s *Storage) GetTopicIDs(ctx context.Context) ([]string, error) {
+	slog.Info("GetTopicIDs started")
+
+	query := `SELECT t.topic_id FROM kvs.topics t WHERE t.is_active ORDER BY t.topic_id;`
+
+	rows, err := s.db.Query(ctx, query)
+	if err != nil {
+		err := errors.Wrapf(entities.ErrInternal, "get topic ids failure: %v", err)
+		slog.Error(err.Error())
+		return nil, err
+	}
+	defer rows.Close()
+
+	topicIDs := make([]string, 0)
+	for rows.Next() {
+		var topicID int64
+		if err := rows.Scan(&topicID); err != nil {
+			err := errors.Wrapf(entities.ErrInternal, "scan topic id failure: %v", err)
+			slog.Error(err.Error())
+			return nil, err
+		}
+		topicIDs = append(topicIDs, strconv.FormatInt(topicID, 10))
+	}
+
+	if err := rows.Err(); err != nil {
+		err := errors.Wrapf(entities.ErrInternal, "rows err: %v", err)
+		slog.Error(err.Error())
+		return nil, err
+	}
+
+	slog.Info("GetTopicIDs completed")
+	return topicIDs, nil
+}
+
+// GetExamResponses returns answers of completed exam sessions which include the topic.
+func (s *Storage) GetExamResponses(ctx context.Context, topicID string) (
+	[]entities.ExamResponses, error) {
+	slog.Info("GetExamResponses started")
+
+	if err := s.checkID(topicID, "topic"); err != nil {
+		return nil, err
+	}
+
+	query := `
+	SELECT s.session_id, s.score, s.questions, s.answers
+	FROM kvs.sessions s
+	WHERE s.state = 'completed state' AND s.mode = 'exam' AND s.score IS NOT NULL
+	AND s.topics @> ARRAY(SELECT t.name::TEXT FROM kvs.topics t WHERE t.topic_id = $1::INTEGER)
+	ORDER BY s.session_id;`
+
+	rows, err := s.db.Query(ctx, query, topicID)
+	if err != nil {
+		err := errors.Wrapf(entities.ErrInternal, "get exam responses failure: %v", err)
+		slog.Error(err.Error())
+		return nil, err
+	}
+	defer rows.Close()
+
+	responses := make([]entities.ExamResponses, 0)
+	for rows.Next() {
+		var (
+			exam       entities.ExamResponses
+			answersRaw []byte
+		)
+
+		if err := rows.Scan(&exam.SessionID, &exam.Score, &exam.QuestionIDs,
+			&answersRaw); err != nil {
+			err := errors.Wrapf(entities.ErrInternal, "scan exam responses failure: %v", err)
+			slog.Error(err.Error())
+			return nil, err
+		}
+
+		var answersListDTO dto.UserAnswersListDTO
+		if err := json.Unmarshal(answersRaw, &answersListDTO); err != nil {
+			err = errors.Wrapf(entities.ErrInternal, "unmarshaling failure: %v", err)
+			slog.Error(err.Error())
+			return nil, err
+		}
+
+		exam.Answers = make([]*entities.UserAnswer, 0, len(answersListDTO.AnswersList))
+		for _, answerDTO := range answersListDTO.AnswersList {
+			answer, err := entities.NewUserAnswer(answerDTO.QuestionID, answerDTO.Answers)
+			if err != nil {
+				err = errors.Wrap(err, "creating user answer failure")
+				slog.Error(err.Error())
+				return nil, err
+			}
+			exam.Answers = append(exam.Answers, answer)
+		}
+
+		responses = append(responses, exam)
+	}
+
+	if err := rows.Err(); err != nil {
+		err := errors.Wrapf(entities.ErrInternal, "rows err: %v", err)
+		slog.Error(err.Error())
+		return nil, err
+	}
+
+	slog.Info("GetExamResponses completed")
+	return responses, nil
+}
+
+// StoreItemStatistics replaces statistics of the topic. A question moved from another topic
+// takes its statistics along, so they are overwritten.
+func (s *Storage) StoreItemStatistics(ctx context.Context, topicID string,
+	statistics []entities.ItemStatistics) error {
+	slog.Info("StoreItemStatistics started")
+
+	if err := s.checkID(topicID, "topic"); err != nil {
+		return err
+	}
+
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		err = errors.Wrapf(entities.ErrInternal, "begin transaction failure: %v", err)
+		slog.Error(err.Error())
+		return err
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	if _, err := tx.Exec(ctx, `DELETE FROM kvs.item_statistics WHERE topic_id = $1::INTEGER;`,
+		topicID); err != nil {
+		err = errors.Wrapf(entities.ErrInternal, "delete item statistics failure: %v", err)
+		slog.Error(err.Error())
+		return err
+	}
+
+	query := `
+	INSERT INTO kvs.item_statistics (question_id, topic_id, responses, correct, omitted,
+	difficulty, discrimination, distractors, flags, computed_at)
+	VALUES ($1::INTEGER, $2::INTEGER, $3, $4, $5, $6, $7, $8, $9, $10)
+	ON CONFLICT (question_id) DO UPDATE SET topic_id = EXCLUDED.topic_id,
+	responses = EXCLUDED.responses, correct = EXCLUDED.correct, omitted = EXCLUDED.omitted,
+	difficulty = EXCLUDED.difficulty, discrimination = EXCLUDED.discrimination,
+	distractors = EXCLUDED.distractors, flags = EXCLUDED.flags,
+	computed_at = EXCLUDED.computed_at;`
+
+	for _, stats := range statistics {
+		distractors, err := s.encodeDistractors(stats.Distractors)
+		if err != nil {
+			slog.Error(err.Error())
+			return err
+		}
+
+		flags := make([]string, 0, len(stats.Flags))
+		for _, flag := range stats.Flags {
+			flags = append(flags, string(flag))
+		}
+
+		if _, err := tx.Exec(ctx, query, stats.QuestionID, topicID, stats.Responses,
+			stats.Correct, stats.Omitted, stats.Difficulty, stats.Discrimination, distractors,
+			flags, stats.ComputedAt); err != nil {
+			err = errors.Wrapf(entities.ErrInternal, "store item statistics failure: %v", err)
+			slog.Error(err.Error())
+			return err
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		err = errors.Wrapf(entities.ErrInternal, "commit transaction failure: %v", err)
+		slog.Error(err.Error())
+		return err
+	}
+
+	slog.Info("StoreItemStatistics completed")
+	return nil
+}
+
+// GetItemStatistics returns statistics of active questions of the topic.
+func (s *Storage) GetItemStatistics(ctx context.Context, topicID string) (
+	[]entities.ItemStatistics, error) {
+	slog.Info("GetItemStatistics started")
+
+	if err := s.checkID(topicID, "topic"); err != nil {
+		return nil, err
+	}
+
+	query := `
+	SELECT st.question_id, t.name, q.subject, qt.name, st.responses, st.correct, st.omitted,
+	st.difficulty, st.discrimination, st.distractors, st.flags, st.computed_at
+	FROM kvs.item_statistics st
+	JOIN kvs.questions q ON q.question_id = st.question_id
+	JOIN kvs.question_types qt ON q.question_type_id = qt.id
+	JOIN kvs.topics t ON t.topic_id = st.topic_id
+	WHERE st.topic_id = $1::INTEGER AND q.is_active
+	ORDER BY st.question_id;`
+
+	rows, err := s.db.Query(ctx, query, topicID)
+	if err != nil {
+		err := errors.Wrapf(entities.ErrInternal, "get item statistics failure: %v", err)
+		slog.Error(err.Error())
+		return nil, err
+	}
+	defer rows.Close()
+
+	statistics := make([]entities.ItemStatistics, 0)
+	for rows.Next() {
+		var (
+			questionID     int64
+			questionType   string
+			distractorsRaw []byte
+			flags          []string
+			stats          entities.ItemStatistics
+		)
+
+		if err := rows.Scan(&questionID, &stats.Topic, &stats.Subject, &questionType,
+			&stats.Responses, &stats.Correct, &stats.Omitted, &stats.Difficulty,
+			&stats.Discrimination, &distractorsRaw, &flags, &stats.ComputedAt); err != nil {
+			err := errors.Wrapf(entities.ErrInternal, "scan item statistics failure: %v", err)
+			slog.Error(err.Error())
+			return nil, err
+		}
+
+		stats.QuestionID = strconv.FormatInt(questionID, 10)
+
+		stats.Type, err = entities.ParseQuestionType(questionType)
+		if err != nil {
+			err := errors.Wrapf(entities.ErrInternal, "parse question type failure: %v", err)
+			slog.Error(err.Error())
+			return nil, err
+		}
+
+		stats.Distractors, err = s.decodeDistractors(distractorsRaw)
+		if err != nil {
+			slog.Error(err.Error())
+			return nil, err
+		}
+
+		for _, flag := range flags {
+			stats.Flags = append(stats.Flags, entities.ItemFlag(flag))
+		}
+
+		statistics = append(statistics, stats)
+	}
+
+	if err := rows.Err(); err != nil {
+		err := errors.Wrapf(entities.ErrInternal, "rows err: %v", err)
+		slog.Error(err.Error())
+		return nil, err
+	}
+
+	slog.Info("GetItemStatistics completed")
+	return statistics, nil
+}
+
+func (s *Storage) encodeDistractors(distractors []entities.DistractorStats) ([]byte, error) {
+	distractorsDTO := make([]dto.DistractorStatsDTO, 0, len(distractors))
+	for _, distractor := range distractors {
+		distractorsDTO = append(distractorsDTO, dto.DistractorStatsDTO{
+			Variant:    distractor.Variant,
+			IsCorrect:  distractor.IsCorrect,
+			Selections: distractor.Selections,
+			Share:      distractor.Share,
+		})
+	}
+
+	raw, err := json.Marshal(distractorsDTO)
+	if err != nil {
+		return nil, errors.Wrapf(entities.ErrInternal, "marshal distractors failure: %v", err)
+	}
+
+	return raw, nil
+}
+
+func (s *Storage) decodeDistractors(raw []byte) ([]entities.DistractorStats, error) {
+	var distractorsDTO []dto.DistractorStatsDTO
+	if err := json.Unmarshal(raw, &distractorsDTO); err != nil {
+		return nil, errors.Wrapf(entities.ErrInternal, "unmarshal distractors failure: %v", err)
+	}
+
+	if len(distractorsDTO) == 0 {
+		return nil, nil
+	}
+
+	distractors := make([]entities.DistractorStats, 0, len(distractorsDTO))
+	for _, distractorDTO := range distractorsDTO {
+		distractors = append(distractors, entities.DistractorStats{
+			Variant:    distractorDTO.Variant,
+			IsCorrect:  distractorDTO.IsCorrect,
+			Selections: distractorDTO.Selections,
+			Share:      distractorDTO.Share,
+		})
+	}
+
+	return distractors, nil
+}
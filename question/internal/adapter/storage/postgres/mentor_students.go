@@ -0,0 +1,118 @@
+package postgres
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/pkg/errors"
+
+	"github.com/parta4ok/kvs/question/internal/entities"
+)
+
+// IsMentorOf tells whether the mentor is linked to the student. The link is linked_id of the
+// student in auth.users, the same column the auth service keeps the mentor of the user in.
+func (s *Storage) IsMentorOf(ctx context.Context, mentorID string, studentID string) (bool, error) {
+	slog.Info("IsMentorOf started")
+
+	query := `
+	SELECT EXISTS (
+		SELECT 1 FROM auth.users u
+		WHERE u.uid = $2 AND u.linked_id = $1 AND u.linked_id <> ''
+	);`
+
+	var linked bool
+	if err := s.db.QueryRow(ctx, query, mentorID, studentID).Scan(&linked); err != nil {
+		err := errors.Wrapf(entities.ErrInternal, "check mentor student link failure: %v", err)
+		slog.Error(err.Error())
+		return false, err
+	}
+
+	slog.Info("IsMentorOf completed")
+	return linked, nil
+}
+
+// LinkStudent makes the mentor the mentor of the student, the previous mentor of the student
+// loses the link.
+func (s *Storage) LinkStudent(ctx context.Context, mentorID string, studentID string) error {
+	slog.Info("LinkStudent started")
+
+	query := `
+	UPDATE auth.users SET linked_id = $1
+	WHERE uid = $2 AND EXISTS (SELECT 1 FROM auth.users m WHERE m.uid = $1);`
+
+	tag, err := s.db.Exec(ctx, query, mentorID, studentID)
+	if err != nil {
+		err := errors.Wrapf(entities.ErrInternal, "link student failure: %v", err)
+		slog.Error(err.Error())
+		return err
+	}
+
+	if tag.RowsAffected() == 0 {
+		err := errors.Wrapf(entities.ErrNotFound, "mentor %s or student %s", mentorID, studentID)
+		slog.Error(err.Error())
+		return err
+	}
+
+	slog.Info("LinkStudent completed")
+	return nil
+}
+
+func (s *Storage) UnlinkStudent(ctx context.Context, mentorID string, studentID string) error {
+	slog.Info("UnlinkStudent started")
+
+	query := `UPDATE auth.users SET linked_id = '' WHERE uid = $2 AND linked_id = $1 AND $1 <> '';`
+
+	tag, err := s.db.Exec(ctx, query, mentorID, studentID)
+	if err != nil {
+		err := errors.Wrapf(entities.ErrInternal, "unlink student failure: %v", err)
+		slog.Error(err.Error())
+		return err
+	}
+
+	if tag.RowsAffected() == 0 {
+		err := errors.Wrapf(entities.ErrNotFound, "student %s of mentor %s", studentID, mentorID)
+		slog.Error(err.Error())
+		return err
+	}
+
+	slog.Info("UnlinkStudent completed")
+	return nil
+}
+
+func (s *Storage) GetMentorStudents(ctx context.Context, mentorID string) ([]string, error) {
+	slog.Info("GetMentorStudents started")
+
+	query := `
+	SELECT u.uid FROM auth.users u
+	WHERE u.linked_id = $1 AND u.linked_id <> ''
+	ORDER BY u.uid;`
+
+	rows, err := s.db.Query(ctx, query, mentorID)
+	if err != nil {
+		err := errors.Wrapf(entities.ErrInternal, "getting mentor students failure: %v", err)
+		slog.Error(err.Error())
+		return nil, err
+	}
+	defer rows.Close()
+
+	students := make([]string, 0)
+
+	for rows.Next() {
+		var studentID string
+		if err := rows.Scan(&studentID); err != nil {
+			err := errors.Wrapf(entities.ErrInternal, "scan student id failure: %v", err)
+			slog.Error(err.Error())
+			return nil, err
+		}
+		students = append(students, studentID)
+	}
+
+	if err := rows.Err(); err != nil {
+		err := errors.Wrapf(entities.ErrInternal, "rows err: %v", err)
+		slog.Error(err.Error())
+		return nil, err
+	}
+
+	slog.Info("GetMentorStudents completed")
+	return students, nil
+}
@@ -0,0 +1,452 @@
+package postgres
+
+import (
+	"context"
+	"log/slog"
+	"strconv"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/pkg/errors"
+
+	"github.com/parta4ok/kvs/question/internal/entities"
+)
+
+const (
+	uniqueViolationCode = "23505"
+)
+
+func (s *Storage) NextTopicID(ctx context.Context) (string, error) {
+	slog.Info("NextTopicID started")
+
+	query := `SELECT nextval(pg_get_serial_sequence('kvs.topics', 'topic_id'));`
+
+	id, err := s.nextID(ctx, query)
+	if err != nil {
+		return "", err
+	}
+
+	slog.Info("NextTopicID completed")
+	return id, nil
+}
+
+func (s *Storage) StoreTopic(ctx context.Context, topic *entities.Topic) error {
+	slog.Info("StoreTopic started")
+
+	query := `
+	INSERT INTO kvs.topics (topic_id, name, pass_threshold, max_pauses, selection_strategy)
+	VALUES ($1::INTEGER, $2, $3, $4, $5);`
+
+	if _, err := s.db.Exec(ctx, query, topic.ID(), topic.Name(), topic.PassThreshold(),
+		topic.MaxPauses(), string(topic.SelectionStrategy())); err != nil {
+		err = s.wrapWriteError(err, "store topic failure")
+		slog.Error(err.Error())
+		return err
+	}
+
+	slog.Info("StoreTopic completed")
+	return nil
+}
+
+// UpdateTopic changes the active topic. Sessions, everything built from them and attempt grants
+// refer to topics by name, so a topic can be renamed only until a session or a grant uses it.
+func (s *Storage) UpdateTopic(ctx context.Context, topic *entities.Topic) error {
+	slog.Info("UpdateTopic started")
+
+	if err := s.checkID(topic.ID(), "topic"); err != nil {
+		return err
+	}
+
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		err = errors.Wrapf(entities.ErrInternal, "begin transaction failure: %v", err)
+		slog.Error(err.Error())
+		return err
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	query := `
+	SELECT t.name FROM kvs.topics t WHERE t.topic_id = $1::INTEGER AND t.is_active
+	FOR UPDATE;`
+
+	var name string
+	if err := tx.QueryRow(ctx, query, topic.ID()).Scan(&name); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			err = errors.Wrapf(entities.ErrNotFound, "topic with id=%s", topic.ID())
+			slog.Error(err.Error())
+			return err
+		}
+		err = errors.Wrapf(entities.ErrInternal, "scan topic failure: %v", err)
+		slog.Error(err.Error())
+		return err
+	}
+
+	if name != topic.Name() {
+		query := `
+		SELECT EXISTS (SELECT 1 FROM kvs.sessions s WHERE s.topics @> ARRAY[$1]::TEXT[])
+		OR EXISTS (SELECT 1 FROM kvs.attempt_grants g WHERE g.topic = $1);`
+
+		var inUse bool
+		if err := tx.QueryRow(ctx, query, name).Scan(&inUse); err != nil {
+			err = errors.Wrapf(entities.ErrInternal, "check topic usage failure: %v", err)
+			slog.Error(err.Error())
+			return err
+		}
+
+		if inUse {
+			err := errors.Wrapf(entities.ErrConflict, "topic %s is used and can not be renamed",
+				name)
+			slog.Error(err.Error())
+			return err
+		}
+	}
+
+	query = `
+	UPDATE kvs.topics SET name = $2, pass_threshold = $3, max_pauses = $4,
+	selection_strategy = $5
+	WHERE topic_id = $1::INTEGER;`
+
+	if _, err := tx.Exec(ctx, query, topic.ID(), topic.Name(), topic.PassThreshold(),
+		topic.MaxPauses(), string(topic.SelectionStrategy())); err != nil {
+		err = s.wrapWriteError(err, "update topic failure")
+		slog.Error(err.Error())
+		return err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		err = errors.Wrapf(entities.ErrInternal, "commit transaction failure: %v", err)
+		slog.Error(err.Error())
+		return err
+	}
+
+	slog.Info("UpdateTopic completed")
+	return nil
+}
+
+// DeleteTopic deactivates topic, so it disappears from the topics list but stays available for
+// completed sessions history. The name is unique among active topics only, so it can be used
+// again for a new topic.
+func (s *Storage) DeleteTopic(ctx context.Context, topicID string) error {
+	slog.Info("DeleteTopic started")
+
+	if err := s.checkID(topicID, "topic"); err != nil {
+		return err
+	}
+
+	query := `UPDATE kvs.topics SET is_active = FALSE WHERE topic_id = $1::INTEGER AND is_active;`
+
+	if err := s.deactivate(ctx, query, topicID, "topic"); err != nil {
+		return err
+	}
+
+	slog.Info("DeleteTopic completed")
+	return nil
+}
+
+func (s *Storage) GetTopicByID(ctx context.Context, topicID string) (*entities.Topic, error) {
+	slog.Info("GetTopicByID started")
+
+	if err := s.checkID(topicID, "topic"); err != nil {
+		return nil, err
+	}
+
+	query := `
+	SELECT t.name, t.pass_threshold, t.max_pauses, t.selection_strategy FROM kvs.topics t
+	WHERE t.topic_id = $1::INTEGER AND t.is_active;`
+
+	var (
+		name          string
+		passThreshold float64
+		maxPauses     int
+		selection     string
+	)
+	if err := s.db.QueryRow(ctx, query, topicID).Scan(&name, &passThreshold,
+		&maxPauses, &selection); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			err = errors.Wrapf(entities.ErrNotFound, "topic with id=%s", topicID)
+			slog.Error(err.Error())
+			return nil, err
+		}
+		err = errors.Wrapf(entities.ErrInternal, "scan topic failure: %v", err)
+		slog.Error(err.Error())
+		return nil, err
+	}
+
+	slog.Info("GetTopicByID completed")
+	return entities.NewTopic(topicID, name, entities.WithPassThreshold(passThreshold),
+		entities.WithMaxPauses(maxPauses),
+		entities.WithSelectionStrategy(entities.SelectionStrategy(selection)))
+}
+
+func (s *Storage) GetTopicQuestions(ctx context.Context, topicID string) (
+	[]entities.Question, error) {
+	slog.Info("GetTopicQuestions started")
+
+	if err := s.checkID(topicID, "topic"); err != nil {
+		return nil, err
+	}
+
+	query := `
+	SELECT q.question_id, qt.name AS question_type_name, t.name AS topic_name, q.subject,
+	q.variants, q.correct_answers, q.options, q.weight, q.explanation, q.difficulty, q.version
+	FROM kvs.questions q
+	JOIN kvs.question_types qt ON q.question_type_id = qt.id
+	JOIN kvs.topics t ON q.topic_id = t.topic_id
+	WHERE t.topic_id = $1::INTEGER AND q.is_active
+	ORDER BY q.question_id;
+	`
+
+	rows, errDB := s.db.Query(ctx, query, topicID)
+	if errDB != nil {
+		err := errors.Wrapf(entities.ErrInternal, "get topic questions failure: %v", errDB)
+		slog.Error(err.Error())
+		return nil, err
+	}
+	defer rows.Close()
+
+	questions, err := s.processingQuestionsRows(ctx, rows)
+	if err != nil {
+		err := errors.Wrap(err, "processingQuestionsRows failure")
+		slog.Error(err.Error())
+		return nil, err
+	}
+
+	slog.Info("GetTopicQuestions completed")
+	return questions, nil
+}
+
+func (s *Storage) NextQuestionID(ctx context.Context) (string, error) {
+	slog.Info("NextQuestionID started")
+
+	query := `SELECT nextval(pg_get_serial_sequence('kvs.questions', 'question_id'));`
+
+	id, err := s.nextID(ctx, query)
+	if err != nil {
+		return "", err
+	}
+
+	slog.Info("NextQuestionID completed")
+	return id, nil
+}
+
+// StoreQuestion saves the question with its first version.
+func (s *Storage) StoreQuestion(ctx context.Context, question entities.Question) error {
+	slog.Info("StoreQuestion started")
+
+	query := `
+	INSERT INTO kvs.questions (question_id, question_type_id, topic_id, subject, variants,
+	correct_answers, options, weight, explanation, difficulty, version)
+	SELECT $1::INTEGER, qt.id, t.topic_id, $4, $5, $6, $7, $8, $9, $10, $11
+	FROM kvs.topics t, kvs.question_types qt
+	WHERE t.name = $2 AND t.is_active AND qt.name = $3;
+	`
+
+	options, err := s.encodeQuestionOptions(question)
+	if err != nil {
+		slog.Error(err.Error())
+		return err
+	}
+
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		err = errors.Wrapf(entities.ErrInternal, "begin transaction failure: %v", err)
+		slog.Error(err.Error())
+		return err
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	tag, err := tx.Exec(ctx, query, question.ID(), question.Topic(), question.Type().String(),
+		question.Subject(), s.questionVariants(question), question.CorrectAnswers(), options,
+		s.questionWeight(question), s.questionExplanation(question),
+		string(entities.DifficultyOf(question)), entities.VersionOf(question))
+	if err != nil {
+		err = s.wrapWriteError(err, "store question failure")
+		slog.Error(err.Error())
+		return err
+	}
+
+	if tag.RowsAffected() == 0 {
+		err := errors.Wrapf(entities.ErrNotFound, "topic %s", question.Topic())
+		slog.Error(err.Error())
+		return err
+	}
+
+	if err := s.commitQuestionVersion(ctx, tx, question.ID()); err != nil {
+		slog.Error(err.Error())
+		return err
+	}
+
+	slog.Info("StoreQuestion completed")
+	return nil
+}
+
+// UpdateQuestion saves the next version of the question. The version must follow the current
+// one, so concurrent edits of the question conflict instead of overwriting each other.
+func (s *Storage) UpdateQuestion(ctx context.Context, question entities.Question) error {
+	slog.Info("UpdateQuestion started")
+
+	if err := s.checkID(question.ID(), "question"); err != nil {
+		return err
+	}
+
+	query := `
+	UPDATE kvs.questions q
+	SET question_type_id = qt.id, subject = $3, variants = $4, correct_answers = $5,
+	options = $6, weight = $7, explanation = $8, difficulty = $9, version = $10
+	FROM kvs.question_types qt
+	WHERE q.question_id = $1::INTEGER AND q.is_active AND qt.name = $2 AND q.version = $10 - 1;
+	`
+
+	options, err := s.encodeQuestionOptions(question)
+	if err != nil {
+		slog.Error(err.Error())
+		return err
+	}
+
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		err = errors.Wrapf(entities.ErrInternal, "begin transaction failure: %v", err)
+		slog.Error(err.Error())
+		return err
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	version := entities.VersionOf(question)
+	tag, err := tx.Exec(ctx, query, question.ID(), question.Type().String(),
+		question.Subject(), s.questionVariants(question), question.CorrectAnswers(), options,
+		s.questionWeight(question), s.questionExplanation(question),
+		string(entities.DifficultyOf(question)), version)
+	if err != nil {
+		err = s.wrapWriteError(err, "update question failure")
+		slog.Error(err.Error())
+		return err
+	}
+
+	if tag.RowsAffected() == 0 {
+		err := errors.Wrapf(entities.ErrConflict,
+			"question with id=%s has no active version %d", question.ID(), version-1)
+		slog.Error(err.Error())
+		return err
+	}
+
+	if err := s.commitQuestionVersion(ctx, tx, question.ID()); err != nil {
+		slog.Error(err.Error())
+		return err
+	}
+
+	slog.Info("UpdateQuestion completed")
+	return nil
+}
+
+// DeleteQuestion deactivates question, so it is no longer selected for new sessions, while
+// completed sessions still can be recovered with it.
+func (s *Storage) DeleteQuestion(ctx context.Context, questionID string) error {
+	slog.Info("DeleteQuestion started")
+
+	if err := s.checkID(questionID, "question"); err != nil {
+		return err
+	}
+
+	query := `
+	UPDATE kvs.questions SET is_active = FALSE WHERE question_id = $1::INTEGER AND is_active;`
+
+	if err := s.deactivate(ctx, query, questionID, "question"); err != nil {
+		return err
+	}
+
+	slog.Info("DeleteQuestion completed")
+	return nil
+}
+
+// GetQuestionByID returns the question while it and its topic are active, deleted questions are
+// not found, so they can not be edited or rescored.
+func (s *Storage) GetQuestionByID(ctx context.Context, questionID string) (entities.Question,
+	error) {
+	slog.Info("GetQuestionByID started")
+
+	if err := s.checkID(questionID, "question"); err != nil {
+		return nil, err
+	}
+
+	// completed sessions are recovered with deleted questions too, so only the bank checks
+	// whether the question is still active
+	query := `
+	SELECT EXISTS (
+		SELECT 1 FROM kvs.questions q JOIN kvs.topics t ON q.topic_id = t.topic_id
+		WHERE q.question_id = $1::INTEGER AND q.is_active AND t.is_active
+	);`
+
+	var active bool
+	if err := s.db.QueryRow(ctx, query, questionID).Scan(&active); err != nil {
+		err = errors.Wrapf(entities.ErrInternal, "check question is active failure: %v", err)
+		slog.Error(err.Error())
+		return nil, err
+	}
+
+	if !active {
+		err := errors.Wrapf(entities.ErrNotFound, "question with id=%s", questionID)
+		slog.Error(err.Error())
+		return nil, err
+	}
+
+	questions, err := s.getQuestionsByID(ctx, []string{questionID})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(questions) == 0 {
+		err := errors.Wrapf(entities.ErrNotFound, "question with id=%s", questionID)
+		slog.Error(err.Error())
+		return nil, err
+	}
+
+	slog.Info("GetQuestionByID completed")
+	return questions[0], nil
+}
+
+func (s *Storage) checkID(id string, entity string) error {
+	if _, err := strconv.ParseUint(id, 10, 31); err != nil {
+		err = errors.Wrapf(entities.ErrInvalidParam, "invalid %s id: %s", entity, id)
+		slog.Error(err.Error())
+		return err
+	}
+
+	return nil
+}
+
+func (s *Storage) nextID(ctx context.Context, query string) (string, error) {
+	var id int64
+	if err := s.db.QueryRow(ctx, query).Scan(&id); err != nil {
+		err = errors.Wrapf(entities.ErrInternal, "next id generation failure: %v", err)
+		slog.Error(err.Error())
+		return "", err
+	}
+
+	return strconv.FormatInt(id, 10), nil
+}
+
+func (s *Storage) deactivate(ctx context.Context, query string, id string, entity string) error {
+	tag, err := s.db.Exec(ctx, query, id)
+	if err != nil {
+		err = errors.Wrapf(entities.ErrInternal, "deactivate %s failure: %v", entity, err)
+		slog.Error(err.Error())
+		return err
+	}
+
+	if tag.RowsAffected() == 0 {
+		err := errors.Wrapf(entities.ErrNotFound, "%s with id=%s", entity, id)
+		slog.Error(err.Error())
+		return err
+	}
+
+	return nil
+}
+
+func (s *Storage) wrapWriteError(err error, msg string) error {
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == uniqueViolationCode {
+		return errors.Wrapf(entities.ErrConflict, "%s: %v", msg, pgErr.Message)
+	}
+
+	return errors.Wrapf(entities.ErrInternal, "%s: %v", msg, err)
+}
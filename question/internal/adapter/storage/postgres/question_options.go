@@ -0,0 +1,119 @@
+package postgres
+
+import (
+	"encoding/json"
+
+	"github.com/pkg/errors"
+
+	"github.com/parta4ok/kvs/question/internal/entities"
+	"github.com/parta4ok/kvs/question/pkg/dto"
+)
+
+type normalizedQuestion interface {
+	Normalization() entities.AnswerNormalization
+}
+
+type partialCreditQuestion interface {
+	PartialCredit() bool
+}
+
+type toleratedQuestion interface {
+	Tolerance() entities.Tolerance
+}
+
+// encodeQuestionOptions collects type specific settings of the question into the value of
+// kvs.questions.options column.
+func (s *Storage) encodeQuestionOptions(question entities.Question) ([]byte, error) {
+	options := dto.QuestionOptionsDTO{}
+
+	if nq, ok := question.(normalizedQuestion); ok {
+		normalization := nq.Normalization()
+		options.Normalization = &dto.AnswerNormalizationDTO{
+			FoldCase:       normalization.FoldCase,
+			TrimSpace:      normalization.TrimSpace,
+			FoldLookalikes: normalization.FoldLookalikes,
+			Pattern:        normalization.Pattern,
+		}
+	}
+
+	if pq, ok := question.(partialCreditQuestion); ok {
+		options.PartialCredit = pq.PartialCredit()
+	}
+
+	if tq, ok := question.(toleratedQuestion); ok {
+		tolerance := tq.Tolerance()
+		options.Tolerance = &dto.ToleranceDTO{
+			Absolute: tolerance.Absolute,
+			Relative: tolerance.Relative,
+		}
+	}
+
+	raw, err := json.Marshal(options)
+	if err != nil {
+		return nil, errors.Wrapf(entities.ErrInternal, "marshal question options failure: %v", err)
+	}
+
+	return raw, nil
+}
+
+func (s *Storage) decodeQuestionOptions(raw []byte) ([]entities.QuestionOption, error) {
+	if len(raw) == 0 {
+		return nil, nil
+	}
+
+	var options dto.QuestionOptionsDTO
+	if err := json.Unmarshal(raw, &options); err != nil {
+		return nil, errors.Wrapf(entities.ErrInternal, "unmarshal question options failure: %v",
+			err)
+	}
+
+	opts := make([]entities.QuestionOption, 0)
+
+	if options.Normalization != nil {
+		opts = append(opts, entities.WithAnswerNormalization(entities.AnswerNormalization{
+			FoldCase:       options.Normalization.FoldCase,
+			TrimSpace:      options.Normalization.TrimSpace,
+			FoldLookalikes: options.Normalization.FoldLookalikes,
+			Pattern:        options.Normalization.Pattern,
+		}))
+	}
+
+	if options.PartialCredit {
+		opts = append(opts, entities.WithPartialCredit(true))
+	}
+
+	if options.Tolerance != nil {
+		opts = append(opts, entities.WithTolerance(entities.Tolerance{
+			Absolute: options.Tolerance.Absolute,
+			Relative: options.Tolerance.Relative,
+		}))
+	}
+
+	return opts, nil
+}
+
+// questionVariants never returns nil, because kvs.questions.variants column is NOT NULL and
+// free input questions have no variants.
+func (s *Storage) questionVariants(question entities.Question) []string {
+	if variants := question.Variants(); variants != nil {
+		return variants
+	}
+
+	return []string{}
+}
+
+func (s *Storage) questionWeight(question entities.Question) float64 {
+	if weighted, ok := question.(entities.Weighted); ok {
+		return weighted.Weight()
+	}
+
+	return entities.DefaultQuestionWeight
+}
+
+func (s *Storage) questionExplanation(question entities.Question) string {
+	if explained, ok := question.(entities.Explained); ok {
+		return explained.Explanation()
+	}
+
+	return ""
+}
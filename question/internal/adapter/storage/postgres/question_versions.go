@@ -0,0 +1,235 @@
+package postgres
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/pkg/errors"
+
+	"github.com/parta4ok/kvs/question/internal/entities"
+)
+
+// commitQuestionVersion copies the current content of the question to its versions and commits
+// the transaction. Versions are never changed, sessions are recovered with them.
+func (s *Storage) commitQuestionVersion(ctx context.Context, tx pgx.Tx, questionID string) error {
+	query := `
+	INSERT INTO kvs.question_versions (question_id, version, question_type_id, subject, variants,
+	correct_answers, options, weight, explanation, difficulty)
+	SELECT q.question_id, q.version, q.question_type_id, q.subject, q.variants, q.correct_answers,
+	q.options, q.weight, q.explanation, q.difficulty
+	FROM kvs.questions q
+	WHERE q.question_id = $1::INTEGER;`
+
+	if _, err := tx.Exec(ctx, query, questionID); err != nil {
+		return s.wrapWriteError(err, "store question version failure")
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return errors.Wrapf(entities.ErrInternal, "commit transaction failure: %v", err)
+	}
+
+	return nil
+}
+
+// getSessionQuestions returns questions in the versions the session was started with. Versions
+// follow the order of questions ids; the current version is taken when it is unknown.
+func (s *Storage) getSessionQuestions(ctx context.Context, questionsIDs []string,
+	versions []int) ([]entities.Question, error) {
+	slog.Info("getSessionQuestions started")
+
+	query := `
+	SELECT q.question_id, qt.name AS question_type_name, t.name AS topic_name, v.subject,
+	v.variants, v.correct_answers, v.options, v.weight, v.explanation, v.difficulty, v.version
+	FROM unnest($1::INTEGER[], $2::INTEGER[]) AS sv(question_id, version)
+	JOIN kvs.questions q ON q.question_id = sv.question_id
+	JOIN kvs.question_versions v ON v.question_id = q.question_id
+		AND v.version = COALESCE(sv.version, q.version)
+	JOIN kvs.question_types qt ON v.question_type_id = qt.id
+	JOIN kvs.topics t ON q.topic_id = t.topic_id
+	ORDER BY q.question_id;`
+
+	rows, errDB := s.db.Query(ctx, query, questionsIDs, versions)
+	if errDB != nil {
+		err := errors.Wrapf(entities.ErrInternal, "get session questions failure: %v", errDB)
+		slog.Error(err.Error())
+		return nil, err
+	}
+	defer rows.Close()
+
+	questions, err := s.processingQuestionsRows(ctx, rows)
+	if err != nil {
+		err := errors.Wrap(err, "processingQuestionsRows failure")
+		slog.Error(err.Error())
+		return nil, err
+	}
+
+	slog.Info("getSessionQuestions completed")
+	return questions, nil
+}
+
+// getQuestionsRefs returns ids and versions of the session questions in the same order.
+func (s *Storage) getQuestionsRefs(session *entities.Session) ([]string, []int, error) {
+	questions, err := session.GetQuestions()
+	if err != nil {
+		err := errors.Wrapf(entities.ErrInternal,
+			"get questions from session state: %v", err)
+		slog.Error(err.Error())
+		return nil, nil, err
+	}
+
+	questionsIDs := make([]string, 0, len(questions))
+	versions := make([]int, 0, len(questions))
+	for _, q := range questions {
+		questionsIDs = append(questionsIDs, q.ID())
+		versions = append(versions, entities.VersionOf(q))
+	}
+
+	return questionsIDs, versions, nil
+}
+
+// storeSessionQuestionVersions saves versions of the questions of the completed session, so
+// sessions to rescore are found by the question. Rescored sessions overwrite their versions.
+func (s *Storage) storeSessionQuestionVersions(ctx context.Context, tx pgx.Tx,
+	session *entities.Session) error {
+	questionsIDs, versions, err := s.getQuestionsRefs(session)
+	if err != nil {
+		return err
+	}
+
+	query := `
+	INSERT INTO kvs.session_question_versions (session_id, question_id, version)
+	SELECT $1, sv.question_id, sv.version
+	FROM unnest($2::INTEGER[], $3::INTEGER[]) AS sv(question_id, version)
+	ON CONFLICT (session_id, question_id) DO UPDATE SET version = EXCLUDED.version;`
+
+	if _, err := tx.Exec(ctx, query, session.GetSesionID(), questionsIDs, versions); err != nil {
+		return errors.Wrapf(entities.ErrInternal, "store session question versions failure: %v",
+			err)
+	}
+
+	return nil
+}
+
+// GetSessionsToRescore returns up to limit completed sessions graded with versions of the
+// question older than the given one. Sessions are ordered by id and start after afterSessionID,
+// empty afterSessionID means from the first one.
+func (s *Storage) GetSessionsToRescore(ctx context.Context, questionID string, version int,
+	afterSessionID string, limit int) ([]string, error) {
+	slog.Info("GetSessionsToRescore started")
+
+	if err := s.checkID(questionID, "question"); err != nil {
+		return nil, err
+	}
+
+	query := `
+	SELECT v.session_id
+	FROM kvs.session_question_versions v
+	WHERE v.question_id = $1::INTEGER AND v.version < $2 AND v.session_id > $3
+	ORDER BY v.session_id
+	LIMIT $4;`
+
+	rows, err := s.db.Query(ctx, query, questionID, version, afterSessionID, limit)
+	if err != nil {
+		err := errors.Wrapf(entities.ErrInternal, "get sessions to rescore failure: %v", err)
+		slog.Error(err.Error())
+		return nil, err
+	}
+	defer rows.Close()
+
+	sessionIDs := make([]string, 0)
+
+	for rows.Next() {
+		var sessionID string
+		if err := rows.Scan(&sessionID); err != nil {
+			err := errors.Wrapf(entities.ErrInternal, "scan session id failure: %v", err)
+			slog.Error(err.Error())
+			return nil, err
+		}
+		sessionIDs = append(sessionIDs, sessionID)
+	}
+
+	if err := rows.Err(); err != nil {
+		err := errors.Wrapf(entities.ErrInternal, "rows err: %v", err)
+		slog.Error(err.Error())
+		return nil, err
+	}
+
+	slog.Info("GetSessionsToRescore completed")
+	return sessionIDs, nil
+}
+
+// UpdateSessionResult saves result of the rescored session and versions of its questions, ids
+// are rewritten in the same order as versions. The result snapshot is rewritten too. Completion
+// time stays the same, so history and reports keep the session in place.
+//
+//nolint:funlen //ok
+func (s *Storage) UpdateSessionResult(ctx context.Context, session *entities.Session) error {
+	slog.Info("UpdateSessionResult started")
+
+	questionsIDs, versions, err := s.getQuestionsRefs(session)
+	if err != nil {
+		return err
+	}
+
+	result, err := session.GetSessionResult()
+	if err != nil {
+		err := errors.Wrap(err, "session GetSessionResult failure")
+		slog.Error(err.Error())
+		return err
+	}
+
+	query := `
+	UPDATE kvs.sessions SET questions = $2, question_versions = $3, is_passed = $4,
+	comment = $5, pass_threshold = $6, score = $7, correct_count = $8, total_count = $9,
+	outcome = $10
+	WHERE session_id = $1 AND state = 'completed state';`
+
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		err = errors.Wrapf(entities.ErrInternal, "begin transaction failure: %v", err)
+		slog.Error(err.Error())
+		return err
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	tag, err := tx.Exec(ctx, query, session.GetSesionID(), questionsIDs, versions,
+		result.IsSuccess, result.Grade, result.PassThreshold, result.Score, result.CorrectCount,
+		result.TotalCount, string(result.Outcome))
+	if err != nil {
+		err = errors.Wrapf(entities.ErrInternal, "update session result failure: %v", err)
+		slog.Error(err.Error())
+		return err
+	}
+
+	if tag.RowsAffected() == 0 {
+		err := errors.Wrapf(entities.ErrNotFound, "completed session %s",
+			session.GetSesionID())
+		slog.Error(err.Error())
+		return err
+	}
+
+	if err := s.storeSessionQuestionVersions(ctx, tx, session); err != nil {
+		slog.Error(err.Error())
+		return err
+	}
+
+	if err := s.updateAnswerOutcomes(ctx, tx, session); err != nil {
+		slog.Error(err.Error())
+		return err
+	}
+
+	if err := s.updateResultSnapshot(ctx, tx, session); err != nil {
+		slog.Error(err.Error())
+		return err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		err = errors.Wrapf(entities.ErrInternal, "commit transaction failure: %v", err)
+		slog.Error(err.Error())
+		return err
+	}
+
+	slog.Info("UpdateSessionResult completed")
+	return nil
+}
@@ -0,0 +1,233 @@
+package postgres
+
+import (
+	"context"
+	"log/slog"
+	"strconv"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/pkg/errors"
+
+	"github.com/parta4ok/kvs/question/internal/entities"
+)
+
+// insertAnswerOutcomesQuery stores correctness of every answer of the completed session.
+// Completion time is taken from the completed state of the session, so outcomes of sessions
+// completed before outcomes were stored keep their place in the history.
+const insertAnswerOutcomesQuery = `
+	INSERT INTO kvs.answer_outcomes (session_id, user_id, question_id, topic, is_correct, mode,
+	completed_at)
+	SELECT $1, $2, o.question_id, o.topic, o.is_correct, $3, s.updated_at
+	FROM kvs.sessions s,
+	unnest($4::INTEGER[], $5::TEXT[], $6::BOOLEAN[]) AS o(question_id, topic, is_correct)
+	WHERE s.session_id = $1 AND s.state = 'completed state'
+	ON CONFLICT (session_id, question_id) DO NOTHING;`
+
+// storeAnswerOutcomes saves correctness of every answer of the completed session, so repetition
+// cards are built without recovering sessions.
+func (s *Storage) storeAnswerOutcomes(ctx context.Context, tx pgx.Tx,
+	session *entities.Session) error {
+	args, err := s.answerOutcomesArgs(session)
+	if err != nil {
+		return err
+	}
+
+	if _, err := tx.Exec(ctx, insertAnswerOutcomesQuery, args...); err != nil {
+		return errors.Wrapf(entities.ErrInternal, "store answer outcomes failure: %v", err)
+	}
+
+	return nil
+}
+
+// updateAnswerOutcomes saves correctness of the answers of the rescored session. Completion time
+// of the outcomes stays the same, so the repetition schedule keeps its order.
+func (s *Storage) updateAnswerOutcomes(ctx context.Context, tx pgx.Tx,
+	session *entities.Session) error {
+	outcomes, err := session.GetAnswerOutcomes()
+	if err != nil {
+		return errors.Wrap(err, "session GetAnswerOutcomes failure")
+	}
+
+	questionsIDs := make([]string, 0, len(outcomes))
+	correctness := make([]bool, 0, len(outcomes))
+	for _, outcome := range outcomes {
+		questionsIDs = append(questionsIDs, outcome.QuestionID)
+		correctness = append(correctness, outcome.IsCorrect)
+	}
+
+	query := `
+	UPDATE kvs.answer_outcomes a SET is_correct = o.is_correct
+	FROM unnest($2::INTEGER[], $3::BOOLEAN[]) AS o(question_id, is_correct)
+	WHERE a.session_id = $1 AND a.question_id = o.question_id;`
+
+	if _, err := tx.Exec(ctx, query, session.GetSesionID(), questionsIDs,
+		correctness); err != nil {
+		return errors.Wrapf(entities.ErrInternal, "update answer outcomes failure: %v", err)
+	}
+
+	return nil
+}
+
+// StoreAnswerOutcomes saves outcomes of the session completed before outcomes were stored,
+// stored outcomes are never overwritten.
+func (s *Storage) StoreAnswerOutcomes(ctx context.Context, session *entities.Session) error {
+	slog.Info("StoreAnswerOutcomes started")
+
+	if session == nil {
+		err := errors.Wrap(entities.ErrInvalidParam, "session not set")
+		slog.Error(err.Error())
+		return err
+	}
+
+	args, err := s.answerOutcomesArgs(session)
+	if err != nil {
+		slog.Error(err.Error())
+		return err
+	}
+
+	if _, err := s.db.Exec(ctx, insertAnswerOutcomesQuery, args...); err != nil {
+		err := errors.Wrapf(entities.ErrInternal, "store answer outcomes failure: %v", err)
+		slog.Error(err.Error())
+		return err
+	}
+
+	slog.Info("StoreAnswerOutcomes completed")
+	return nil
+}
+
+// GetSessionsWithoutOutcomes returns positions of completed sessions after the cursor which have
+// no answer outcomes, the oldest first. Nil cursor means from the start.
+func (s *Storage) GetSessionsWithoutOutcomes(ctx context.Context,
+	after *entities.BackfillCursor, limit int) ([]entities.BackfillCursor, error) {
+	slog.Info("GetSessionsWithoutOutcomes started")
+
+	query := `
+	SELECT s.session_id, s.updated_at
+	FROM kvs.sessions s
+	WHERE s.state = 'completed state'
+	AND NOT EXISTS (SELECT 1 FROM kvs.answer_outcomes o WHERE o.session_id = s.session_id)
+	AND ($1::TIMESTAMP IS NULL OR (s.updated_at, s.session_id) > ($1::TIMESTAMP, $2::TEXT))
+	ORDER BY s.updated_at, s.session_id
+	LIMIT $3;`
+
+	var (
+		afterCompletedAt *time.Time
+		afterSessionID   string
+	)
+	if after != nil {
+		afterCompletedAt = &after.CompletedAt
+		afterSessionID = after.SessionID
+	}
+
+	rows, err := s.db.Query(ctx, query, afterCompletedAt, afterSessionID, limit)
+	if err != nil {
+		err := errors.Wrapf(entities.ErrInternal, "search sessions without outcomes failure: %v",
+			err)
+		slog.Error(err.Error())
+		return nil, err
+	}
+	defer rows.Close()
+
+	sessions, err := scanBackfillCursors(rows)
+	if err != nil {
+		slog.Error(err.Error())
+		return nil, err
+	}
+
+	slog.Info("GetSessionsWithoutOutcomes completed")
+	return sessions, nil
+}
+
+// GetRepetitionOutcomes returns stored answer outcomes of the user to the active questions of
+// the active topics in the order of the sessions. Outcomes are dated by the start of the
+// session. Empty topics mean all topics.
+func (s *Storage) GetRepetitionOutcomes(ctx context.Context, userID string, topics []string) (
+	[]entities.AnswerOutcome, error) {
+	slog.Info("GetRepetitionOutcomes started")
+
+	query := `
+	SELECT o.question_id, t.name, o.is_correct, s.created_at AS answered_at
+	FROM kvs.answer_outcomes o
+	JOIN kvs.sessions s ON s.session_id = o.session_id
+	JOIN kvs.questions q ON q.question_id = o.question_id
+	JOIN kvs.topics t ON t.topic_id = q.topic_id
+	WHERE o.user_id = $1 AND q.is_active AND t.is_active
+	AND (cardinality($2::TEXT[]) = 0 OR t.name = ANY($2))
+	ORDER BY answered_at, o.question_id;`
+
+	if topics == nil {
+		topics = []string{}
+	}
+
+	rows, err := s.db.Query(ctx, query, userID, topics)
+	if err != nil {
+		err := errors.Wrapf(entities.ErrInternal, "get repetition outcomes failure: %v", err)
+		slog.Error(err.Error())
+		return nil, err
+	}
+	defer rows.Close()
+
+	outcomes := make([]entities.AnswerOutcome, 0)
+	for rows.Next() {
+		var (
+			questionID int64
+			outcome    entities.AnswerOutcome
+		)
+
+		if err := rows.Scan(&questionID, &outcome.Topic, &outcome.IsCorrect,
+			&outcome.AnsweredAt); err != nil {
+			err := errors.Wrapf(entities.ErrInternal, "scan repetition outcome failure: %v", err)
+			slog.Error(err.Error())
+			return nil, err
+		}
+
+		outcome.QuestionID = strconv.FormatInt(questionID, 10)
+		outcomes = append(outcomes, outcome)
+	}
+
+	if err := rows.Err(); err != nil {
+		err := errors.Wrapf(entities.ErrInternal, "rows err: %v", err)
+		slog.Error(err.Error())
+		return nil, err
+	}
+
+	slog.Info("GetRepetitionOutcomes completed")
+	return outcomes, nil
+}
+
+func (s *Storage) answerOutcomesArgs(session *entities.Session) ([]any, error) {
+	outcomes, err := session.GetAnswerOutcomes()
+	if err != nil {
+		return nil, errors.Wrap(err, "session GetAnswerOutcomes failure")
+	}
+
+	questionsIDs := make([]string, 0, len(outcomes))
+	topics := make([]string, 0, len(outcomes))
+	correctness := make([]bool, 0, len(outcomes))
+	for _, outcome := range outcomes {
+		questionsIDs = append(questionsIDs, outcome.QuestionID)
+		topics = append(topics, outcome.Topic)
+		correctness = append(correctness, outcome.IsCorrect)
+	}
+
+	return []any{session.GetSesionID(), session.GetUserID(), string(session.GetPolicy().Mode),
+		questionsIDs, topics, correctness}, nil
+}
+
+func scanBackfillCursors(rows pgx.Rows) ([]entities.BackfillCursor, error) {
+	sessions := make([]entities.BackfillCursor, 0)
+	for rows.Next() {
+		var session entities.BackfillCursor
+		if err := rows.Scan(&session.SessionID, &session.CompletedAt); err != nil {
+			return nil, errors.Wrapf(entities.ErrInternal, "scan session id failure: %v", err)
+		}
+		sessions = append(sessions, session)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrapf(entities.ErrInternal, "rows err: %v", err)
+	}
+
+	return sessions, nil
+}
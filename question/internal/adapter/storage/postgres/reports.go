@@ -0,0 +1,197 @@
+package postgres
+
+import (
+	"context"
+	"log/slog"
+	"strconv"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/parta4ok/kvs/question/internal/entities"
+)
+
+// GetSessionStats counts completed sessions of the user by outcome. Durations are counted for
+// sessions which have not expired.
+func (s *Storage) GetSessionStats(ctx context.Context, userID string) (entities.SessionStats,
+	error) {
+	slog.Info("GetSessionStats started")
+
+	query := `
+	WITH completed AS (
+		SELECT s.mode, s.outcome,
+		CASE WHEN s.outcome <> 'expired'
+			THEN EXTRACT(EPOCH FROM s.updated_at - s.created_at)::DOUBLE PRECISION
+		END AS duration
+		FROM kvs.sessions s
+		WHERE s.user_id = $1 AND s.state = 'completed state'
+	)
+	SELECT
+		COUNT(*),
+		COUNT(*) FILTER (WHERE mode = 'exam'),
+		COUNT(*) FILTER (WHERE outcome = 'passed'),
+		COUNT(*) FILTER (WHERE outcome = 'failed'),
+		COUNT(*) FILTER (WHERE outcome = 'expired'),
+		COALESCE(AVG(duration), 0),
+		COALESCE(percentile_cont(0.5) WITHIN GROUP (ORDER BY duration), 0),
+		COALESCE(MIN(duration), 0),
+		COALESCE(MAX(duration), 0)
+	FROM completed;`
+
+	var (
+		stats                   entities.SessionStats
+		average, median, lo, hi float64
+	)
+	if err := s.db.QueryRow(ctx, query, userID).Scan(&stats.Total, &stats.Exams, &stats.Passed,
+		&stats.Failed, &stats.Expired, &average, &median, &lo, &hi); err != nil {
+		err = errors.Wrapf(entities.ErrInternal, "get session stats failure: %v", err)
+		slog.Error(err.Error())
+		return entities.SessionStats{}, err
+	}
+
+	stats.AverageDuration = secondsToDuration(average)
+	stats.MedianDuration = secondsToDuration(median)
+	stats.MinDuration = secondsToDuration(lo)
+	stats.MaxDuration = secondsToDuration(hi)
+
+	slog.Info("GetSessionStats completed")
+	return stats, nil
+}
+
+// GetTopicAccuracy counts answers of the user by topic and week of the session completion.
+func (s *Storage) GetTopicAccuracy(ctx context.Context, userID string) (
+	[]entities.TopicAccuracy, error) {
+	slog.Info("GetTopicAccuracy started")
+
+	query := `
+	SELECT o.topic, date_trunc('week', o.completed_at) AS period,
+	COUNT(*) FILTER (WHERE o.is_correct), COUNT(*)
+	FROM kvs.answer_outcomes o
+	WHERE o.user_id = $1
+	GROUP BY o.topic, period
+	ORDER BY o.topic, period;`
+
+	rows, err := s.db.Query(ctx, query, userID)
+	if err != nil {
+		err := errors.Wrapf(entities.ErrInternal, "get topic accuracy failure: %v", err)
+		slog.Error(err.Error())
+		return nil, err
+	}
+	defer rows.Close()
+
+	topics := make([]entities.TopicAccuracy, 0)
+	for rows.Next() {
+		var (
+			topic  string
+			period entities.AccuracyPeriod
+		)
+
+		if err := rows.Scan(&topic, &period.Start, &period.Correct, &period.Total); err != nil {
+			err := errors.Wrapf(entities.ErrInternal, "scan topic accuracy failure: %v", err)
+			slog.Error(err.Error())
+			return nil, err
+		}
+
+		// rows are ordered by topic, so periods of one topic follow each other
+		if len(topics) == 0 || topics[len(topics)-1].Topic != topic {
+			topics = append(topics, entities.TopicAccuracy{Topic: topic})
+		}
+		topics[len(topics)-1].AddPeriod(period)
+	}
+
+	if err := rows.Err(); err != nil {
+		err := errors.Wrapf(entities.ErrInternal, "rows err: %v", err)
+		slog.Error(err.Error())
+		return nil, err
+	}
+
+	slog.Info("GetTopicAccuracy completed")
+	return topics, nil
+}
+
+// GetFailedQuestions returns questions the user answered wrong most often, deleted questions
+// included.
+func (s *Storage) GetFailedQuestions(ctx context.Context, userID string, limit int) (
+	[]entities.FailedQuestion, error) {
+	slog.Info("GetFailedQuestions started")
+
+	query := `
+	SELECT o.question_id, o.topic, q.subject,
+	COUNT(*) FILTER (WHERE NOT o.is_correct) AS failures, COUNT(*)
+	FROM kvs.answer_outcomes o
+	JOIN kvs.questions q ON q.question_id = o.question_id
+	WHERE o.user_id = $1
+	GROUP BY o.question_id, o.topic, q.subject
+	HAVING COUNT(*) FILTER (WHERE NOT o.is_correct) > 0
+	ORDER BY failures DESC, o.question_id
+	LIMIT $2;`
+
+	rows, err := s.db.Query(ctx, query, userID, limit)
+	if err != nil {
+		err := errors.Wrapf(entities.ErrInternal, "get failed questions failure: %v", err)
+		slog.Error(err.Error())
+		return nil, err
+	}
+	defer rows.Close()
+
+	questions := make([]entities.FailedQuestion, 0)
+	for rows.Next() {
+		var (
+			questionID int64
+			question   entities.FailedQuestion
+		)
+
+		if err := rows.Scan(&questionID, &question.Topic, &question.Subject, &question.Failures,
+			&question.Answers); err != nil {
+			err := errors.Wrapf(entities.ErrInternal, "scan failed question failure: %v", err)
+			slog.Error(err.Error())
+			return nil, err
+		}
+
+		question.QuestionID = strconv.FormatInt(questionID, 10)
+		questions = append(questions, question)
+	}
+
+	if err := rows.Err(); err != nil {
+		err := errors.Wrapf(entities.ErrInternal, "rows err: %v", err)
+		slog.Error(err.Error())
+		return nil, err
+	}
+
+	slog.Info("GetFailedQuestions completed")
+	return questions, nil
+}
+
+// GetScoreTrend fits a line to scores of the recent exam sessions of the user numbered in the
+// completion order.
+func (s *Storage) GetScoreTrend(ctx context.Context, userID string, depth int) (
+	entities.ScoreTrend, error) {
+	slog.Info("GetScoreTrend started")
+
+	query := `
+	SELECT COUNT(*), COALESCE(regr_slope(recent.score, recent.rn), 0)
+	FROM (
+		SELECT s.score,
+		(ROW_NUMBER() OVER (ORDER BY s.updated_at))::DOUBLE PRECISION AS rn
+		FROM kvs.sessions s
+		WHERE s.user_id = $1 AND s.state = 'completed state' AND s.mode = 'exam'
+		AND s.score IS NOT NULL
+		ORDER BY s.updated_at DESC
+		LIMIT $2
+	) recent;`
+
+	var trend entities.ScoreTrend
+	if err := s.db.QueryRow(ctx, query, userID, depth).Scan(&trend.Sessions,
+		&trend.Slope); err != nil {
+		err = errors.Wrapf(entities.ErrInternal, "get score trend failure: %v", err)
+		slog.Error(err.Error())
+		return entities.ScoreTrend{}, err
+	}
+
+	slog.Info("GetScoreTrend completed")
+	return trend, nil
+}
+
+func secondsToDuration(seconds float64) time.Duration {
+	return time.Duration(seconds * float64(time.Second))
+}
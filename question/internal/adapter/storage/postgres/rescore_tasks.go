@@ -0,0 +1,156 @@
+package postgres
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/pkg/errors"
+
+	"github.com/parta4ok/kvs/question/internal/entities"
+)
+
+const rescoreTaskColumns = `question_id::TEXT, version, status, last_session_id, rescored, failed,
+	attempts, error, created_at, updated_at`
+
+// StoreRescoreTask queues rescoring of the question with the version. The finished task of the
+// question and the task of another version are started over, the unfinished task of the same
+// version is kept. The queued task is returned.
+func (s *Storage) StoreRescoreTask(ctx context.Context, questionID string, version int) (
+	*entities.RescoreTask, error) {
+	slog.Info("StoreRescoreTask started")
+
+	if err := s.checkID(questionID, "question"); err != nil {
+		return nil, err
+	}
+
+	query := `
+	INSERT INTO kvs.rescore_jobs (question_id, version, status, created_at, updated_at)
+	VALUES ($1::INTEGER, $2, 'pending', $3, $3)
+	ON CONFLICT (question_id) DO UPDATE SET version = EXCLUDED.version, status = 'pending',
+	last_session_id = '', rescored = 0, failed = 0, attempts = 0, error = '',
+	created_at = EXCLUDED.created_at, updated_at = EXCLUDED.updated_at
+	WHERE kvs.rescore_jobs.status IN ('completed', 'failed')
+		OR kvs.rescore_jobs.version <> EXCLUDED.version;`
+
+	if _, err := s.db.Exec(ctx, query, questionID, version, time.Now().UTC()); err != nil {
+		err := s.wrapWriteError(err, "store rescore task failure")
+		slog.Error(err.Error())
+		return nil, err
+	}
+
+	task, err := s.GetRescoreTask(ctx, questionID)
+	if err != nil {
+		return nil, err
+	}
+
+	slog.Info("StoreRescoreTask completed")
+	return task, nil
+}
+
+// GetRescoreTask returns the last rescore task of the question.
+func (s *Storage) GetRescoreTask(ctx context.Context, questionID string) (*entities.RescoreTask,
+	error) {
+	slog.Info("GetRescoreTask started")
+
+	if err := s.checkID(questionID, "question"); err != nil {
+		return nil, err
+	}
+
+	query := `SELECT ` + rescoreTaskColumns + `
+	FROM kvs.rescore_jobs WHERE question_id = $1::INTEGER;`
+
+	task, err := scanRescoreTask(s.db.QueryRow(ctx, query, questionID))
+	if errors.Is(err, pgx.ErrNoRows) {
+		err := errors.Wrapf(entities.ErrNotFound, "rescore task of question %s", questionID)
+		slog.Warn(err.Error())
+		return nil, err
+	}
+	if err != nil {
+		err := errors.Wrapf(entities.ErrInternal, "get rescore task failure: %v", err)
+		slog.Error(err.Error())
+		return nil, err
+	}
+
+	slog.Info("GetRescoreTask completed")
+	return task, nil
+}
+
+// ClaimRescoreTask takes the oldest pending task or the running one which progress was not
+// saved since staleBefore and marks it running. Several replicas never take the same task:
+// every claim increments attempts, and progress of the task is saved only by its last claimer.
+func (s *Storage) ClaimRescoreTask(ctx context.Context, staleBefore time.Time) (
+	*entities.RescoreTask, error) {
+	slog.Info("ClaimRescoreTask started")
+
+	query := `
+	UPDATE kvs.rescore_jobs j SET status = 'running', attempts = j.attempts + 1, updated_at = $2
+	WHERE j.question_id = (
+		SELECT c.question_id FROM kvs.rescore_jobs c
+		WHERE c.status = 'pending' OR (c.status = 'running' AND c.updated_at < $1)
+		ORDER BY c.updated_at
+		LIMIT 1
+		FOR UPDATE SKIP LOCKED
+	)
+	RETURNING ` + rescoreTaskColumns + `;`
+
+	task, err := scanRescoreTask(s.db.QueryRow(ctx, query, staleBefore, time.Now().UTC()))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, errors.Wrap(entities.ErrNotFound, "no rescore tasks to run")
+	}
+	if err != nil {
+		err := errors.Wrapf(entities.ErrInternal, "claim rescore task failure: %v", err)
+		slog.Error(err.Error())
+		return nil, err
+	}
+
+	slog.Info("ClaimRescoreTask completed")
+	return task, nil
+}
+
+// UpdateRescoreTask saves progress of the running task. The task claimed again or started over
+// since it was claimed is not changed, entities.ErrConflict is returned then.
+func (s *Storage) UpdateRescoreTask(ctx context.Context, task *entities.RescoreTask) error {
+	slog.Info("UpdateRescoreTask started")
+
+	query := `
+	UPDATE kvs.rescore_jobs SET status = $4, last_session_id = $5, rescored = $6, failed = $7,
+	error = $8, updated_at = $9
+	WHERE question_id = $1::INTEGER AND version = $2 AND attempts = $3 AND status = 'running';`
+
+	tag, err := s.db.Exec(ctx, query, task.QuestionID, task.Version, task.Attempts,
+		string(task.Status), task.LastSessionID, task.Rescored, task.Failed, task.Error,
+		time.Now().UTC())
+	if err != nil {
+		err := errors.Wrapf(entities.ErrInternal, "update rescore task failure: %v", err)
+		slog.Error(err.Error())
+		return err
+	}
+
+	if tag.RowsAffected() == 0 {
+		err := errors.Wrapf(entities.ErrConflict, "rescore task of question %s was taken over",
+			task.QuestionID)
+		slog.Warn(err.Error())
+		return err
+	}
+
+	slog.Info("UpdateRescoreTask completed")
+	return nil
+}
+
+func scanRescoreTask(row pgx.Row) (*entities.RescoreTask, error) {
+	var (
+		task   entities.RescoreTask
+		status string
+	)
+
+	if err := row.Scan(&task.QuestionID, &task.Version, &status, &task.LastSessionID,
+		&task.Rescored, &task.Failed, &task.Attempts, &task.Error, &task.CreatedAt,
+		&task.UpdatedAt); err != nil {
+		return nil, err
+	}
+	task.Status = entities.RescoreStatus(status)
+
+	return &task, nil
+}
@@ -0,0 +1,44 @@
+package postgres
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/pkg/errors"
+
+	"github.com/parta4ok/kvs/question/internal/entities"
+)
+
+// storeSessionDeadline keeps kvs.session_deadlines in step with the stored state: a running exam
+// session has its deadline there, a paused or completed session has none. Practice sessions are
+// untimed and never get there.
+func (s *Storage) storeSessionDeadline(ctx context.Context, tx pgx.Tx,
+	session *entities.Session) error {
+	switch session.GetStatus() {
+	case entities.ActiveState:
+		if !session.GetPolicy().IsTimed() {
+			return nil
+		}
+
+		deadline, err := session.GetDeadline()
+		if err != nil {
+			return errors.Wrap(err, "session GetDeadline failure")
+		}
+
+		query := `
+		INSERT INTO kvs.session_deadlines (session_id, deadline) VALUES ($1, $2)
+		ON CONFLICT (session_id) DO UPDATE SET deadline = EXCLUDED.deadline;`
+
+		if _, err := tx.Exec(ctx, query, session.GetSesionID(), deadline); err != nil {
+			return errors.Wrapf(entities.ErrInternal, "store session deadline failure: %v", err)
+		}
+	case entities.PausedState, entities.CompletedState:
+		query := `DELETE FROM kvs.session_deadlines WHERE session_id = $1;`
+
+		if _, err := tx.Exec(ctx, query, session.GetSesionID()); err != nil {
+			return errors.Wrapf(entities.ErrInternal, "delete session deadline failure: %v", err)
+		}
+	}
+
+	return nil
+}
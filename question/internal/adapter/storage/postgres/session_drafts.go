@@ -0,0 +1,123 @@
+package postgres
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/pkg/errors"
+
+	"github.com/parta4ok/kvs/question/internal/entities"
+)
+
+func (s *Storage) StoreDraftAnswer(ctx context.Context, sessionID string,
+	answer *entities.UserAnswer) error {
+	slog.Info("StoreDraftAnswer started")
+
+	selections := answer.GetSelections()
+	if selections == nil {
+		selections = []string{}
+	}
+
+	query := `
+	INSERT INTO kvs.session_drafts (session_id, question_id, answers) VALUES ($1, $2, $3)
+	ON CONFLICT (session_id, question_id) DO UPDATE
+	SET answers = EXCLUDED.answers, updated_at = NOW();`
+
+	if _, err := s.db.Exec(ctx, query, sessionID, answer.GetQuestionID(), selections); err != nil {
+		err := errors.Wrapf(entities.ErrInternal, "store draft answer failure: %v", err)
+		slog.Error(err.Error())
+		return err
+	}
+
+	slog.Info("StoreDraftAnswer completed")
+	return nil
+}
+
+func (s *Storage) GetDraftAnswers(ctx context.Context, sessionID string) (
+	[]*entities.UserAnswer, error) {
+	slog.Info("GetDraftAnswers started")
+
+	query := `
+	SELECT d.question_id, d.answers FROM kvs.session_drafts d
+	WHERE d.session_id = $1
+	ORDER BY d.updated_at;`
+
+	rows, err := s.db.Query(ctx, query, sessionID)
+	if err != nil {
+		err := errors.Wrapf(entities.ErrInternal, "getting draft answers failure: %v", err)
+		slog.Error(err.Error())
+		return nil, err
+	}
+	defer rows.Close()
+
+	drafts := make([]*entities.UserAnswer, 0)
+
+	for rows.Next() {
+		var (
+			questionID string
+			selections []string
+		)
+		if err := rows.Scan(&questionID, &selections); err != nil {
+			err := errors.Wrapf(entities.ErrInternal, "scan draft answer failure: %v", err)
+			slog.Error(err.Error())
+			return nil, err
+		}
+
+		draft, err := entities.NewUserAnswer(questionID, selections)
+		if err != nil {
+			err := errors.Wrapf(entities.ErrInternal, "restore draft answer failure: %v", err)
+			slog.Error(err.Error())
+			return nil, err
+		}
+		drafts = append(drafts, draft)
+	}
+
+	if err := rows.Err(); err != nil {
+		err := errors.Wrapf(entities.ErrInternal, "rows err: %v", err)
+		slog.Error(err.Error())
+		return nil, err
+	}
+
+	slog.Info("GetDraftAnswers completed")
+	return drafts, nil
+}
+
+// deleteDrafts removes drafts of the session completed in tx: the answers are stored with the
+// completed session, so the drafts are not needed anymore.
+func (s *Storage) deleteDrafts(ctx context.Context, tx pgx.Tx, sessionID string) error {
+	query := `DELETE FROM kvs.session_drafts WHERE session_id = $1;`
+
+	if _, err := tx.Exec(ctx, query, sessionID); err != nil {
+		return errors.Wrapf(entities.ErrInternal, "delete draft answers failure: %v", err)
+	}
+
+	return nil
+}
+
+// DeleteStaleDrafts removes up to limit drafts left from completed sessions and returns the
+// number of removed drafts.
+func (s *Storage) DeleteStaleDrafts(ctx context.Context, limit int) (int, error) {
+	slog.Info("DeleteStaleDrafts started")
+
+	query := `
+	DELETE FROM kvs.session_drafts d
+	WHERE (d.session_id, d.question_id) IN (
+		SELECT stale.session_id, stale.question_id FROM kvs.session_drafts stale
+		WHERE EXISTS (
+			SELECT 1 FROM kvs.sessions s
+			WHERE s.session_id = stale.session_id AND s.state = 'completed state'
+		)
+		LIMIT $1
+	);`
+
+	tag, err := s.db.Exec(ctx, query, limit)
+	if err != nil {
+		err := errors.Wrapf(entities.ErrInternal, "delete stale drafts failure: %v", err)
+		slog.Error(err.Error())
+		return 0, err
+	}
+
+	slog.Info("DeleteStaleDrafts completed")
+	return int(tag.RowsAffected()), nil
+}
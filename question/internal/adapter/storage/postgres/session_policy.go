@@ -0,0 +1,100 @@
+package postgres
+
+import (
+	"encoding/json"
+
+	"github.com/pkg/errors"
+
+	"github.com/parta4ok/kvs/question/internal/entities"
+	"github.com/parta4ok/kvs/question/pkg/dto"
+)
+
+// encodeSessionPolicy prepares value of kvs.sessions.policy column.
+func (s *Storage) encodeSessionPolicy(policy entities.SessionPolicy) ([]byte, error) {
+	policyDTO := dto.SessionPolicyDTO{}
+
+	if policy.Scoring != nil {
+		policyDTO.Scoring.Strategy = policy.Scoring.Name()
+		if nm, ok := policy.Scoring.(*entities.NegativeMarkingStrategy); ok {
+			penalty := nm.Penalty()
+			policyDTO.Scoring.Penalty = &penalty
+		}
+	}
+
+	policyDTO.PassThreshold = dto.PassThresholdDTO{
+		Resolution: policy.PassThreshold.Resolution,
+		Topics:     policy.PassThreshold.Topics,
+	}
+	policyDTO.Pause = dto.PausePolicyDTO{MaxPauses: policy.Pause.MaxPauses}
+	policyDTO.Review = dto.ReviewPolicyDTO{Visibility: string(policy.Review.Visibility)}
+	if policy.Shuffle.IsEnabled() {
+		policyDTO.Shuffle = &dto.ShufflePolicyDTO{
+			Variants:  policy.Shuffle.Variants,
+			Questions: policy.Shuffle.Questions,
+			Seed:      policy.Shuffle.Seed,
+		}
+	}
+
+	raw, err := json.Marshal(policyDTO)
+	if err != nil {
+		return nil, errors.Wrapf(entities.ErrInternal, "marshal session policy failure: %v", err)
+	}
+
+	return raw, nil
+}
+
+// decodeSessionPolicy restores policy the session was created with. Sessions stored before
+// policies were introduced have no policy and get the default one. Mode is kept in its own
+// column of kvs.sessions. Sessions without shuffle settings keep variants in storage order.
+func (s *Storage) decodeSessionPolicy(raw []byte, mode string) (entities.SessionPolicy, error) {
+	policy := entities.DefaultSessionPolicy()
+
+	sessionMode, err := entities.NewSessionMode(mode)
+	if err != nil {
+		return policy, errors.Wrapf(entities.ErrInternal, "restore session mode failure: %v", err)
+	}
+	policy.Mode = sessionMode
+
+	if len(raw) == 0 {
+		return policy, nil
+	}
+
+	var policyDTO dto.SessionPolicyDTO
+	if err := json.Unmarshal(raw, &policyDTO); err != nil {
+		return policy, errors.Wrapf(entities.ErrInternal, "unmarshal session policy failure: %v",
+			err)
+	}
+
+	if policyDTO.Scoring.Strategy != "" {
+		strategy, err := entities.NewScoringStrategy(policyDTO.Scoring.Strategy,
+			policyDTO.Scoring.Penalty)
+		if err != nil {
+			return policy, errors.Wrapf(entities.ErrInternal, "restore scoring strategy failure: %v",
+				err)
+		}
+		policy.Scoring = strategy
+	}
+
+	if policyDTO.PassThreshold.Resolution != "" {
+		policy.PassThreshold.Resolution = policyDTO.PassThreshold.Resolution
+	}
+	policy.PassThreshold.Topics = policyDTO.PassThreshold.Topics
+	policy.Pause.MaxPauses = policyDTO.Pause.MaxPauses
+
+	visibility, err := entities.NewReviewVisibility(policyDTO.Review.Visibility)
+	if err != nil {
+		return policy, errors.Wrapf(entities.ErrInternal, "restore review visibility failure: %v",
+			err)
+	}
+	policy.Review.Visibility = visibility
+
+	if policyDTO.Shuffle != nil {
+		policy.Shuffle = entities.ShufflePolicy{
+			Variants:  policyDTO.Shuffle.Variants,
+			Questions: policyDTO.Shuffle.Questions,
+			Seed:      policyDTO.Shuffle.Seed,
+		}
+	}
+
+	return policy, nil
+}
@@ -0,0 +1,402 @@
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/pkg/errors"
+
+	"github.com/parta4ok/kvs/question/internal/entities"
+	"github.com/parta4ok/kvs/question/pkg/dto"
+)
+
+// insertResultSnapshotQuery stores the snapshot of the completed session. Completion time is
+// taken from the completed state of the session, so snapshots of sessions completed before
+// snapshots were stored keep their place in the history.
+const insertResultSnapshotQuery = `
+	INSERT INTO kvs.session_results (session_id, user_id, mode, topics, started_at, completed_at,
+	is_expired, is_passed, grade, score, pass_threshold, correct_count, total_count, outcome,
+	topic_scores, items)
+	SELECT $1, $2, $3, $4, $5, s.updated_at, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15
+	FROM kvs.sessions s
+	WHERE s.session_id = $1 AND s.state = 'completed state'
+	ON CONFLICT (session_id)`
+
+// storeResultSnapshot saves the result of the completed session as it was graded.
+func (s *Storage) storeResultSnapshot(ctx context.Context, tx pgx.Tx,
+	session *entities.Session) error {
+	snapshot, err := session.Snapshot()
+	if err != nil {
+		return errors.Wrap(err, "session Snapshot failure")
+	}
+
+	args, err := s.resultSnapshotArgs(snapshot)
+	if err != nil {
+		return err
+	}
+
+	if _, err := tx.Exec(ctx, insertResultSnapshotQuery+" DO NOTHING;", args...); err != nil {
+		return errors.Wrapf(entities.ErrInternal, "store result snapshot failure: %v", err)
+	}
+
+	return nil
+}
+
+// updateResultSnapshot rewrites the result of the rescored session, completion time stays the
+// same.
+func (s *Storage) updateResultSnapshot(ctx context.Context, tx pgx.Tx,
+	session *entities.Session) error {
+	snapshot, err := session.Snapshot()
+	if err != nil {
+		return errors.Wrap(err, "session Snapshot failure")
+	}
+
+	args, err := s.resultSnapshotArgs(snapshot)
+	if err != nil {
+		return err
+	}
+
+	query := insertResultSnapshotQuery + `
+	DO UPDATE SET is_passed = EXCLUDED.is_passed, grade = EXCLUDED.grade,
+	score = EXCLUDED.score, pass_threshold = EXCLUDED.pass_threshold,
+	correct_count = EXCLUDED.correct_count, total_count = EXCLUDED.total_count,
+	outcome = EXCLUDED.outcome, topic_scores = EXCLUDED.topic_scores, items = EXCLUDED.items,
+	rescored_at = NOW();`
+
+	if _, err := tx.Exec(ctx, query, args...); err != nil {
+		return errors.Wrapf(entities.ErrInternal, "update result snapshot failure: %v", err)
+	}
+
+	return nil
+}
+
+// StoreResultSnapshot saves the snapshot of the session completed before snapshots were
+// stored. The stored snapshot is never overwritten.
+func (s *Storage) StoreResultSnapshot(ctx context.Context,
+	snapshot *entities.ResultSnapshot) error {
+	slog.Info("StoreResultSnapshot started")
+
+	if snapshot == nil {
+		err := errors.Wrap(entities.ErrInvalidParam, "snapshot not set")
+		slog.Error(err.Error())
+		return err
+	}
+
+	args, err := s.resultSnapshotArgs(snapshot)
+	if err != nil {
+		slog.Error(err.Error())
+		return err
+	}
+
+	if _, err := s.db.Exec(ctx, insertResultSnapshotQuery+" DO NOTHING;", args...); err != nil {
+		err := errors.Wrapf(entities.ErrInternal, "store result snapshot failure: %v", err)
+		slog.Error(err.Error())
+		return err
+	}
+
+	slog.Info("StoreResultSnapshot completed")
+	return nil
+}
+
+// GetSessionsWithoutSnapshot returns positions of completed sessions after the cursor which
+// have no result snapshot, the oldest first. Nil cursor means from the start.
+func (s *Storage) GetSessionsWithoutSnapshot(ctx context.Context,
+	after *entities.BackfillCursor, limit int) ([]entities.BackfillCursor, error) {
+	slog.Info("GetSessionsWithoutSnapshot started")
+
+	query := `
+	SELECT s.session_id, s.updated_at
+	FROM kvs.sessions s
+	LEFT JOIN kvs.session_results r ON r.session_id = s.session_id
+	WHERE s.state = 'completed state' AND r.session_id IS NULL
+	AND ($1::TIMESTAMP IS NULL OR (s.updated_at, s.session_id) > ($1::TIMESTAMP, $2::TEXT))
+	ORDER BY s.updated_at, s.session_id
+	LIMIT $3;`
+
+	var (
+		afterCompletedAt *time.Time
+		afterSessionID   string
+	)
+	if after != nil {
+		afterCompletedAt = &after.CompletedAt
+		afterSessionID = after.SessionID
+	}
+
+	rows, err := s.db.Query(ctx, query, afterCompletedAt, afterSessionID, limit)
+	if err != nil {
+		err := errors.Wrapf(entities.ErrInternal, "search sessions without snapshot failure: %v",
+			err)
+		slog.Error(err.Error())
+		return nil, err
+	}
+	defer rows.Close()
+
+	sessions, err := scanBackfillCursors(rows)
+	if err != nil {
+		slog.Error(err.Error())
+		return nil, err
+	}
+
+	slog.Info("GetSessionsWithoutSnapshot completed")
+	return sessions, nil
+}
+
+// GetResultSnapshots returns the page of results of the completed sessions of the user with
+// totals of the sessions matching the filter. Filters, sorting and the cursor are applied in
+// SQL; one session more than the limit is selected to tell whether the next page exists.
+//
+//nolint:funlen //ok
+func (s *Storage) GetResultSnapshots(ctx context.Context, userID string,
+	query entities.HistoryQuery) (*entities.HistoryPage, error) {
+	slog.Info("GetResultSnapshots started")
+
+	conditions, args := s.historyConditions(userID, query.Filter)
+
+	totals, err := s.getHistoryTotals(ctx, conditions, args)
+	if err != nil {
+		slog.Error(err.Error())
+		return nil, err
+	}
+
+	sortColumns := "completed_at, session_id"
+	if query.Sort == entities.SortByScore {
+		sortColumns = "score, completed_at, session_id"
+	}
+
+	comparison, direction := "<", "DESC"
+	if query.Order == entities.Ascending {
+		comparison, direction = ">", "ASC"
+	}
+
+	// the page starts right after the cursor in the order of the sort columns
+	if cursor := query.After; cursor != nil {
+		values := []any{cursor.CompletedAt, cursor.SessionID}
+		if query.Sort == entities.SortByScore {
+			values = append([]any{cursor.Score}, values...)
+		}
+
+		placeholders := make([]string, 0, len(values))
+		for _, value := range values {
+			args = append(args, value)
+			placeholders = append(placeholders, fmt.Sprintf("$%d", len(args)))
+		}
+
+		conditions = append(conditions, fmt.Sprintf("(%s) %s (%s)", sortColumns, comparison,
+			strings.Join(placeholders, ", ")))
+	}
+
+	orderBy := strings.ReplaceAll(sortColumns, ",", " "+direction+",") + " " + direction
+	args = append(args, query.Limit+1)
+
+	//nolint:gosec // ok, only columns and placeholders are formatted
+	sql := fmt.Sprintf(`
+	SELECT session_id, user_id, mode, topics, started_at, completed_at, is_expired, is_passed,
+	grade, score, pass_threshold, correct_count, total_count, outcome, topic_scores, items
+	FROM kvs.session_results
+	WHERE %s
+	ORDER BY %s
+	LIMIT $%d;`, strings.Join(conditions, " AND "), orderBy, len(args))
+
+	rows, err := s.db.Query(ctx, sql, args...)
+	if err != nil {
+		err := errors.Wrapf(entities.ErrInternal, "search result snapshots failure: %v", err)
+		slog.Error(err.Error())
+		return nil, err
+	}
+	defer rows.Close()
+
+	snapshots := make([]*entities.ResultSnapshot, 0, query.Limit+1)
+
+	for rows.Next() {
+		snapshot, err := s.scanResultSnapshot(rows)
+		if err != nil {
+			slog.Error(err.Error())
+			return nil, err
+		}
+		snapshots = append(snapshots, snapshot)
+	}
+
+	if err := rows.Err(); err != nil {
+		err := errors.Wrapf(entities.ErrInternal, "rows err: %v", err)
+		slog.Error(err.Error())
+		return nil, err
+	}
+
+	slog.Info("GetResultSnapshots completed")
+	return query.NewPage(snapshots, totals), nil
+}
+
+// historyConditions returns conditions of the history filter joined by AND and their
+// arguments, the user is always the first argument.
+func (s *Storage) historyConditions(userID string, filter entities.HistoryFilter) ([]string,
+	[]any) {
+	conditions := []string{"user_id = $1"}
+	args := []any{userID}
+
+	if filter.Topic != "" {
+		args = append(args, filter.Topic)
+		conditions = append(conditions, fmt.Sprintf("topics @> ARRAY[$%d::TEXT]", len(args)))
+	}
+
+	if !filter.From.IsZero() {
+		args = append(args, filter.From.UTC())
+		conditions = append(conditions, fmt.Sprintf("completed_at >= $%d", len(args)))
+	}
+
+	if !filter.To.IsZero() {
+		args = append(args, filter.To.UTC())
+		conditions = append(conditions, fmt.Sprintf("completed_at < $%d", len(args)))
+	}
+
+	if len(filter.Outcomes) != 0 {
+		outcomes := make([]string, 0, len(filter.Outcomes))
+		for _, outcome := range filter.Outcomes {
+			outcomes = append(outcomes, string(outcome))
+		}
+		args = append(args, outcomes)
+		conditions = append(conditions, fmt.Sprintf("outcome = ANY($%d::TEXT[])", len(args)))
+	}
+
+	return conditions, args
+}
+
+func (s *Storage) getHistoryTotals(ctx context.Context, conditions []string, args []any) (
+	entities.HistoryTotals, error) {
+	//nolint:gosec // ok, only placeholders are formatted
+	sql := fmt.Sprintf(`
+	SELECT COUNT(*),
+	COUNT(*) FILTER (WHERE outcome = 'passed'),
+	COUNT(*) FILTER (WHERE outcome = 'failed'),
+	COUNT(*) FILTER (WHERE outcome = 'expired')
+	FROM kvs.session_results
+	WHERE %s;`, strings.Join(conditions, " AND "))
+
+	var totals entities.HistoryTotals
+	if err := s.db.QueryRow(ctx, sql, args...).Scan(&totals.Total, &totals.Passed,
+		&totals.Failed, &totals.Expired); err != nil {
+		return totals, errors.Wrapf(entities.ErrInternal, "count result snapshots failure: %v",
+			err)
+	}
+
+	return totals, nil
+}
+
+func (s *Storage) scanResultSnapshot(rows pgx.Rows) (*entities.ResultSnapshot, error) {
+	var (
+		snapshot       entities.ResultSnapshot
+		result         entities.SessionResult
+		mode           string
+		outcome        string
+		topicScoresRaw []byte
+		itemsRaw       []byte
+	)
+
+	if err := rows.Scan(&snapshot.SessionID, &snapshot.UserID, &mode, &snapshot.Topics,
+		&snapshot.StartedAt, &snapshot.CompletedAt, &snapshot.IsExpired, &result.IsSuccess,
+		&result.Grade, &result.Score, &result.PassThreshold, &result.CorrectCount,
+		&result.TotalCount, &outcome, &topicScoresRaw, &itemsRaw); err != nil {
+		return nil, errors.Wrapf(entities.ErrInternal, "scan result snapshot failure: %v", err)
+	}
+
+	var topicScoresDTO []dto.TopicScoreDTO
+	if err := json.Unmarshal(topicScoresRaw, &topicScoresDTO); err != nil {
+		return nil, errors.Wrapf(entities.ErrInternal, "unmarshal topic scores failure: %v", err)
+	}
+
+	result.TopicScores = dto.ToTopicScores(topicScoresDTO)
+
+	items, err := s.decodeResultItems(itemsRaw)
+	if err != nil {
+		return nil, err
+	}
+
+	snapshot.Mode = entities.SessionMode(mode)
+	result.UserID = snapshot.UserID
+	result.Topics = snapshot.Topics
+	result.IsExpire = snapshot.IsExpired
+	result.Outcome = entities.Outcome(outcome)
+	result.Mode = snapshot.Mode
+	snapshot.Result = &result
+	snapshot.Items = items
+
+	return &snapshot, nil
+}
+
+func (s *Storage) resultSnapshotArgs(snapshot *entities.ResultSnapshot) ([]any, error) {
+	result := snapshot.Result
+
+	topicScores, err := json.Marshal(dto.NewTopicScoresDTO(result.TopicScores))
+	if err != nil {
+		return nil, errors.Wrapf(entities.ErrInternal, "marshal topic scores failure: %v", err)
+	}
+
+	items, err := s.encodeResultItems(snapshot.Items)
+	if err != nil {
+		return nil, err
+	}
+
+	return []any{snapshot.SessionID, snapshot.UserID, string(snapshot.Mode), snapshot.Topics,
+		snapshot.StartedAt, snapshot.IsExpired, result.IsSuccess, result.Grade, result.Score,
+		result.PassThreshold, result.CorrectCount, result.TotalCount, string(result.Outcome),
+		topicScores, items}, nil
+}
+
+func (s *Storage) encodeResultItems(items []entities.ResultItem) ([]byte, error) {
+	itemsDTO := make([]dto.ResultItemDTO, 0, len(items))
+	for _, item := range items {
+		itemsDTO = append(itemsDTO, dto.ResultItemDTO{
+			QuestionID:   item.QuestionID,
+			Version:      item.Version,
+			QuestionType: item.Type.String(),
+			Topic:        item.Topic,
+			Subject:      item.Subject,
+			Weight:       item.Weight,
+			Answered:     item.Answered,
+			Selections:   item.Selections,
+			IsCorrect:    item.IsCorrect,
+			Credit:       item.Credit,
+		})
+	}
+
+	raw, err := json.Marshal(itemsDTO)
+	if err != nil {
+		return nil, errors.Wrapf(entities.ErrInternal, "marshal result items failure: %v", err)
+	}
+
+	return raw, nil
+}
+
+func (s *Storage) decodeResultItems(raw []byte) ([]entities.ResultItem, error) {
+	var itemsDTO []dto.ResultItemDTO
+	if err := json.Unmarshal(raw, &itemsDTO); err != nil {
+		return nil, errors.Wrapf(entities.ErrInternal, "unmarshal result items failure: %v", err)
+	}
+
+	items := make([]entities.ResultItem, 0, len(itemsDTO))
+	for _, itemDTO := range itemsDTO {
+		questionType, err := entities.ParseQuestionType(itemDTO.QuestionType)
+		if err != nil {
+			return nil, errors.Wrapf(entities.ErrInternal, "parse question type failure: %v", err)
+		}
+
+		items = append(items, entities.ResultItem{
+			QuestionID: itemDTO.QuestionID,
+			Version:    itemDTO.Version,
+			Type:       questionType,
+			Topic:      itemDTO.Topic,
+			Subject:    itemDTO.Subject,
+			Weight:     itemDTO.Weight,
+			Answered:   itemDTO.Answered,
+			Selections: itemDTO.Selections,
+			IsCorrect:  itemDTO.IsCorrect,
+			Credit:     itemDTO.Credit,
+		})
+	}
+
+	return items, nil
+}
@@ -21,12 +21,19 @@ import (
 )
 
 var (
-	_ cases.Storage           = (*Storage)(nil)
-	_ entities.SessionStorage = (*Storage)(nil)
+	_ cases.Storage             = (*Storage)(nil)
+	_ cases.QuestionBankStorage = (*Storage)(nil)
+	_ cases.AttemptStorage      = (*Storage)(nil)
+	_ cases.ReportStorage       = (*Storage)(nil)
+	_ cases.ItemAnalysisStorage = (*Storage)(nil)
 )
 
 const (
+	// Deprecated: sessions pass the questions limit to GetQuesions, DefaultTopicLimit is only
+	// used when the passed limit is not positive.
 	DefaultTopicLimit = 10
+	// topicHistoryDepth is how many recent sessions of the topic the history is built of.
+	topicHistoryDepth = 10
 )
 
 type Storage struct {
@@ -39,6 +46,8 @@ type Storage struct {
 
 type StorageOption func(s *Storage)
 
+// Deprecated: sessions pass the questions limit to GetQuesions, the option only sets the limit
+// used when the passed one is not positive.
 func WithQuestionsLimit(questionsLimit int) StorageOption {
 	return func(s *Storage) {
 		s.questionsLimits = questionsLimit
@@ -83,7 +92,7 @@ func (s *Storage) Close() {
 
 func (s *Storage) GetTopics(ctx context.Context) ([]string, error) {
 	slog.Info("GetTopics started")
-	query := `SELECT t.name FROM kvs.topics t`
+	query := `SELECT t.name FROM kvs.topics t WHERE t.is_active`
 
 	rows, err := s.db.Query(ctx, query)
 	if err != nil {
@@ -115,8 +124,184 @@ func (s *Storage) GetTopics(ctx context.Context) ([]string, error) {
 	return topics, nil
 }
 
-//nolint:funlen //ok
-func (s *Storage) GetQuesions(ctx context.Context, topics []string) (
+// GetPassThresholds returns pass thresholds of the requested topics by topic name.
+func (s *Storage) GetPassThresholds(ctx context.Context, topics []string) (
+	map[string]float64, error) {
+	slog.Info("GetPassThresholds started")
+
+	query := `
+	SELECT t.name, t.pass_threshold FROM kvs.topics t 
+	WHERE t.name = ANY($1) AND t.is_active;`
+
+	rows, err := s.db.Query(ctx, query, topics)
+	if err != nil {
+		err := errors.Wrapf(entities.ErrInternal, "getting pass thresholds failure: %v", err)
+		slog.Error(err.Error())
+		return nil, err
+	}
+	defer rows.Close()
+
+	thresholds := make(map[string]float64, len(topics))
+
+	for rows.Next() {
+		var (
+			topicName string
+			threshold float64
+		)
+		if err := rows.Scan(&topicName, &threshold); err != nil {
+			err := errors.Wrapf(entities.ErrInternal, "scan pass threshold failure: %v", err)
+			slog.Error(err.Error())
+			return nil, err
+		}
+		thresholds[topicName] = threshold
+	}
+
+	if err := rows.Err(); err != nil {
+		err := errors.Wrapf(entities.ErrInternal, "rows err: %v", err)
+		slog.Error(err.Error())
+		return nil, err
+	}
+
+	slog.Info("GetPassThresholds completed")
+	return thresholds, nil
+}
+
+// GetPauseLimits returns pause limits of the requested topics by topic name.
+func (s *Storage) GetPauseLimits(ctx context.Context, topics []string) (map[string]int, error) {
+	slog.Info("GetPauseLimits started")
+
+	query := `
+	SELECT t.name, t.max_pauses FROM kvs.topics t 
+	WHERE t.name = ANY($1) AND t.is_active;`
+
+	rows, err := s.db.Query(ctx, query, topics)
+	if err != nil {
+		err := errors.Wrapf(entities.ErrInternal, "getting pause limits failure: %v", err)
+		slog.Error(err.Error())
+		return nil, err
+	}
+	defer rows.Close()
+
+	limits := make(map[string]int, len(topics))
+
+	for rows.Next() {
+		var (
+			topicName string
+			maxPauses int
+		)
+		if err := rows.Scan(&topicName, &maxPauses); err != nil {
+			err := errors.Wrapf(entities.ErrInternal, "scan pause limit failure: %v", err)
+			slog.Error(err.Error())
+			return nil, err
+		}
+		limits[topicName] = maxPauses
+	}
+
+	if err := rows.Err(); err != nil {
+		err := errors.Wrapf(entities.ErrInternal, "rows err: %v", err)
+		slog.Error(err.Error())
+		return nil, err
+	}
+
+	slog.Info("GetPauseLimits completed")
+	return limits, nil
+}
+
+// GetSelectionStrategies returns question selection strategies of the requested topics by topic
+// name.
+func (s *Storage) GetSelectionStrategies(ctx context.Context, topics []string) (
+	map[string]entities.SelectionStrategy, error) {
+	slog.Info("GetSelectionStrategies started")
+
+	query := `
+	SELECT t.name, t.selection_strategy FROM kvs.topics t
+	WHERE t.name = ANY($1) AND t.is_active;`
+
+	rows, err := s.db.Query(ctx, query, topics)
+	if err != nil {
+		err := errors.Wrapf(entities.ErrInternal, "getting selection strategies failure: %v", err)
+		slog.Error(err.Error())
+		return nil, err
+	}
+	defer rows.Close()
+
+	strategies := make(map[string]entities.SelectionStrategy, len(topics))
+
+	for rows.Next() {
+		var topicName, strategy string
+		if err := rows.Scan(&topicName, &strategy); err != nil {
+			err := errors.Wrapf(entities.ErrInternal, "scan selection strategy failure: %v", err)
+			slog.Error(err.Error())
+			return nil, err
+		}
+		strategies[topicName] = entities.SelectionStrategy(strategy)
+	}
+
+	if err := rows.Err(); err != nil {
+		err := errors.Wrapf(entities.ErrInternal, "rows err: %v", err)
+		slog.Error(err.Error())
+		return nil, err
+	}
+
+	slog.Info("GetSelectionStrategies completed")
+	return strategies, nil
+}
+
+// GetTopicHistory returns average score of the recent completed exam sessions of the user
+// containing the requested topics by topic name.
+func (s *Storage) GetTopicHistory(ctx context.Context, userID string, topics []string) (
+	map[string]entities.TopicHistory, error) {
+	slog.Info("GetTopicHistory started")
+
+	query := `
+	SELECT recent.topic, COUNT(*), AVG(recent.score)
+	FROM (
+		SELECT topic.name AS topic, s.score,
+		ROW_NUMBER() OVER (PARTITION BY topic.name ORDER BY s.updated_at DESC) AS rn
+		FROM kvs.sessions s, unnest(s.topics) AS topic(name)
+		WHERE s.user_id = $1 AND s.state = 'completed state' AND s.mode = 'exam'
+		AND s.score IS NOT NULL AND topic.name = ANY($2)
+	) recent
+	WHERE recent.rn <= $3
+	GROUP BY recent.topic;`
+
+	rows, err := s.db.Query(ctx, query, userID, topics, topicHistoryDepth)
+	if err != nil {
+		err := errors.Wrapf(entities.ErrInternal, "getting topic history failure: %v", err)
+		slog.Error(err.Error())
+		return nil, err
+	}
+	defer rows.Close()
+
+	history := make(map[string]entities.TopicHistory, len(topics))
+
+	for rows.Next() {
+		var (
+			topicName    string
+			topicHistory entities.TopicHistory
+		)
+		if err := rows.Scan(&topicName, &topicHistory.Sessions,
+			&topicHistory.AverageScore); err != nil {
+			err := errors.Wrapf(entities.ErrInternal, "scan topic history failure: %v", err)
+			slog.Error(err.Error())
+			return nil, err
+		}
+		history[topicName] = topicHistory
+	}
+
+	if err := rows.Err(); err != nil {
+		err := errors.Wrapf(entities.ErrInternal, "rows err: %v", err)
+		slog.Error(err.Error())
+		return nil, err
+	}
+
+	slog.Info("GetTopicHistory completed")
+	return history, nil
+}
+
+// GetQuesions returns up to limit random active questions of every requested topic. Not positive
+// limit means the storage questions limit.
+func (s *Storage) GetQuesions(ctx context.Context, topics []string, limit int) (
 	[]entities.Question, error) {
 	slog.Info("GetQuesions started")
 
@@ -124,22 +309,25 @@ func (s *Storage) GetQuesions(ctx context.Context, topics []string) (
 		return nil, err
 	}
 
-	params := []interface{}{topics, s.questionsLimits}
+	if limit <= 0 {
+		limit = s.questionsLimits
+	}
 
 	query := `
-	SELECT 
-    question_id, question_type, topic, subject, variants, correct_answers 
+	SELECT
+	question_id, question_type, topic, subject, variants, correct_answers, options, weight,
+	explanation, difficulty, version
 	FROM (
-		SELECT q.*, qt.name AS question_type, t.name AS topic, 
+		SELECT q.*, qt.name AS question_type, t.name AS topic,
 		ROW_NUMBER() OVER (PARTITION BY t.topic_id ORDER BY random()) AS rn
-    	FROM kvs.questions q
-    	JOIN kvs.topics t ON q.topic_id = t.topic_id
-    	JOIN kvs.question_types qt ON q.question_type_id = qt.id
-    	WHERE t.name = ANY($1)
+		FROM kvs.questions q
+		JOIN kvs.topics t ON q.topic_id = t.topic_id
+		JOIN kvs.question_types qt ON q.question_type_id = qt.id
+		WHERE t.name = ANY($1) AND t.is_active AND q.is_active
 	) random_questions
 	WHERE rn <= $2;`
 
-	rows, errDB := s.db.Query(ctx, query, params...)
+	rows, errDB := s.db.Query(ctx, query, topics, limit)
 	if errDB != nil {
 		err := errors.Wrapf(entities.ErrInternal, "get questions from db failure: %v", errDB)
 		slog.Error(err.Error())
@@ -158,6 +346,87 @@ func (s *Storage) GetQuesions(ctx context.Context, topics []string) (
 	return questions, nil
 }
 
+// GetQuestionsByDifficulty returns up to limit random active questions of every difficulty of
+// every requested topic.
+func (s *Storage) GetQuestionsByDifficulty(ctx context.Context, topics []string, limit int) (
+	[]entities.Question, error) {
+	slog.Info("GetQuestionsByDifficulty started")
+
+	if err := s.checkTopics(ctx, topics); err != nil {
+		return nil, err
+	}
+
+	if limit <= 0 {
+		limit = s.questionsLimits
+	}
+
+	query := `
+	SELECT
+	question_id, question_type, topic, subject, variants, correct_answers, options, weight,
+	explanation, difficulty, version
+	FROM (
+		SELECT q.*, qt.name AS question_type, t.name AS topic,
+		ROW_NUMBER() OVER (PARTITION BY t.topic_id, q.difficulty ORDER BY random()) AS rn
+		FROM kvs.questions q
+		JOIN kvs.topics t ON q.topic_id = t.topic_id
+		JOIN kvs.question_types qt ON q.question_type_id = qt.id
+		WHERE t.name = ANY($1) AND t.is_active AND q.is_active
+	) random_questions
+	WHERE rn <= $2;`
+
+	rows, errDB := s.db.Query(ctx, query, topics, limit)
+	if errDB != nil {
+		err := errors.Wrapf(entities.ErrInternal, "get questions from db failure: %v", errDB)
+		slog.Error(err.Error())
+		return nil, err
+	}
+	defer rows.Close()
+
+	questions, err := s.processingQuestionsRows(ctx, rows)
+	if err != nil {
+		err := errors.Wrap(err, "processingQuestionsRows")
+		slog.Error(err.Error())
+		return nil, err
+	}
+
+	slog.Info("GetQuestionsByDifficulty completed")
+	return questions, nil
+}
+
+// GetActiveQuestions returns the requested questions which are active and belong to active
+// topics.
+func (s *Storage) GetActiveQuestions(ctx context.Context, questionIDs []string) (
+	[]entities.Question, error) {
+	slog.Info("GetActiveQuestions started")
+
+	query := `
+	SELECT q.question_id, qt.name AS question_type_name, t.name AS topic_name, q.subject,
+	q.variants, q.correct_answers, q.options, q.weight, q.explanation, q.difficulty, q.version
+	FROM kvs.questions q
+	JOIN kvs.topics t ON q.topic_id = t.topic_id
+	JOIN kvs.question_types qt ON q.question_type_id = qt.id
+	WHERE q.question_id = ANY($1::BIGINT[]) AND t.is_active AND q.is_active
+	ORDER BY q.question_id;`
+
+	rows, errDB := s.db.Query(ctx, query, questionIDs)
+	if errDB != nil {
+		err := errors.Wrapf(entities.ErrInternal, "get questions from db failure: %v", errDB)
+		slog.Error(err.Error())
+		return nil, err
+	}
+	defer rows.Close()
+
+	questions, err := s.processingQuestionsRows(ctx, rows)
+	if err != nil {
+		err := errors.Wrap(err, "processingQuestionsRows")
+		slog.Error(err.Error())
+		return nil, err
+	}
+
+	slog.Info("GetActiveQuestions completed")
+	return questions, nil
+}
+
 //nolint:funlen //ok
 func (s *Storage) StoreSession(ctx context.Context, session *entities.Session) error {
 	slog.Info("StoreSession started")
@@ -167,18 +436,25 @@ func (s *Storage) StoreSession(ctx context.Context, session *entities.Session) e
 	sessionStatus := session.GetStatus()
 	topics := session.GetTopics()
 
+	policy, err := s.encodeSessionPolicy(session.GetPolicy())
+	if err != nil {
+		slog.Error(err.Error())
+		return err
+	}
+
 	parameters := make([]interface{}, 0)
-	parameters = append(parameters, sessionID, userID, sessionStatus, topics)
+	parameters = append(parameters, sessionID, userID, sessionStatus, topics, policy,
+		string(session.GetPolicy().Mode))
 
-	query := `INSERT INTO kvs.sessions (session_id, user_id, state, topics`
+	query := `INSERT INTO kvs.sessions (session_id, user_id, state, topics, policy, mode`
 
 	switch sessionStatus {
 	case entities.InitState:
 		query += s.makeInitStateSessionQuery()
-	case entities.ActiveState:
+	case entities.ActiveState, entities.PausedState:
 		query += s.makeActiveStateSessionQuery()
 
-		questionsIDs, err := s.getQuestionsIDs(session)
+		questionsIDs, versions, err := s.getQuestionsRefs(session)
 		if err != nil {
 			slog.Error(err.Error())
 			return err
@@ -198,14 +474,28 @@ func (s *Storage) StoreSession(ctx context.Context, session *entities.Session) e
 			return err
 		}
 
-		parameters = append(parameters, questionsIDs, startedAt, duration)
+		clock, err := session.GetClock()
+		if err != nil {
+			err := errors.Wrap(err, "session GetClock failure")
+			slog.Error(err.Error())
+			return err
+		}
+
+		// resumed_at stays NULL while the session is paused
+		var resumedAt *time.Time
+		if clock.IsRunning() {
+			resumedAt = &clock.RunningSince
+		}
+
+		parameters = append(parameters, questionsIDs, startedAt, duration, clock.Elapsed,
+			resumedAt, clock.Pauses, versions)
 
 	case entities.CompletedState:
 		query += s.makeCompletedStateSessionQuery()
 
-		questionsIDs, err := s.getQuestionsIDs(session)
+		questionsIDs, versions, err := s.getQuestionsRefs(session)
 		if err != nil {
-			err := errors.Wrap(err, "getQuestionsIDs failure")
+			err := errors.Wrap(err, "getQuestionsRefs failure")
 			slog.Error(err.Error())
 			return err
 		}
@@ -247,6 +537,13 @@ func (s *Storage) StoreSession(ctx context.Context, session *entities.Session) e
 			return err
 		}
 
+		duration, err := session.GetSessionDurationLimit()
+		if err != nil {
+			err := errors.Wrap(err, "session GetSessionDurationLimit failure")
+			slog.Error(err.Error())
+			return err
+		}
+
 		sesseionResult, err := session.GetSessionResult()
 		if err != nil {
 			err := errors.Wrap(err, "session GetSessionResult failure")
@@ -255,27 +552,128 @@ func (s *Storage) StoreSession(ctx context.Context, session *entities.Session) e
 		}
 
 		parameters = append(parameters, questionsIDs, startedAt, answersListJSON, isExpired,
-			sesseionResult.IsSuccess, sesseionResult.Grade)
+			sesseionResult.IsSuccess, sesseionResult.Grade, sesseionResult.PassThreshold,
+			sesseionResult.Score, sesseionResult.CorrectCount, sesseionResult.TotalCount,
+			string(sesseionResult.Outcome), duration, versions)
 	}
 
-	_, err := s.db.Exec(ctx, query, parameters...)
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		err = errors.Wrapf(entities.ErrInternal, "begin transaction failure: %v", err)
+		slog.Error(err.Error())
+		return err
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	// writes of one session are serialized, so the completed row inserted by a concurrent
+	// transaction is seen by the guard of the active and paused rows
+	if _, err := tx.Exec(ctx, `SELECT pg_advisory_xact_lock(hashtext($1));`,
+		sessionID); err != nil {
+		err = errors.Wrapf(entities.ErrInternal, "lock session failure: %v", err)
+		slog.Error(err.Error())
+		return err
+	}
+
+	tag, err := tx.Exec(ctx, query, parameters...)
 	if err != nil {
 		err = errors.Wrapf(entities.ErrInternal, "store session finished with failure: %v", err)
 		slog.Error(err.Error())
 		return err
 	}
 
+	if tag.RowsAffected() == 0 {
+		err = errors.Wrapf(entities.ErrConflict, "session %s already completed", sessionID)
+		slog.Warn(err.Error())
+		return err
+	}
+
+	if err := s.storeSessionDeadline(ctx, tx, session); err != nil {
+		slog.Error(err.Error())
+		return err
+	}
+
+	if sessionStatus == entities.CompletedState {
+		if err := s.deleteDrafts(ctx, tx, sessionID); err != nil {
+			slog.Error(err.Error())
+			return err
+		}
+
+		if err := s.storeAnswerOutcomes(ctx, tx, session); err != nil {
+			slog.Error(err.Error())
+			return err
+		}
+
+		if err := s.storeSessionQuestionVersions(ctx, tx, session); err != nil {
+			slog.Error(err.Error())
+			return err
+		}
+
+		if err := s.storeResultSnapshot(ctx, tx, session); err != nil {
+			slog.Error(err.Error())
+			return err
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		err = errors.Wrapf(entities.ErrInternal, "commit transaction failure: %v", err)
+		slog.Error(err.Error())
+		return err
+	}
+
 	slog.Info("StoreSession completed")
 	return nil
 }
 
+// GetExpiredSessionIDs returns running sessions which deadline passed before now. The deadlines
+// are kept by StoreSession in kvs.session_deadlines, paused and practice sessions have none.
+func (s *Storage) GetExpiredSessionIDs(ctx context.Context, now time.Time, limit int) (
+	[]string, error) {
+	slog.Info("GetExpiredSessionIDs started")
+
+	query := `
+	SELECT d.session_id FROM kvs.session_deadlines d
+	WHERE d.deadline < $1
+	ORDER BY d.deadline
+	LIMIT $2;`
+
+	rows, err := s.db.Query(ctx, query, now, limit)
+	if err != nil {
+		err := errors.Wrapf(entities.ErrInternal, "search expired sessions failure: %v", err)
+		slog.Error(err.Error())
+		return nil, err
+	}
+	defer rows.Close()
+
+	sessionIDs := make([]string, 0)
+
+	for rows.Next() {
+		var sessionID string
+		if err := rows.Scan(&sessionID); err != nil {
+			err := errors.Wrapf(entities.ErrInternal, "scan session id failure: %v", err)
+			slog.Error(err.Error())
+			return nil, err
+		}
+		sessionIDs = append(sessionIDs, sessionID)
+	}
+
+	if err := rows.Err(); err != nil {
+		err := errors.Wrapf(entities.ErrInternal, "rows err: %v", err)
+		slog.Error(err.Error())
+		return nil, err
+	}
+
+	slog.Info("GetExpiredSessionIDs completed")
+	return sessionIDs, nil
+}
+
 func (s *Storage) GetSessionBySessionID(ctx context.Context, sessionID string) (*entities.Session,
 	error) {
 	slog.Info("GetSessionBySessionID started")
 
 	query := `
 	SELECT s.user_id, s.state, s.topics, s.questions, s.answers, s.created_at, 
-	s.duration_limit, s.is_expired
+	s.duration_limit, s.is_expired, s.policy, s.elapsed, s.resumed_at, s.pause_count, s.mode,
+	s.question_versions
 	FROM kvs.sessions s 
 	WHERE s.session_id = $1
 	ORDER BY s.updated_at DESC
@@ -288,14 +686,21 @@ func (s *Storage) GetSessionBySessionID(ctx context.Context, sessionID string) (
 		stateName      string
 		topics         []string
 		questionsIDs   []string
+		versions       []int
 		answersRaw     []byte
 		createdAt      *time.Time
 		duration_limit uint64
 		isExpired      *bool
+		policyRaw      []byte
+		elapsed        int64
+		resumedAt      *time.Time
+		pauseCount     int
+		mode           string
 	)
 
 	err := row.Scan(&userID, &stateName, &topics, &questionsIDs, &answersRaw,
-		&createdAt, &duration_limit, &isExpired)
+		&createdAt, &duration_limit, &isExpired, &policyRaw, &elapsed, &resumedAt, &pauseCount,
+		&mode, &versions)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
 			err = errors.Wrapf(entities.ErrNotFound, "not found session with requested id: %v", err)
@@ -307,29 +712,44 @@ func (s *Storage) GetSessionBySessionID(ctx context.Context, sessionID string) (
 		return nil, err
 	}
 
+	policy, err := s.decodeSessionPolicy(policyRaw, mode)
+	if err != nil {
+		slog.Error(err.Error())
+		return nil, err
+	}
+
+	// sessions stored before pauses were introduced have no resumed_at and run since creation
+	clock := entities.SessionClock{Elapsed: time.Duration(elapsed), Pauses: pauseCount}
+	if resumedAt != nil {
+		clock.RunningSince = *resumedAt
+	} else if stateName == entities.ActiveState && createdAt != nil {
+		clock.RunningSince = *createdAt
+	}
+
 	slog.Info("GetSessionBySessionID completed")
-	return s.recoverSession(ctx, sessionID, stateName, userID, topics, questionsIDs,
-		duration_limit, answersRaw, createdAt, isExpired)
+	return s.recoverSession(ctx, sessionID, stateName, userID, topics, questionsIDs, versions,
+		duration_limit, answersRaw, createdAt, isExpired, policy, clock)
 }
 
 //nolint:funlen //ok
 func (s *Storage) recoverSession(ctx context.Context, sessionID string, stateName string,
-	userID string, topics []string, questionsIDs []string, duration_limit uint64, answersRaw []byte,
-	createdAt *time.Time, isExpired *bool) (*entities.Session, error) {
+	userID string, topics []string, questionsIDs []string, versions []int, duration_limit uint64,
+	answersRaw []byte, createdAt *time.Time, isExpired *bool, policy entities.SessionPolicy,
+	clock entities.SessionClock) (*entities.Session, error) {
 	slog.Info("recoverSession started")
 
 	switch stateName {
 	case entities.InitState:
 		initSession, err := entities.NewSession(userID, topics,
-			cryptoprocessing.NewUint64Generator(), s, entities.WithSessionID(sessionID),
-			entities.WithNilState())
+			cryptoprocessing.NewUint64Generator(), entities.WithSessionID(sessionID),
+			entities.WithNilState(), entities.WithPolicy(policy))
 		if err != nil {
 			err = errors.Wrap(err, "creating new session with sessionID option failure")
 			slog.Error(err.Error())
 			return nil, err
 		}
 
-		state := entities.NewInitSessionState(initSession, s)
+		state := entities.NewInitSessionState(initSession, entities.WithInitStatePolicy(policy))
 		initSession.ChangeState(state)
 
 		slog.Info("recoverSession completed")
@@ -337,17 +757,17 @@ func (s *Storage) recoverSession(ctx context.Context, sessionID string, stateNam
 
 	case entities.ActiveState:
 		activeSession, err := entities.NewSession(userID, topics,
-			cryptoprocessing.NewUint64Generator(), s, entities.WithSessionID(sessionID),
-			entities.WithNilState())
+			cryptoprocessing.NewUint64Generator(), entities.WithSessionID(sessionID),
+			entities.WithNilState(), entities.WithPolicy(policy))
 		if err != nil {
 			err = errors.Wrap(err, "creating new session with sessionID option failure")
 			slog.Error(err.Error())
 			return nil, err
 		}
 
-		questions, err := s.getQuestionsByID(ctx, questionsIDs)
+		questions, err := s.getSessionQuestions(ctx, questionsIDs, versions)
 		if err != nil {
-			err = errors.Wrap(err, "getQuestionsByID failure")
+			err = errors.Wrap(err, "getSessionQuestions failure")
 			slog.Error(err.Error())
 			return nil, err
 		}
@@ -357,25 +777,55 @@ func (s *Storage) recoverSession(ctx context.Context, sessionID string, stateNam
 			questionsMap[question.ID()] = question
 		}
 		state := entities.NewActiveSessionState(questionsMap, activeSession,
-			time.Microsecond*time.Duration(duration_limit), entities.WithStartedAt(*createdAt)) //nolint:gosec,lll // ok
+			time.Duration(duration_limit), entities.WithStartedAt(*createdAt), //nolint:gosec // ok
+			entities.WithActiveClock(clock), entities.WithActiveStatePolicy(policy))
 		activeSession.ChangeState(state)
 
 		slog.Info("recoverSession completed")
 		return activeSession, nil
 
+	case entities.PausedState:
+		pausedSession, err := entities.NewSession(userID, topics,
+			cryptoprocessing.NewUint64Generator(), entities.WithSessionID(sessionID),
+			entities.WithNilState(), entities.WithPolicy(policy))
+		if err != nil {
+			err = errors.Wrap(err, "creating new session with sessionID option failure")
+			slog.Error(err.Error())
+			return nil, err
+		}
+
+		questions, err := s.getSessionQuestions(ctx, questionsIDs, versions)
+		if err != nil {
+			err = errors.Wrap(err, "getSessionQuestions failure")
+			slog.Error(err.Error())
+			return nil, err
+		}
+
+		questionsMap := make(map[string]entities.Question, len(questions))
+		for _, question := range questions {
+			questionsMap[question.ID()] = question
+		}
+		state := entities.NewPausedSessionState(questionsMap, pausedSession,
+			time.Duration(duration_limit), *createdAt, clock, //nolint:gosec // ok
+			entities.WithPausedStatePolicy(policy))
+		pausedSession.ChangeState(state)
+
+		slog.Info("recoverSession completed")
+		return pausedSession, nil
+
 	case entities.CompletedState:
 		completedSession, err := entities.NewSession(userID, topics,
-			cryptoprocessing.NewUint64Generator(), s, entities.WithSessionID(sessionID),
-			entities.WithNilState())
+			cryptoprocessing.NewUint64Generator(), entities.WithSessionID(sessionID),
+			entities.WithNilState(), entities.WithPolicy(policy))
 		if err != nil {
 			err = errors.Wrap(err, "creating new session with sessionID option failure")
 			slog.Error(err.Error())
 			return nil, err
 		}
 
-		questions, err := s.getQuestionsByID(ctx, questionsIDs)
+		questions, err := s.getSessionQuestions(ctx, questionsIDs, versions)
 		if err != nil {
-			err = errors.Wrap(err, "getQuestionsByID failure")
+			err = errors.Wrap(err, "getSessionQuestions failure")
 			slog.Error(err.Error())
 			return nil, err
 		}
@@ -404,7 +854,8 @@ func (s *Storage) recoverSession(ctx context.Context, sessionID string, stateNam
 		}
 
 		state := entities.NewCompletedSessionState(questionsMap, completedSession,
-			answers, *createdAt, *isExpired)
+			answers, *createdAt, *isExpired, entities.WithCompletedStatePolicy(policy),
+			entities.WithCompletedDurationLimit(time.Duration(duration_limit))) //nolint:gosec // ok
 		completedSession.ChangeState(state)
 
 		slog.Info("recoverSession completed")
@@ -422,7 +873,7 @@ func (s *Storage) getQuestionsByID(ctx context.Context, questionsIDs []string) (
 
 	query := `
 	SELECT q.question_id, qt.name AS question_type_name, t.name AS topic_name, q.subject, 
-	q.variants, q.correct_answers
+	q.variants, q.correct_answers, q.options, q.weight, q.explanation, q.difficulty, q.version
 	FROM 
     kvs.questions q
 	JOIN kvs.question_types qt ON q.question_type_id = qt.id
@@ -468,26 +919,38 @@ func (s *Storage) processingQuestionsRows(_ context.Context, rows pgx.Rows) ([]e
 			subject       string
 			variants      []string
 			correctAnswer []string
+			optionsRaw    []byte
+			weight        float64
+			explanation   string
+			difficulty    string
+			version       int
 		)
 
-		err := rows.Scan(&questionID, &questionType, &topic, &subject, &variants, &correctAnswer)
+		err := rows.Scan(&questionID, &questionType, &topic, &subject, &variants, &correctAnswer,
+			&optionsRaw, &weight, &explanation, &difficulty, &version)
 		if err != nil {
 			err := errors.Wrapf(entities.ErrInternal, "scan questions data failure: %v", err)
 			slog.Error(err.Error())
 			return nil, err
 		}
 
-		var qt entities.QuestionType
-		switch questionType {
-		case "single selection":
-			qt = entities.SingleSelection
-		case "multi selection":
-			qt = entities.MultiSelection
-		case "true or false":
-			qt = entities.TrueOrFalse
+		qt, err := entities.ParseQuestionType(questionType)
+		if err != nil {
+			err := errors.Wrapf(entities.ErrInternal, "parse question type failure: %v", err)
+			slog.Error(err.Error())
+			return nil, err
+		}
+
+		opts, err := s.decodeQuestionOptions(optionsRaw)
+		if err != nil {
+			slog.Error(err.Error())
+			return nil, err
 		}
+		opts = append(opts, entities.WithWeight(weight), entities.WithExplanation(explanation),
+			entities.WithDifficulty(entities.Difficulty(difficulty)), entities.WithVersion(version))
+
 		question, err := s.questionFactory.NewQuestion(questionID, qt, topic, subject, variants,
-			correctAnswer)
+			correctAnswer, opts...)
 		if err != nil {
 			err := errors.Wrapf(entities.ErrInternal, "creating questions failure")
 			slog.Error(err.Error())
@@ -503,90 +966,34 @@ func (s *Storage) processingQuestionsRows(_ context.Context, rows pgx.Rows) ([]e
 
 func (s *Storage) makeInitStateSessionQuery() string {
 	return `
-		) values ($1, $2, $3, $4);
+		) values ($1, $2, $3, $4, $5, $6);
 	`
 }
 
+// makeActiveStateSessionQuery inserts nothing when the session is already completed, so a pause
+// or a resume racing with the completion can not hide the result behind a newer row.
 func (s *Storage) makeActiveStateSessionQuery() string {
 	return `
-		, questions, created_at, duration_limit) values ($1, $2, $3, $4, $5, $6, $7);
+		, questions, created_at, duration_limit, elapsed, resumed_at, pause_count,
+		question_versions) 
+		SELECT $1::TEXT, $2::TEXT, $3::VARCHAR, $4::TEXT[], $5::JSONB, $6::VARCHAR,
+		$7::INTEGER[], $8::TIMESTAMP, $9::BIGINT, $10::BIGINT, $11::TIMESTAMP, $12::INTEGER,
+		$13::INTEGER[]
+		WHERE NOT EXISTS (
+			SELECT 1 FROM kvs.sessions c
+			WHERE c.session_id = $1 AND c.state = 'completed state'
+		);
 	`
 }
 
 func (s *Storage) makeCompletedStateSessionQuery() string {
 	return `
-		, questions, created_at, answers, is_expired, is_passed, comment) values ($1, $2, $3, $4, $5, 
-		$6, $7, $8, $9, $10);
-	`
-}
-
-func (s *Storage) getQuestionsIDs(session *entities.Session) ([]string, error) {
-	questions, err := session.GetQuestions()
-	if err != nil {
-		err := errors.Wrapf(entities.ErrInternal,
-			"get questions from session state: %v", err)
-		slog.Error(err.Error())
-		return nil, err
-	}
-
-	questionsIDs := make([]string, 0, len(questions))
-	for _, q := range questions {
-		questionsIDs = append(questionsIDs, q.ID())
-	}
-
-	return questionsIDs, nil
-}
-
-func (s *Storage) IsDailySessionLimitReached(ctx context.Context, userID string,
-	topics []string) (bool, error) {
-	slog.Info("IsDailySessionLimitReached started")
-
-	query := `
-SELECT 
-    s.user_id,
-    s.topics,
-    COUNT(*) AS completed_sessions_today
-FROM 
-    kvs.sessions s
-WHERE
-    s.user_id = $1
-    AND
-    s.state = 'completed state'
-    AND
-    s.updated_at::date >= CURRENT_DATE
-    AND
-    $2::text[] && s.topics
-GROUP BY 
-    s.user_id, 
-    s.topics
-ORDER BY 
-    completed_sessions_today DESC,
-    user_id ASC;
+		, questions, created_at, answers, is_expired, is_passed, comment, pass_threshold, 
+		score, correct_count, total_count, outcome, duration_limit, question_versions) 
+		values ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17,
+		$18, $19)
+		ON CONFLICT (session_id) WHERE state = 'completed state' DO NOTHING;
 	`
-	parameters := []interface{}{userID, topics}
-
-	row := s.db.QueryRow(ctx, query, parameters...)
-	var (
-		uid string
-		t   []string
-		cnt int
-	)
-	if err := row.Scan(&uid, &t, &cnt); err != nil {
-		if errors.Is(err, pgx.ErrNoRows) {
-			slog.Info("IsDailySessionLimitReached completed")
-			return false, nil
-		}
-		err := errors.Wrapf(entities.ErrInternal, "scan failure: %v", err)
-		slog.Error(err.Error())
-		return false, err
-	}
-
-	slog.Info("IsDailySessionLimitReached completed")
-	if cnt >= 1 {
-		return true, nil
-	}
-
-	return false, nil
 }
 
 //nolint:funlen //ok
@@ -608,7 +1015,10 @@ func (s *Storage) GetAllCompletedUserSessions(ctx context.Context, userID string
     	is_passed,
     	comment,
 		created_at,
-    	updated_at
+    	updated_at,
+    	policy,
+    	mode,
+    	question_versions
 	FROM kvs.sessions
 	WHERE user_id = $1
   	AND state = 'completed state'
@@ -636,33 +1046,43 @@ func (s *Storage) GetAllCompletedUserSessions(ctx context.Context, userID string
 			stateName    string
 			topics       []string
 			questionsIDs []string
+			versions     []int
 			answersRaw   []byte
 			isExpired    *bool
 			isPassed     bool
 			comment      string
 			createdAt    *time.Time
 			updatedAt    *time.Time
+			policyRaw    []byte
+			mode         string
 		)
 
 		if err := rows.Scan(&sessionID, &userID, &stateName, &topics, &questionsIDs, &answersRaw,
-			&isExpired, &isPassed, &comment, &createdAt, &updatedAt); err != nil {
+			&isExpired, &isPassed, &comment, &createdAt, &updatedAt, &policyRaw,
+			&mode, &versions); err != nil {
 			err := errors.Wrapf(entities.ErrInternal, "scan session data failure: %v", err)
 			slog.Error(err.Error())
 			return nil, err
 		}
 
+		policy, err := s.decodeSessionPolicy(policyRaw, mode)
+		if err != nil {
+			slog.Error(err.Error())
+			return nil, err
+		}
+
 		completedSession, err := entities.NewSession(userID, topics,
-			cryptoprocessing.NewUint64Generator(), s, entities.WithSessionID(sessionID),
-			entities.WithNilState())
+			cryptoprocessing.NewUint64Generator(), entities.WithSessionID(sessionID),
+			entities.WithNilState(), entities.WithPolicy(policy))
 		if err != nil {
 			err = errors.Wrap(err, "creating new session with sessionID option failure")
 			slog.Error(err.Error())
 			return nil, err
 		}
 
-		questions, err := s.getQuestionsByID(ctx, questionsIDs)
+		questions, err := s.getSessionQuestions(ctx, questionsIDs, versions)
 		if err != nil {
-			err = errors.Wrap(err, "getQuestionsByID failure")
+			err = errors.Wrap(err, "getSessionQuestions failure")
 			slog.Error(err.Error())
 			return nil, err
 		}
@@ -691,7 +1111,7 @@ func (s *Storage) GetAllCompletedUserSessions(ctx context.Context, userID string
 		}
 
 		state := entities.NewCompletedSessionState(questionsMap, completedSession,
-			answers, *createdAt, *isExpired)
+			answers, *createdAt, *isExpired, entities.WithCompletedStatePolicy(policy))
 		completedSession.ChangeState(state)
 		userSessions = append(userSessions, completedSession)
 	}
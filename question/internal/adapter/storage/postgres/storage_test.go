@@ -14,7 +14,6 @@ import (
 	cryptoprocessing "github.com/parta4ok/kvs/question/internal/adapter/generator/crypto_processing"
 	"github.com/parta4ok/kvs/question/internal/adapter/storage/postgres"
 	"github.com/parta4ok/kvs/question/internal/entities"
-	"github.com/parta4ok/kvs/question/internal/entities/testdata"
 	"github.com/stretchr/testify/require"
 )
 
@@ -43,34 +42,68 @@ func TestStorage_GetTopics(t *testing.T) {
 }
 
 func TestStorage_GetQuestions(t *testing.T) {
-	limit := 3
+	db := makeDB(t)
+	defer db.Close()
+
+	testTopics := []string{"Базы данных"}
+	questions, err := db.GetQuesions(context.TODO(), testTopics, 0)
+	require.NoError(t, err)
+	require.NotEmpty(t, questions)
+
+	require.LessOrEqual(t, len(questions), postgres.DefaultTopicLimit)
+
+	for _, question := range questions {
+		require.Equal(t, testTopics[0], question.Topic())
+		require.Equal(t, entities.DefaultDifficulty, entities.DifficultyOf(question))
+	}
+
+	questions, err = db.GetQuesions(context.TODO(), testTopics, 2)
+	require.NoError(t, err)
+	require.Len(t, questions, 2)
 
-	db := makeDB(t, postgres.WithQuestionsLimit(limit))
+	// every difficulty of the topic gets its own sample
+	questions, err = db.GetQuestionsByDifficulty(context.TODO(), testTopics, 2)
+	require.NoError(t, err)
+	require.NotEmpty(t, questions)
+	byDifficulty := make(map[entities.Difficulty]int)
+	for _, question := range questions {
+		byDifficulty[entities.DifficultyOf(question)]++
+	}
+	for _, count := range byDifficulty {
+		require.LessOrEqual(t, count, 2)
+	}
+
+	active, err := db.GetActiveQuestions(context.TODO(), []string{questions[0].ID(), "0"})
+	require.NoError(t, err)
+	require.Len(t, active, 1)
+	require.Equal(t, questions[0].ID(), active[0].ID())
+}
+
+func TestStorage_GetSelectionStrategies(t *testing.T) {
+	db := makeDB(t)
 	defer db.Close()
 
 	testTopics := []string{"Базы данных"}
-	questions, err := db.GetQuesions(context.TODO(), testTopics)
+	strategies, err := db.GetSelectionStrategies(context.TODO(), testTopics)
 	require.NoError(t, err)
+	require.Equal(t, map[string]entities.SelectionStrategy{
+		testTopics[0]: entities.UniformSelection,
+	}, strategies)
 
-	require.Equal(t, limit, len(questions))
+	history, err := db.GetTopicHistory(context.TODO(),
+		fmt.Sprintf("%d", time.Now().UTC().UnixNano()), testTopics)
+	require.NoError(t, err)
+	require.Empty(t, history)
 }
 
 func TestStorage_GetSession(t *testing.T) {
-	db := makeDB(t, postgres.WithQuestionsLimit(1))
+	db := makeDB(t)
 	defer db.Close()
 
 	testTopics := []string{"Составные типы в Go"}
 	userID := "12"
 
-	ctrl := gomock.NewController(t)
-	defer t.Cleanup(func() {
-		ctrl.Finish()
-	})
-
-	SessionStorage := testdata.NewMockSessionStorage(ctrl)
-
-	session, err := entities.NewSession(userID, testTopics, cryptoprocessing.NewUint64Generator(),
-		SessionStorage)
+	session, err := entities.NewSession(userID, testTopics, cryptoprocessing.NewUint64Generator())
 	require.NoError(t, err)
 	require.Equal(t, session.GetStatus(), entities.InitState)
 
@@ -84,7 +117,7 @@ func TestStorage_GetSession(t *testing.T) {
 	require.Equal(t, restoredInitSession.GetStatus(), entities.InitState)
 	compareSession(t, session, restoredInitSession)
 
-	questions, err := db.GetQuesions(context.TODO(), testTopics)
+	questions, err := db.GetQuesions(context.TODO(), testTopics, 0)
 	require.NoError(t, err)
 
 	questionsMap := make(map[string]entities.Question, len(questions))
@@ -177,7 +210,81 @@ func compareSession(t *testing.T, originalSession, recoveredSession *entities.Se
 	}
 }
 
-func TestStorage_IsDailySessionLimitReached(t *testing.T) {
+func TestStorage_GetExpiredSessionIDs(t *testing.T) {
+	db := makeDB(t)
+	defer db.Close()
+
+	ctx := context.TODO()
+	testTopics := []string{"Базы данных"}
+
+	questions, err := db.GetQuesions(ctx, testTopics, 0)
+	require.NoError(t, err)
+	require.NotEmpty(t, questions)
+
+	session, err := entities.NewSession("expiry", testTopics, cryptoprocessing.NewUint64Generator())
+	require.NoError(t, err)
+	require.NoError(t, session.SetQuestions(map[string]entities.Question{
+		questions[0].ID(): questions[0]}, time.Minute))
+	require.NoError(t, db.StoreSession(ctx, session))
+
+	expired, err := db.GetExpiredSessionIDs(ctx, time.Now().Add(time.Hour), 1000)
+	require.NoError(t, err)
+	require.Contains(t, expired, session.GetSesionID())
+
+	expired, err = db.GetExpiredSessionIDs(ctx, time.Now(), 1000)
+	require.NoError(t, err)
+	require.NotContains(t, expired, session.GetSesionID())
+
+	require.NoError(t, session.SetUserAnswer(nil))
+	require.NoError(t, db.StoreSession(ctx, session))
+
+	// completed sessions leave the deadlines table
+	expired, err = db.GetExpiredSessionIDs(ctx, time.Now().Add(time.Hour), 1000)
+	require.NoError(t, err)
+	require.NotContains(t, expired, session.GetSesionID())
+}
+
+func TestStorage_StoreSession_PausedAfterCompletion(t *testing.T) {
+	db := makeDB(t)
+	defer db.Close()
+
+	ctx := context.TODO()
+	testTopics := []string{"Базы данных"}
+
+	questions, err := db.GetQuesions(ctx, testTopics, 0)
+	require.NoError(t, err)
+	require.NotEmpty(t, questions)
+
+	policy := entities.DefaultSessionPolicy()
+	policy.Pause.MaxPauses = 1
+
+	session, err := entities.NewSession("pause-race", testTopics,
+		cryptoprocessing.NewUint64Generator(), entities.WithPolicy(policy))
+	require.NoError(t, err)
+	require.NoError(t, session.SetQuestions(map[string]entities.Question{
+		questions[0].ID(): questions[0]}, time.Minute))
+	require.NoError(t, db.StoreSession(ctx, session))
+
+	// the pause was loaded before the sweeper completed the session
+	stale, err := db.GetSessionBySessionID(ctx, session.GetSesionID())
+	require.NoError(t, err)
+
+	require.NoError(t, session.SetUserAnswer(nil))
+	require.NoError(t, db.StoreSession(ctx, session))
+
+	require.NoError(t, stale.Pause(time.Now().UTC()))
+	require.ErrorIs(t, db.StoreSession(ctx, stale), entities.ErrConflict)
+
+	restored, err := db.GetSessionBySessionID(ctx, session.GetSesionID())
+	require.NoError(t, err)
+	require.Equal(t, entities.CompletedState, restored.GetStatus())
+
+	expired, err := db.GetExpiredSessionIDs(ctx, time.Now().Add(time.Hour), 1000)
+	require.NoError(t, err)
+	require.NotContains(t, expired, session.GetSesionID())
+}
+
+func TestStorage_GetAttempts(t *testing.T) {
 	db := makeDB(t)
 	defer db.Close()
 
@@ -185,14 +292,14 @@ func TestStorage_IsDailySessionLimitReached(t *testing.T) {
 	topics := []string{"Базы данных"}
 	ctx := context.TODO()
 
-	session, err := entities.NewSession(userID, topics, cryptoprocessing.NewUint64Generator(), db)
+	attempts, err := db.GetAttempts(ctx, userID, topics)
 	require.NoError(t, err)
+	require.Empty(t, attempts)
 
-	forbidden, err := session.IsDailySessionLimitReached(ctx, session.GetUserID(), session.GetTopics())
+	session, err := entities.NewSession(userID, topics, cryptoprocessing.NewUint64Generator())
 	require.NoError(t, err)
-	require.False(t, forbidden)
 
-	questions, err := db.GetQuesions(ctx, topics)
+	questions, err := db.GetQuesions(ctx, topics, 0)
 	require.NoError(t, err)
 
 	questionsMap := make(map[string]entities.Question, len(questions))
@@ -217,20 +324,66 @@ func TestStorage_IsDailySessionLimitReached(t *testing.T) {
 	err = db.StoreSession(ctx, session)
 	require.NoError(t, err)
 
-	secondSession, err := entities.NewSession(userID, topics,
-		cryptoprocessing.NewUint64Generator(), db)
+	attempts, err = db.GetAttempts(ctx, userID, topics)
+	require.NoError(t, err)
+	require.Len(t, attempts, 1)
+	require.Equal(t, topics, attempts[0].Topics)
+
+	err = entities.DefaultAttemptPolicy().Check(topics, attempts, nil, time.Now().UTC(), time.UTC)
+	require.ErrorIs(t, err, entities.ErrForbidden)
+}
+
+func TestStorage_AttemptGrantsAndTimezone(t *testing.T) {
+	db := makeDB(t)
+	defer db.Close()
+
+	userID := fmt.Sprintf("%d", time.Now().UTC().UnixNano())
+	topics := []string{"Базы данных"}
+	ctx := context.TODO()
+	grantedAt := time.Now().UTC().Truncate(time.Second)
+
+	err := db.StoreAttemptGrant(ctx, userID, entities.AttemptGrant{
+		Topic:     topics[0],
+		Attempts:  2,
+		GrantedBy: "1",
+		GrantedAt: grantedAt,
+	})
+	require.NoError(t, err)
+
+	grants, err := db.GetAttemptGrants(ctx, userID, topics, grantedAt.Add(-time.Minute))
+	require.NoError(t, err)
+	require.Len(t, grants, 1)
+	require.Equal(t, 2, grants[0].Attempts)
+
+	grants, err = db.GetAttemptGrants(ctx, userID, topics, grantedAt.Add(time.Minute))
 	require.NoError(t, err)
+	require.Empty(t, grants)
 
-	require.Equal(t, entities.InitState, secondSession.GetStatus())
+	timezone, err := db.GetUserTimezone(ctx, userID)
+	require.NoError(t, err)
+	require.Empty(t, timezone)
+
+	changedBefore := time.Now().UTC().Add(-time.Hour)
+	require.NoError(t, db.StoreUserTimezone(ctx, userID, "Europe/Moscow", &changedBefore))
+	// the same timezone again is not a change
+	require.NoError(t, db.StoreUserTimezone(ctx, userID, "Europe/Moscow", &changedBefore))
+	// the timezone was changed less than an hour ago
+	require.ErrorIs(t, db.StoreUserTimezone(ctx, userID, "Asia/Tokyo", &changedBefore),
+		entities.ErrConflict)
+
+	timezone, err = db.GetUserTimezone(ctx, userID)
+	require.NoError(t, err)
+	require.Equal(t, "Europe/Moscow", timezone)
+
+	require.NoError(t, db.StoreUserTimezone(ctx, userID, "Asia/Tokyo", nil))
 
-	forbidden, err = secondSession.IsDailySessionLimitReached(ctx, secondSession.GetUserID(),
-		secondSession.GetTopics())
+	timezone, err = db.GetUserTimezone(ctx, userID)
 	require.NoError(t, err)
-	require.True(t, forbidden)
+	require.Equal(t, "Asia/Tokyo", timezone)
 }
 
 func TestStorage_GetAllCompletedUserSessions(t *testing.T) {
-	db := makeDB(t, postgres.WithQuestionsLimit(2))
+	db := makeDB(t)
 	defer db.Close()
 
 	ctx := context.TODO()
@@ -239,7 +392,7 @@ func TestStorage_GetAllCompletedUserSessions(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer t.Cleanup(ctrl.Finish)
 
-	questions, err := db.GetQuesions(ctx, topics)
+	questions, err := db.GetQuesions(ctx, topics, 0)
 	require.NoError(t, err)
 	require.NotEmpty(t, questions)
 
@@ -248,7 +401,7 @@ func TestStorage_GetAllCompletedUserSessions(t *testing.T) {
 		questionsMap[q.ID()] = q
 	}
 
-	sessionOld, err := entities.NewSession(userID, topics, cryptoprocessing.NewUint64Generator(), db)
+	sessionOld, err := entities.NewSession(userID, topics, cryptoprocessing.NewUint64Generator())
 	require.NoError(t, err)
 	err = sessionOld.SetQuestions(questionsMap, time.Minute*10)
 	require.NoError(t, err)
@@ -259,7 +412,7 @@ func TestStorage_GetAllCompletedUserSessions(t *testing.T) {
 	require.Equal(t, entities.CompletedState, sessionOld.GetStatus())
 	require.NoError(t, db.StoreSession(ctx, sessionOld))
 
-	sessionNew, err := entities.NewSession(userID, topics, cryptoprocessing.NewUint64Generator(), db)
+	sessionNew, err := entities.NewSession(userID, topics, cryptoprocessing.NewUint64Generator())
 	require.NoError(t, err)
 	err = sessionNew.SetQuestions(questionsMap, time.Minute*10)
 	require.NoError(t, err)
@@ -292,6 +445,64 @@ func TestStorage_GetAllCompletedUserSessions(t *testing.T) {
 	require.Equal(t, entities.CompletedState, sessions[1].GetStatus())
 }
 
+func TestStorage_GetRepetitionOutcomes(t *testing.T) {
+	db := makeDB(t)
+	defer db.Close()
+
+	userID := fmt.Sprintf("repetition-%d", time.Now().UTC().UnixNano())
+	topics := []string{"Базы данных"}
+	ctx := context.TODO()
+
+	session, err := entities.NewSession(userID, topics, cryptoprocessing.NewUint64Generator())
+	require.NoError(t, err)
+
+	questions, err := db.GetQuesions(ctx, topics, 0)
+	require.NoError(t, err)
+
+	questionsMap := make(map[string]entities.Question, len(questions))
+	for _, q := range questions {
+		questionsMap[q.ID()] = q
+	}
+
+	require.NoError(t, session.SetQuestions(questionsMap, time.Minute))
+	// no answers, so every question is failed
+	require.NoError(t, session.SetUserAnswer(nil))
+	require.NoError(t, db.StoreSession(ctx, session))
+
+	startedAt, err := session.GetStartedAt()
+	require.NoError(t, err)
+
+	outcomes, err := db.GetRepetitionOutcomes(ctx, userID, nil)
+	require.NoError(t, err)
+	require.Len(t, outcomes, len(questions))
+	for _, outcome := range outcomes {
+		require.Equal(t, topics[0], outcome.Topic)
+		require.False(t, outcome.IsCorrect)
+		require.WithinDuration(t, startedAt, outcome.AnsweredAt, time.Second)
+	}
+
+	outcomes, err = db.GetRepetitionOutcomes(ctx, userID, []string{"Базовые типы в Go"})
+	require.NoError(t, err)
+	require.Empty(t, outcomes)
+
+	// outcomes are stored once, the backfill does not duplicate them
+	require.NoError(t, db.StoreAnswerOutcomes(ctx, session))
+	outcomes, err = db.GetRepetitionOutcomes(ctx, userID, nil)
+	require.NoError(t, err)
+	require.Len(t, outcomes, len(questions))
+
+	withoutOutcomes, err := db.GetSessionsWithoutOutcomes(ctx, nil, 1000)
+	require.NoError(t, err)
+	for _, position := range withoutOutcomes {
+		require.NotEqual(t, session.GetSesionID(), position.SessionID)
+	}
+
+	withoutOutcomes, err = db.GetSessionsWithoutOutcomes(ctx, &entities.BackfillCursor{
+		CompletedAt: time.Now().UTC().Add(time.Hour)}, 1000)
+	require.NoError(t, err)
+	require.Empty(t, withoutOutcomes)
+}
+
 func mustAnswer(t *testing.T, q entities.Question) *entities.UserAnswer {
 	t.Helper()
 
@@ -299,3 +510,441 @@ func mustAnswer(t *testing.T, q entities.Question) *entities.UserAnswer {
 	require.NoError(t, err)
 	return answer
 }
+
+func TestStorage_GetResultSnapshots(t *testing.T) {
+	db := makeDB(t)
+	defer db.Close()
+
+	ctx := context.TODO()
+	userID := fmt.Sprintf("snapshot-%d", time.Now().UTC().UnixNano())
+	topics := []string{"Базовые типы в Go"}
+
+	questions, err := db.GetQuesions(ctx, topics, 0)
+	require.NoError(t, err)
+	require.NotEmpty(t, questions)
+
+	questionsMap := make(map[string]entities.Question, len(questions))
+	for _, q := range questions {
+		questionsMap[q.ID()] = q
+	}
+
+	sessionIDs := make([]string, 0, 3)
+	for range 3 {
+		session, err := entities.NewSession(userID, topics, cryptoprocessing.NewUint64Generator())
+		require.NoError(t, err)
+		require.NoError(t, session.SetQuestions(questionsMap, time.Minute*10))
+		require.NoError(t, session.SetUserAnswer([]*entities.UserAnswer{
+			mustAnswer(t, questions[0]),
+		}))
+		require.NoError(t, db.StoreSession(ctx, session))
+		sessionIDs = append(sessionIDs, session.GetSesionID())
+	}
+
+	query, err := entities.NewHistoryQuery(entities.HistoryFilter{}, "", "", 2, nil)
+	require.NoError(t, err)
+
+	page, err := db.GetResultSnapshots(ctx, userID, query)
+	require.NoError(t, err)
+	require.Len(t, page.Sessions, 2)
+	require.Equal(t, 3, page.Totals.Total)
+	require.Equal(t, sessionIDs[2], page.Sessions[0].SessionID, "latest session first")
+	require.Equal(t, sessionIDs[1], page.Sessions[1].SessionID)
+	require.Len(t, page.Sessions[0].Items, len(questions))
+	require.Equal(t, len(questions), page.Sessions[0].Result.TotalCount)
+	require.Positive(t, page.Sessions[0].Result.PassThreshold)
+	require.NotNil(t, page.Next)
+
+	query, err = entities.NewHistoryQuery(entities.HistoryFilter{}, "", "", 2, page.Next)
+	require.NoError(t, err)
+
+	page, err = db.GetResultSnapshots(ctx, userID, query)
+	require.NoError(t, err)
+	require.Len(t, page.Sessions, 1)
+	require.Equal(t, sessionIDs[0], page.Sessions[0].SessionID)
+	require.Nil(t, page.Next)
+
+	// the stored snapshot is not overwritten by the backfill
+	snapshot := *page.Sessions[0]
+	snapshot.Items = nil
+	require.NoError(t, db.StoreResultSnapshot(ctx, &snapshot))
+
+	page, err = db.GetResultSnapshots(ctx, userID, query)
+	require.NoError(t, err)
+	require.Len(t, page.Sessions[0].Items, len(questions))
+
+	// filters and totals are applied in SQL
+	outcome := page.Sessions[0].Result.Outcome
+	query, err = entities.NewHistoryQuery(entities.HistoryFilter{
+		Topic:    topics[0],
+		From:     page.Sessions[0].CompletedAt,
+		Outcomes: []entities.Outcome{outcome},
+	}, entities.SortByScore, entities.Ascending, 10, nil)
+	require.NoError(t, err)
+
+	page, err = db.GetResultSnapshots(ctx, userID, query)
+	require.NoError(t, err)
+	require.Len(t, page.Sessions, 3)
+	require.Equal(t, 3, page.Totals.Total)
+
+	query, err = entities.NewHistoryQuery(entities.HistoryFilter{Topic: "Базы данных"}, "", "",
+		10, nil)
+	require.NoError(t, err)
+
+	page, err = db.GetResultSnapshots(ctx, userID, query)
+	require.NoError(t, err)
+	require.Empty(t, page.Sessions)
+	require.Zero(t, page.Totals.Total)
+
+	withoutSnapshot, err := db.GetSessionsWithoutSnapshot(ctx, nil, 1000)
+	require.NoError(t, err)
+	for _, position := range withoutSnapshot {
+		require.NotEqual(t, sessionIDs[0], position.SessionID)
+	}
+
+	withoutSnapshot, err = db.GetSessionsWithoutSnapshot(ctx, &entities.BackfillCursor{
+		CompletedAt: time.Now().UTC().Add(time.Hour)}, 1000)
+	require.NoError(t, err)
+	require.Empty(t, withoutSnapshot)
+}
+
+func TestStorage_RecreateDeletedTopic(t *testing.T) {
+	db := makeDB(t)
+	defer db.Close()
+
+	ctx := context.TODO()
+	name := fmt.Sprintf("recreated-%d", time.Now().UnixNano())
+
+	topicID, err := db.NextTopicID(ctx)
+	require.NoError(t, err)
+	topic, err := entities.NewTopic(topicID, name)
+	require.NoError(t, err)
+	require.NoError(t, db.StoreTopic(ctx, topic))
+
+	duplicateID, err := db.NextTopicID(ctx)
+	require.NoError(t, err)
+	duplicate, err := entities.NewTopic(duplicateID, name)
+	require.NoError(t, err)
+	require.ErrorIs(t, db.StoreTopic(ctx, duplicate), entities.ErrConflict)
+
+	require.NoError(t, db.DeleteTopic(ctx, topicID))
+	require.NoError(t, db.StoreTopic(ctx, duplicate))
+
+	recreated, err := db.GetTopicByID(ctx, duplicateID)
+	require.NoError(t, err)
+	require.Equal(t, name, recreated.Name())
+}
+
+func TestStorage_UpdateTopic_RenameUsed(t *testing.T) {
+	db := makeDB(t)
+	defer db.Close()
+
+	ctx := context.TODO()
+	name := fmt.Sprintf("renamed-%d", time.Now().UnixNano())
+
+	topicID, err := db.NextTopicID(ctx)
+	require.NoError(t, err)
+	topic, err := entities.NewTopic(topicID, name+"-draft")
+	require.NoError(t, err)
+	require.NoError(t, db.StoreTopic(ctx, topic))
+
+	// the topic is not used yet
+	topic, err = entities.NewTopic(topicID, name)
+	require.NoError(t, err)
+	require.NoError(t, db.UpdateTopic(ctx, topic))
+
+	session, err := entities.NewSession("renamed", []string{name},
+		cryptoprocessing.NewUint64Generator())
+	require.NoError(t, err)
+	require.NoError(t, db.StoreSession(ctx, session))
+
+	renamed, err := entities.NewTopic(topicID, name+"-renamed")
+	require.NoError(t, err)
+	require.ErrorIs(t, db.UpdateTopic(ctx, renamed), entities.ErrConflict)
+
+	// the name is kept, so the topic still can be changed
+	require.NoError(t, db.UpdateTopic(ctx, topic))
+
+	stored, err := db.GetTopicByID(ctx, topicID)
+	require.NoError(t, err)
+	require.Equal(t, name, stored.Name())
+
+	missing, err := entities.NewTopic("0", name)
+	require.NoError(t, err)
+	require.ErrorIs(t, db.UpdateTopic(ctx, missing), entities.ErrNotFound)
+}
+
+func TestStorage_UpdateTopic_RenameGranted(t *testing.T) {
+	db := makeDB(t)
+	defer db.Close()
+
+	ctx := context.TODO()
+	name := fmt.Sprintf("granted-%d", time.Now().UnixNano())
+
+	topicID, err := db.NextTopicID(ctx)
+	require.NoError(t, err)
+	topic, err := entities.NewTopic(topicID, name)
+	require.NoError(t, err)
+	require.NoError(t, db.StoreTopic(ctx, topic))
+
+	require.NoError(t, db.StoreAttemptGrant(ctx, "granted", entities.AttemptGrant{
+		Topic:     name,
+		Attempts:  1,
+		GrantedBy: "1",
+		GrantedAt: time.Now().UTC(),
+	}))
+
+	renamed, err := entities.NewTopic(topicID, name+"-renamed")
+	require.NoError(t, err)
+	require.ErrorIs(t, db.UpdateTopic(ctx, renamed), entities.ErrConflict)
+}
+
+func TestStorage_GetQuestionByID_Deleted(t *testing.T) {
+	db := makeDB(t)
+	defer db.Close()
+
+	ctx := context.TODO()
+	factory := &entities.QuestionFactory{}
+
+	topicID, err := db.NextTopicID(ctx)
+	require.NoError(t, err)
+	topic, err := entities.NewTopic(topicID, fmt.Sprintf("deleted-%d", time.Now().UnixNano()))
+	require.NoError(t, err)
+	require.NoError(t, db.StoreTopic(ctx, topic))
+
+	questionID, err := db.NextQuestionID(ctx)
+	require.NoError(t, err)
+	question, err := factory.NewQuestion(questionID, entities.TrueOrFalse, topic.Name(),
+		"Индексы всегда ускоряют вставку", nil, []string{"false"})
+	require.NoError(t, err)
+	require.NoError(t, db.StoreQuestion(ctx, question))
+
+	stored, err := db.GetQuestionByID(ctx, questionID)
+	require.NoError(t, err)
+	require.Equal(t, question.Subject(), stored.Subject())
+
+	require.NoError(t, db.DeleteQuestion(ctx, questionID))
+	_, err = db.GetQuestionByID(ctx, questionID)
+	require.ErrorIs(t, err, entities.ErrNotFound)
+}
+
+func TestStorage_KnowledgeReport(t *testing.T) {
+	db := makeDB(t)
+	defer db.Close()
+
+	userID := fmt.Sprintf("report-%d", time.Now().UTC().UnixNano())
+	topics := []string{"Базы данных"}
+	ctx := context.TODO()
+
+	stats, err := db.GetSessionStats(ctx, userID)
+	require.NoError(t, err)
+	require.Zero(t, stats.Total)
+
+	session, err := entities.NewSession(userID, topics, cryptoprocessing.NewUint64Generator())
+	require.NoError(t, err)
+
+	questions, err := db.GetQuesions(ctx, topics, 0)
+	require.NoError(t, err)
+
+	questionsMap := make(map[string]entities.Question, len(questions))
+	for _, q := range questions {
+		questionsMap[q.ID()] = q
+	}
+
+	require.NoError(t, session.SetQuestions(questionsMap, time.Minute))
+	// no answers, so every question is failed
+	require.NoError(t, session.SetUserAnswer(nil))
+	require.NoError(t, db.StoreSession(ctx, session))
+
+	stats, err = db.GetSessionStats(ctx, userID)
+	require.NoError(t, err)
+	require.Equal(t, 1, stats.Total)
+	require.Equal(t, 1, stats.Exams)
+	require.Equal(t, 1, stats.Failed)
+
+	accuracy, err := db.GetTopicAccuracy(ctx, userID)
+	require.NoError(t, err)
+	require.Len(t, accuracy, 1)
+	require.Equal(t, topics[0], accuracy[0].Topic)
+	require.Equal(t, len(questions), accuracy[0].Total)
+	require.Zero(t, accuracy[0].Correct)
+	require.Len(t, accuracy[0].Periods, 1)
+
+	failed, err := db.GetFailedQuestions(ctx, userID, 3)
+	require.NoError(t, err)
+	require.Len(t, failed, min(3, len(questions)))
+	require.Equal(t, 1, failed[0].Failures)
+
+	trend, err := db.GetScoreTrend(ctx, userID, 10)
+	require.NoError(t, err)
+	require.Equal(t, 1, trend.Sessions)
+	require.Equal(t, entities.TrendInsufficientData, trend.Direction())
+}
+
+func TestStorage_ItemAnalysis(t *testing.T) {
+	db := makeDB(t)
+	defer db.Close()
+
+	ctx := context.TODO()
+
+	topicIDs, err := db.GetTopicIDs(ctx)
+	require.NoError(t, err)
+	require.NotEmpty(t, topicIDs)
+
+	questions, err := db.GetTopicQuestions(ctx, topicIDs[0])
+	require.NoError(t, err)
+
+	responses, err := db.GetExamResponses(ctx, topicIDs[0])
+	require.NoError(t, err)
+
+	statistics := entities.AnalyzeItems(questions, responses, time.Now().UTC().Truncate(time.Second))
+	require.NoError(t, db.StoreItemStatistics(ctx, topicIDs[0], statistics))
+	// statistics are replaced, not duplicated
+	require.NoError(t, db.StoreItemStatistics(ctx, topicIDs[0], statistics))
+
+	stored, err := db.GetItemStatistics(ctx, topicIDs[0])
+	require.NoError(t, err)
+	require.Len(t, stored, len(statistics))
+
+	for i, stats := range stored {
+		require.Equal(t, statistics[i].QuestionID, stats.QuestionID)
+		require.Equal(t, statistics[i].Responses, stats.Responses)
+		require.Equal(t, statistics[i].Distractors, stats.Distractors)
+	}
+}
+
+func TestStorage_QuestionVersions(t *testing.T) {
+	db := makeDB(t)
+	defer db.Close()
+
+	ctx := context.TODO()
+	factory := &entities.QuestionFactory{}
+
+	topicID, err := db.NextTopicID(ctx)
+	require.NoError(t, err)
+	topic, err := entities.NewTopic(topicID, fmt.Sprintf("versions-%d", time.Now().UnixNano()))
+	require.NoError(t, err)
+	require.NoError(t, db.StoreTopic(ctx, topic))
+
+	questionID, err := db.NextQuestionID(ctx)
+	require.NoError(t, err)
+	original, err := factory.NewQuestion(questionID, entities.TrueOrFalse, topic.Name(),
+		"Индексы всегда ускоряют вставку", nil, []string{"true"})
+	require.NoError(t, err)
+	require.NoError(t, db.StoreQuestion(ctx, original))
+
+	session, err := entities.NewSession("versions", []string{topic.Name()},
+		cryptoprocessing.NewUint64Generator())
+	require.NoError(t, err)
+	require.NoError(t, session.SetQuestions(map[string]entities.Question{questionID: original},
+		time.Minute))
+	answer, err := entities.NewUserAnswer(questionID, []string{"false"})
+	require.NoError(t, err)
+	require.NoError(t, session.SetUserAnswer([]*entities.UserAnswer{answer}))
+	require.NoError(t, db.StoreSession(ctx, session))
+
+	fixed, err := factory.NewQuestion(questionID, entities.TrueOrFalse, topic.Name(),
+		"Индексы замедляют вставку", nil, []string{"false"}, entities.WithVersion(2))
+	require.NoError(t, err)
+	require.NoError(t, db.UpdateQuestion(ctx, fixed))
+	// the same version can not be saved twice
+	require.ErrorIs(t, db.UpdateQuestion(ctx, fixed), entities.ErrConflict)
+
+	// the completed session keeps the version it was graded with
+	recovered, err := db.GetSessionBySessionID(ctx, session.GetSesionID())
+	require.NoError(t, err)
+	recoveredQuestions, err := recovered.GetQuestions()
+	require.NoError(t, err)
+	require.Equal(t, "Индексы всегда ускоряют вставку", recoveredQuestions[0].Subject())
+	require.Equal(t, entities.FirstQuestionVersion, entities.VersionOf(recoveredQuestions[0]))
+
+	sessionIDs, err := db.GetSessionsToRescore(ctx, questionID, 2, "", 10)
+	require.NoError(t, err)
+	require.Equal(t, []string{session.GetSesionID()}, sessionIDs)
+
+	// sessions are paged by id
+	sessionIDs, err = db.GetSessionsToRescore(ctx, questionID, 2, session.GetSesionID(), 10)
+	require.NoError(t, err)
+	require.Empty(t, sessionIDs)
+
+	current, err := db.GetQuestionByID(ctx, questionID)
+	require.NoError(t, err)
+	require.Equal(t, 2, entities.VersionOf(current))
+	require.NoError(t, recovered.Rescore(current))
+	require.NoError(t, db.UpdateSessionResult(ctx, recovered))
+
+	sessionIDs, err = db.GetSessionsToRescore(ctx, questionID, 2, "", 10)
+	require.NoError(t, err)
+	require.Empty(t, sessionIDs)
+
+	rescored, err := db.GetSessionBySessionID(ctx, session.GetSesionID())
+	require.NoError(t, err)
+	result, err := rescored.GetSessionResult()
+	require.NoError(t, err)
+	require.Equal(t, entities.OutcomePassed, result.Outcome)
+}
+
+func TestStorage_RescoreTasks(t *testing.T) {
+	db := makeDB(t)
+	defer db.Close()
+
+	ctx := context.TODO()
+	factory := &entities.QuestionFactory{}
+
+	topicID, err := db.NextTopicID(ctx)
+	require.NoError(t, err)
+	topic, err := entities.NewTopic(topicID, fmt.Sprintf("rescore-%d", time.Now().UnixNano()))
+	require.NoError(t, err)
+	require.NoError(t, db.StoreTopic(ctx, topic))
+
+	questionID, err := db.NextQuestionID(ctx)
+	require.NoError(t, err)
+	question, err := factory.NewQuestion(questionID, entities.TrueOrFalse, topic.Name(),
+		"Индексы замедляют вставку", nil, []string{"false"})
+	require.NoError(t, err)
+	require.NoError(t, db.StoreQuestion(ctx, question))
+
+	_, err = db.GetRescoreTask(ctx, questionID)
+	require.ErrorIs(t, err, entities.ErrNotFound)
+
+	queued, err := db.StoreRescoreTask(ctx, questionID, 1)
+	require.NoError(t, err)
+	require.Equal(t, entities.RescorePending, queued.Status)
+
+	var claimed *entities.RescoreTask
+	for claimed == nil || claimed.QuestionID != questionID {
+		claimed, err = db.ClaimRescoreTask(ctx, time.Now().UTC().Add(-time.Hour))
+		require.NoError(t, err)
+	}
+	require.Equal(t, entities.RescoreRunning, claimed.Status)
+	require.Equal(t, 1, claimed.Attempts)
+
+	// the repeated request keeps the unfinished task
+	requeued, err := db.StoreRescoreTask(ctx, questionID, 1)
+	require.NoError(t, err)
+	require.Equal(t, entities.RescoreRunning, requeued.Status)
+
+	claimed.LastSessionID = "10"
+	claimed.Rescored = 1
+	require.NoError(t, db.UpdateRescoreTask(ctx, claimed))
+
+	// the stale claimer can not overwrite progress
+	stale := *claimed
+	stale.Attempts = 0
+	require.ErrorIs(t, db.UpdateRescoreTask(ctx, &stale), entities.ErrConflict)
+
+	claimed.Finish()
+	require.NoError(t, db.UpdateRescoreTask(ctx, claimed))
+
+	finished, err := db.GetRescoreTask(ctx, questionID)
+	require.NoError(t, err)
+	require.Equal(t, entities.RescoreCompleted, finished.Status)
+	require.Equal(t, "10", finished.LastSessionID)
+	require.Equal(t, 1, finished.Rescored)
+
+	// the finished task is started over
+	restarted, err := db.StoreRescoreTask(ctx, questionID, 1)
+	require.NoError(t, err)
+	require.Equal(t, entities.RescorePending, restarted.Status)
+	require.Empty(t, restarted.LastSessionID)
+	require.Zero(t, restarted.Attempts)
+}
@@ -0,0 +1,16 @@
+package cases
+
+import (
+	"context"
+
+	"github.com/parta4ok/kvs/question/internal/entities"
+)
+
+//go:generate mockgen -source=./attempt_service.go -destination=./testdata/attempt_service.go -package=testdata
+type AttemptService interface {
+	CheckAttempt(ctx context.Context, userID string, topics []string) error
+	GrantAttempts(ctx context.Context, studentID string, grant entities.AttemptGrant) error
+	// SetUserTimezone sets timezone of the user. The user changes own timezone not more often
+	// than the attempt policy allows, other callers are not limited.
+	SetUserTimezone(ctx context.Context, userID string, timezone string, changedBy string) error
+}
@@ -0,0 +1,171 @@
+package cases
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/parta4ok/kvs/question/internal/entities"
+)
+
+var (
+	_ AttemptService = (*AttemptServiceBase)(nil)
+)
+
+// AttemptServiceBase decides whether the student may start one more exam session according to
+// the attempt policy, attempts history, grants of mentors and timezone of the student.
+type AttemptServiceBase struct {
+	storage AttemptStorage
+	policy  entities.AttemptPolicy
+}
+
+func NewAttemptServiceBase(storage AttemptStorage, opts ...AttemptServiceOption) (
+	*AttemptServiceBase, error) {
+	if storage == nil {
+		return nil, errors.Wrap(entities.ErrInvalidParam, "attempt storage not set")
+	}
+
+	service := &AttemptServiceBase{
+		storage: storage,
+		policy:  entities.DefaultAttemptPolicy(),
+	}
+
+	for _, opt := range opts {
+		opt(service)
+	}
+
+	if err := service.policy.Validate(); err != nil {
+		return nil, errors.Wrap(err, "Validate")
+	}
+
+	return service, nil
+}
+
+type AttemptServiceOption func(*AttemptServiceBase)
+
+func WithAttemptPolicy(policy entities.AttemptPolicy) AttemptServiceOption {
+	return func(srv *AttemptServiceBase) {
+		srv.policy = policy
+	}
+}
+
+func (srv *AttemptServiceBase) CheckAttempt(ctx context.Context, userID string,
+	topics []string) error {
+	slog.Info("CheckAttempt started")
+
+	location := time.UTC
+	// the timezone matters for calendar day windows only
+	if srv.policy.Window == 0 {
+		var err error
+		location, err = srv.userLocation(ctx, userID)
+		if err != nil {
+			slog.Error(err.Error())
+			return err
+		}
+	}
+
+	now := time.Now().UTC()
+
+	attempts, err := srv.storage.GetAttempts(ctx, userID, topics)
+	if err != nil {
+		slog.Error(err.Error())
+		return errors.Wrap(err, "GetAttempts")
+	}
+
+	grants, err := srv.storage.GetAttemptGrants(ctx, userID, topics,
+		srv.policy.WindowStart(now, location))
+	if err != nil {
+		slog.Error(err.Error())
+		return errors.Wrap(err, "GetAttemptGrants")
+	}
+
+	if err := srv.policy.Check(topics, attempts, grants, now, location); err != nil {
+		slog.Warn(err.Error())
+		return err
+	}
+
+	slog.Info("CheckAttempt completed")
+	return nil
+}
+
+func (srv *AttemptServiceBase) GrantAttempts(ctx context.Context, studentID string,
+	grant entities.AttemptGrant) error {
+	slog.Info("GrantAttempts started")
+
+	if studentID == "" {
+		err := errors.Wrap(entities.ErrInvalidParam, "student id is empty")
+		slog.Error(err.Error())
+		return err
+	}
+
+	if err := grant.Validate(); err != nil {
+		slog.Error(err.Error())
+		return errors.Wrap(err, "Validate")
+	}
+
+	if grant.GrantedAt.IsZero() {
+		grant.GrantedAt = time.Now().UTC()
+	}
+
+	if err := srv.storage.StoreAttemptGrant(ctx, studentID, grant); err != nil {
+		slog.Error(err.Error())
+		return errors.Wrap(err, "StoreAttemptGrant")
+	}
+
+	slog.Info("GrantAttempts completed")
+	return nil
+}
+
+func (srv *AttemptServiceBase) SetUserTimezone(ctx context.Context, userID string,
+	timezone string, changedBy string) error {
+	slog.Info("SetUserTimezone started")
+
+	if timezone == "" {
+		err := errors.Wrap(entities.ErrInvalidParam, "timezone is empty")
+		slog.Error(err.Error())
+		return err
+	}
+
+	if _, err := time.LoadLocation(timezone); err != nil {
+		err := errors.Wrapf(entities.ErrInvalidParam, "unknown timezone %s: %v", timezone, err)
+		slog.Error(err.Error())
+		return err
+	}
+
+	var changedBefore *time.Time
+	if changedBy == userID && srv.policy.TimezoneChangeInterval > 0 {
+		since := time.Now().UTC().Add(-srv.policy.TimezoneChangeInterval)
+		changedBefore = &since
+	}
+
+	if err := srv.storage.StoreUserTimezone(ctx, userID, timezone, changedBefore); err != nil {
+		slog.Error(err.Error())
+		return errors.Wrap(err, "StoreUserTimezone")
+	}
+
+	slog.Info("SetUserTimezone completed")
+	return nil
+}
+
+// userLocation returns UTC for users who have not set their timezone.
+func (srv *AttemptServiceBase) userLocation(ctx context.Context, userID string) (
+	*time.Location, error) {
+	timezone, err := srv.storage.GetUserTimezone(ctx, userID)
+	if err != nil {
+		return nil, errors.Wrap(err, "GetUserTimezone")
+	}
+
+	if timezone == "" {
+		return time.UTC, nil
+	}
+
+	location, err := time.LoadLocation(timezone)
+	if err != nil {
+		return nil, errors.Wrapf(entities.ErrInternal, "load timezone %s failure: %v", timezone,
+			err)
+	}
+
+	return location, nil
+}
@@ -0,0 +1,233 @@
+package cases_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/parta4ok/kvs/question/internal/cases"
+	"github.com/parta4ok/kvs/question/internal/cases/testdata"
+	"github.com/parta4ok/kvs/question/internal/entities"
+)
+
+func TestNewAttemptServiceBase_ValidationErrors(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	_, err := cases.NewAttemptServiceBase(nil)
+	require.ErrorIs(t, err, entities.ErrInvalidParam)
+
+	_, err = cases.NewAttemptServiceBase(testdata.NewMockAttemptStorage(ctrl),
+		cases.WithAttemptPolicy(entities.AttemptPolicy{MaxAttempts: -1}))
+	require.ErrorIs(t, err, entities.ErrInvalidParam)
+}
+
+func TestAttemptServiceBase_CheckAttempt(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	topics := []string{"Go"}
+	failed := entities.Attempt{
+		Topics:      topics,
+		CompletedAt: time.Now().UTC().Add(-time.Minute),
+		Outcome:     entities.OutcomeFailed,
+	}
+
+	testCases := []struct {
+		name          string
+		policy        entities.AttemptPolicy
+		setupMocks    func() *testdata.MockAttemptStorage
+		expectedError string
+		expectedIs    error
+	}{
+		{
+			name:   "success",
+			policy: entities.DefaultAttemptPolicy(),
+			setupMocks: func() *testdata.MockAttemptStorage {
+				storage := testdata.NewMockAttemptStorage(ctrl)
+				storage.EXPECT().GetUserTimezone(gomock.Any(), "1").Return("Europe/Moscow", nil)
+				storage.EXPECT().GetAttempts(gomock.Any(), "1", topics).Return(nil, nil)
+				storage.EXPECT().GetAttemptGrants(gomock.Any(), "1", topics,
+					gomock.Any()).Return(nil, nil)
+				return storage
+			},
+		},
+		{
+			name:   "limit_reached",
+			policy: entities.AttemptPolicy{MaxAttempts: 1, Window: time.Hour},
+			setupMocks: func() *testdata.MockAttemptStorage {
+				storage := testdata.NewMockAttemptStorage(ctrl)
+				storage.EXPECT().GetAttempts(gomock.Any(), "1", topics).Return(
+					[]entities.Attempt{failed}, nil)
+				storage.EXPECT().GetAttemptGrants(gomock.Any(), "1", topics,
+					gomock.Any()).Return(nil, nil)
+				return storage
+			},
+			expectedIs: entities.ErrForbidden,
+		},
+		{
+			name:   "granted_attempt",
+			policy: entities.AttemptPolicy{MaxAttempts: 1, Window: time.Hour},
+			setupMocks: func() *testdata.MockAttemptStorage {
+				storage := testdata.NewMockAttemptStorage(ctrl)
+				storage.EXPECT().GetAttempts(gomock.Any(), "1", topics).Return(
+					[]entities.Attempt{failed}, nil)
+				storage.EXPECT().GetAttemptGrants(gomock.Any(), "1", topics,
+					gomock.Any()).Return([]entities.AttemptGrant{{
+					Topic:     "Go",
+					Attempts:  1,
+					GrantedBy: "2",
+					GrantedAt: time.Now().UTC(),
+				}}, nil)
+				return storage
+			},
+		},
+		{
+			name:   "get_user_timezone_error",
+			policy: entities.DefaultAttemptPolicy(),
+			setupMocks: func() *testdata.MockAttemptStorage {
+				storage := testdata.NewMockAttemptStorage(ctrl)
+				storage.EXPECT().GetUserTimezone(gomock.Any(), "1").Return("",
+					errors.New("timezone error"))
+				return storage
+			},
+			expectedError: "GetUserTimezone",
+		},
+		{
+			name:   "get_attempts_error",
+			policy: entities.DefaultAttemptPolicy(),
+			setupMocks: func() *testdata.MockAttemptStorage {
+				storage := testdata.NewMockAttemptStorage(ctrl)
+				storage.EXPECT().GetUserTimezone(gomock.Any(), "1").Return("", nil)
+				storage.EXPECT().GetAttempts(gomock.Any(), "1", topics).Return(nil,
+					errors.New("attempts error"))
+				return storage
+			},
+			expectedError: "GetAttempts",
+		},
+		{
+			name:   "get_attempt_grants_error",
+			policy: entities.DefaultAttemptPolicy(),
+			setupMocks: func() *testdata.MockAttemptStorage {
+				storage := testdata.NewMockAttemptStorage(ctrl)
+				storage.EXPECT().GetUserTimezone(gomock.Any(), "1").Return("", nil)
+				storage.EXPECT().GetAttempts(gomock.Any(), "1", topics).Return(nil, nil)
+				storage.EXPECT().GetAttemptGrants(gomock.Any(), "1", topics,
+					gomock.Any()).Return(nil, errors.New("grants error"))
+				return storage
+			},
+			expectedError: "GetAttemptGrants",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			service, err := cases.NewAttemptServiceBase(tc.setupMocks(),
+				cases.WithAttemptPolicy(tc.policy))
+			require.NoError(t, err)
+
+			err = service.CheckAttempt(context.Background(), "1", topics)
+			switch {
+			case tc.expectedIs != nil:
+				require.ErrorIs(t, err, tc.expectedIs)
+			case tc.expectedError != "":
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tc.expectedError)
+			default:
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestAttemptServiceBase_GrantAttempts(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	storage := testdata.NewMockAttemptStorage(ctrl)
+	storage.EXPECT().StoreAttemptGrant(gomock.Any(), "1", gomock.Any()).DoAndReturn(
+		func(_ context.Context, _ string, grant entities.AttemptGrant) error {
+			require.Equal(t, "Go", grant.Topic)
+			require.Equal(t, 2, grant.Attempts)
+			require.Equal(t, "2", grant.GrantedBy)
+			require.False(t, grant.GrantedAt.IsZero())
+			return nil
+		})
+
+	service, err := cases.NewAttemptServiceBase(storage)
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	err = service.GrantAttempts(ctx, "1",
+		entities.AttemptGrant{Topic: "Go", Attempts: 2, GrantedBy: "2"})
+	require.NoError(t, err)
+
+	err = service.GrantAttempts(ctx, "", entities.AttemptGrant{Topic: "Go", Attempts: 2})
+	require.ErrorIs(t, err, entities.ErrInvalidParam)
+
+	err = service.GrantAttempts(ctx, "1", entities.AttemptGrant{Topic: "Go"})
+	require.ErrorIs(t, err, entities.ErrInvalidParam)
+}
+
+func TestAttemptServiceBase_SetUserTimezone(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	storage := testdata.NewMockAttemptStorage(ctrl)
+	// the user changes own timezone once in the interval
+	storage.EXPECT().StoreUserTimezone(gomock.Any(), "1", "Europe/Moscow", gomock.Not(gomock.Nil())).
+		DoAndReturn(func(_ context.Context, _ string, _ string, changedBefore *time.Time) error {
+			require.WithinDuration(t, time.Now().UTC().Add(-entities.DefaultTimezoneChangeInterval),
+				*changedBefore, time.Minute)
+			return nil
+		})
+	storage.EXPECT().StoreUserTimezone(gomock.Any(), "1", "Asia/Tokyo", gomock.Not(gomock.Nil())).
+		Return(fmt.Errorf("changed recently: %w", entities.ErrConflict))
+	// the mentor is not limited
+	storage.EXPECT().StoreUserTimezone(gomock.Any(), "1", "Asia/Tokyo", gomock.Nil()).Return(nil)
+
+	service, err := cases.NewAttemptServiceBase(storage)
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	require.NoError(t, service.SetUserTimezone(ctx, "1", "Europe/Moscow", "1"))
+	require.ErrorIs(t, service.SetUserTimezone(ctx, "1", "Asia/Tokyo", "1"), entities.ErrConflict)
+	require.NoError(t, service.SetUserTimezone(ctx, "1", "Asia/Tokyo", "5"))
+	require.ErrorIs(t, service.SetUserTimezone(ctx, "1", "", "1"), entities.ErrInvalidParam)
+	require.ErrorIs(t, service.SetUserTimezone(ctx, "1", "Mars/Olympus", "1"),
+		entities.ErrInvalidParam)
+}
+
+func TestAttemptServiceBase_SetUserTimezone_NoInterval(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	storage := testdata.NewMockAttemptStorage(ctrl)
+	storage.EXPECT().StoreUserTimezone(gomock.Any(), "1", "Europe/Moscow", gomock.Nil()).Return(nil)
+
+	policy := entities.DefaultAttemptPolicy()
+	policy.TimezoneChangeInterval = 0
+
+	service, err := cases.NewAttemptServiceBase(storage, cases.WithAttemptPolicy(policy))
+	require.NoError(t, err)
+
+	require.NoError(t, service.SetUserTimezone(context.Background(), "1", "Europe/Moscow", "1"))
+}
@@ -0,0 +1,23 @@
+package cases
+
+import (
+	"context"
+	"time"
+
+	"github.com/parta4ok/kvs/question/internal/entities"
+)
+
+//go:generate mockgen -source=attempt_storage.go -destination=./testdata/attempt_storage.go -package=testdata
+type AttemptStorage interface {
+	GetAttempts(ctx context.Context, userID string, topics []string) ([]entities.Attempt, error)
+	GetAttemptGrants(ctx context.Context, userID string, topics []string, since time.Time) (
+		[]entities.AttemptGrant, error)
+	StoreAttemptGrant(ctx context.Context, studentID string, grant entities.AttemptGrant) error
+	// GetUserTimezone returns empty timezone if the user has not set it.
+	GetUserTimezone(ctx context.Context, userID string) (string, error)
+	// StoreUserTimezone saves the timezone unless it was changed after changedBefore, then it
+	// returns entities.ErrConflict. Nil changedBefore stores the timezone in any case. Storing
+	// the current timezone again does not count as a change.
+	StoreUserTimezone(ctx context.Context, userID string, timezone string,
+		changedBefore *time.Time) error
+}
@@ -0,0 +1,189 @@
+package cases
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/parta4ok/kvs/question/internal/entities"
+)
+
+const (
+	defaultSweepInterval  = time.Minute
+	defaultSweepBatchSize = 100
+)
+
+// ExpirySweeper completes active sessions abandoned after their deadline and removes drafts
+// left from completed sessions. Several replicas may sweep at the same time: storage accepts
+// only one completion of the session and no pause or resume after it, the others get
+// entities.ErrConflict and skip the session.
+type ExpirySweeper struct {
+	storage      Storage
+	broker       MessageBroker
+	interval     time.Duration
+	batchSize    int
+	timeoutEvent time.Duration
+}
+
+func NewExpirySweeper(storage Storage, broker MessageBroker,
+	opts ...ExpirySweeperOption) (*ExpirySweeper, error) {
+	if storage == nil {
+		return nil, errors.Wrap(entities.ErrInvalidParam, "storage not set")
+	}
+
+	if broker == nil {
+		return nil, errors.Wrap(entities.ErrInvalidParam, "message broker not set")
+	}
+
+	sweeper := &ExpirySweeper{
+		storage:      storage,
+		broker:       broker,
+		interval:     defaultSweepInterval,
+		batchSize:    defaultSweepBatchSize,
+		timeoutEvent: timeoutEventDefault,
+	}
+
+	for _, opt := range opts {
+		opt(sweeper)
+	}
+
+	return sweeper, nil
+}
+
+type ExpirySweeperOption func(*ExpirySweeper)
+
+func WithSweepInterval(interval time.Duration) ExpirySweeperOption {
+	return func(sweeper *ExpirySweeper) {
+		if interval > 0 {
+			sweeper.interval = interval
+		}
+	}
+}
+
+func WithSweepBatchSize(batchSize int) ExpirySweeperOption {
+	return func(sweeper *ExpirySweeper) {
+		if batchSize > 0 {
+			sweeper.batchSize = batchSize
+		}
+	}
+}
+
+func WithSweepEventTimeout(timeout time.Duration) ExpirySweeperOption {
+	return func(sweeper *ExpirySweeper) {
+		if timeout > 0 {
+			sweeper.timeoutEvent = timeout
+		}
+	}
+}
+
+// Run sweeps expired sessions every interval until ctx is done.
+func (sweeper *ExpirySweeper) Run(ctx context.Context) {
+	slog.Info("ExpirySweeper started", "interval", sweeper.interval.String())
+
+	ticker := time.NewTicker(sweeper.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			slog.Info("ExpirySweeper completed")
+			return
+		case <-ticker.C:
+			if _, err := sweeper.Sweep(ctx); err != nil {
+				slog.Warn("Failed to sweep expired sessions", "error", err)
+			}
+		}
+	}
+}
+
+// Sweep expires one batch of abandoned sessions and returns the number of expired sessions.
+func (sweeper *ExpirySweeper) Sweep(ctx context.Context) (int, error) {
+	slog.Info("Sweep started")
+
+	sessionIDs, err := sweeper.storage.GetExpiredSessionIDs(ctx, time.Now().UTC(),
+		sweeper.batchSize)
+	if err != nil {
+		slog.Error(err.Error())
+		return 0, errors.Wrap(err, "GetExpiredSessionIDs")
+	}
+
+	var expired int
+	for _, sessionID := range sessionIDs {
+		ok, err := sweeper.expire(ctx, sessionID)
+		if err != nil {
+			slog.Warn("Failed to expire session", "session_id", sessionID, "error", err)
+			continue
+		}
+
+		if ok {
+			expired++
+		}
+	}
+
+	// drafts are removed on completion, the ones left before that are removed here
+	if _, err := sweeper.storage.DeleteStaleDrafts(ctx, sweeper.batchSize); err != nil {
+		slog.Warn("Failed to delete stale drafts", "error", err)
+	}
+
+	slog.Info("Sweep completed", "expired", expired)
+	return expired, nil
+}
+
+func (sweeper *ExpirySweeper) expire(ctx context.Context, sessionID string) (bool, error) {
+	session, err := sweeper.storage.GetSessionBySessionID(ctx, sessionID)
+	if err != nil {
+		return false, errors.Wrap(err, "GetSessionBySessionID")
+	}
+
+	// the session could be completed after it was selected
+	if session.GetStatus() != entities.ActiveState {
+		return false, nil
+	}
+
+	// completion after the deadline expires the session, drafts are kept as its answers
+	drafts, err := sweeper.storage.GetDraftAnswers(ctx, sessionID)
+	if err != nil {
+		return false, errors.Wrap(err, "GetDraftAnswers")
+	}
+
+	if err := session.SetUserAnswer(drafts); err != nil {
+		return false, errors.Wrap(err, "SetUserAnswer")
+	}
+
+	isExpired, err := session.IsExpired()
+	if err != nil {
+		return false, errors.Wrap(err, "IsExpired")
+	}
+
+	if !isExpired {
+		return false, nil
+	}
+
+	sessionResult, err := session.GetSessionResult()
+	if err != nil {
+		return false, errors.Wrap(err, "GetSessionResult")
+	}
+
+	if err := sweeper.storage.StoreSession(ctx, session); err != nil {
+		if errors.Is(err, entities.ErrConflict) {
+			return false, nil
+		}
+
+		return false, errors.Wrap(err, "StoreSession")
+	}
+
+	sessionResult.UserID = session.GetUserID()
+	sessionResult.Topics = session.GetTopics()
+
+	msgCtx, cancel := context.WithTimeout(ctx, sweeper.timeoutEvent)
+	defer cancel()
+
+	if err := sweeper.broker.SessionFinishedEvent(msgCtx, sessionResult); err != nil {
+		slog.Warn("Failed to send session finished event",
+			"session_id", sessionID, "error", err)
+	}
+
+	return true, nil
+}
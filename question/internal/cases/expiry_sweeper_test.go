@@ -0,0 +1,207 @@
+package cases_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/parta4ok/kvs/question/internal/cases"
+	"github.com/parta4ok/kvs/question/internal/cases/testdata"
+	"github.com/parta4ok/kvs/question/internal/entities"
+	entitiesTestdata "github.com/parta4ok/kvs/question/internal/entities/testdata"
+)
+
+func TestNewExpirySweeper_ValidationErrors(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	_, err := cases.NewExpirySweeper(nil, testdata.NewMockMessageBroker(ctrl))
+	require.ErrorIs(t, err, entities.ErrInvalidParam)
+
+	_, err = cases.NewExpirySweeper(testdata.NewMockStorage(ctrl), nil)
+	require.ErrorIs(t, err, entities.ErrInvalidParam)
+}
+
+func TestExpirySweeper_Sweep(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	expiredResult := &entities.SessionResult{Outcome: entities.OutcomeExpired}
+
+	newActiveSession := func(isExpired bool) (*entities.Session,
+		*entitiesTestdata.MockSessionState) {
+		state := entitiesTestdata.NewMockSessionState(ctrl)
+		state.EXPECT().GetStatus().Return(entities.ActiveState)
+		state.EXPECT().SetUserAnswer(gomock.Nil()).Return(nil)
+		state.EXPECT().IsExpired().Return(isExpired, nil)
+
+		return entities.NewSessionWithCustomState("123", "1", []string{"Go"}, state), state
+	}
+
+	testCases := []struct {
+		name            string
+		setupMocks      func() (*testdata.MockStorage, *testdata.MockMessageBroker)
+		expectedExpired int
+		expectedError   string
+	}{
+		{
+			name: "success",
+			setupMocks: func() (*testdata.MockStorage, *testdata.MockMessageBroker) {
+				storage := testdata.NewMockStorage(ctrl)
+				broker := testdata.NewMockMessageBroker(ctrl)
+
+				session, state := newActiveSession(true)
+				state.EXPECT().GetSessionResult().Return(expiredResult, nil)
+
+				storage.EXPECT().GetExpiredSessionIDs(gomock.Any(), gomock.Any(), 100).Return(
+					[]string{"123"}, nil)
+				storage.EXPECT().DeleteStaleDrafts(gomock.Any(), 100).Return(0, nil)
+				storage.EXPECT().GetSessionBySessionID(gomock.Any(), "123").Return(session, nil)
+				storage.EXPECT().GetDraftAnswers(gomock.Any(), "123").Return(nil, nil)
+				storage.EXPECT().StoreSession(gomock.Any(), session).Return(nil)
+				broker.EXPECT().SessionFinishedEvent(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(_ context.Context, result *entities.SessionResult) error {
+						require.Equal(t, "1", result.UserID)
+						require.Equal(t, []string{"Go"}, result.Topics)
+						require.Equal(t, entities.OutcomeExpired, result.Outcome)
+						return nil
+					})
+
+				return storage, broker
+			},
+			expectedExpired: 1,
+		},
+		{
+			name: "completed_by_another_replica",
+			setupMocks: func() (*testdata.MockStorage, *testdata.MockMessageBroker) {
+				storage := testdata.NewMockStorage(ctrl)
+				broker := testdata.NewMockMessageBroker(ctrl)
+
+				session, state := newActiveSession(true)
+				state.EXPECT().GetSessionResult().Return(expiredResult, nil)
+
+				storage.EXPECT().GetExpiredSessionIDs(gomock.Any(), gomock.Any(), 100).Return(
+					[]string{"123"}, nil)
+				storage.EXPECT().DeleteStaleDrafts(gomock.Any(), 100).Return(0, nil)
+				storage.EXPECT().GetSessionBySessionID(gomock.Any(), "123").Return(session, nil)
+				storage.EXPECT().GetDraftAnswers(gomock.Any(), "123").Return(nil, nil)
+				storage.EXPECT().StoreSession(gomock.Any(), session).Return(
+					fmt.Errorf("session already completed: %w", entities.ErrConflict))
+
+				return storage, broker
+			},
+			expectedExpired: 0,
+		},
+		{
+			name: "already_completed",
+			setupMocks: func() (*testdata.MockStorage, *testdata.MockMessageBroker) {
+				storage := testdata.NewMockStorage(ctrl)
+				broker := testdata.NewMockMessageBroker(ctrl)
+
+				state := entitiesTestdata.NewMockSessionState(ctrl)
+				state.EXPECT().GetStatus().Return(entities.CompletedState)
+				session := entities.NewSessionWithCustomState("123", "1", []string{"Go"}, state)
+
+				storage.EXPECT().GetExpiredSessionIDs(gomock.Any(), gomock.Any(), 100).Return(
+					[]string{"123"}, nil)
+				storage.EXPECT().DeleteStaleDrafts(gomock.Any(), 100).Return(0, nil)
+				storage.EXPECT().GetSessionBySessionID(gomock.Any(), "123").Return(session, nil)
+
+				return storage, broker
+			},
+			expectedExpired: 0,
+		},
+		{
+			name: "deadline_not_passed",
+			setupMocks: func() (*testdata.MockStorage, *testdata.MockMessageBroker) {
+				storage := testdata.NewMockStorage(ctrl)
+				broker := testdata.NewMockMessageBroker(ctrl)
+
+				session, _ := newActiveSession(false)
+
+				storage.EXPECT().GetExpiredSessionIDs(gomock.Any(), gomock.Any(), 100).Return(
+					[]string{"123"}, nil)
+				storage.EXPECT().DeleteStaleDrafts(gomock.Any(), 100).Return(0, nil)
+				storage.EXPECT().GetSessionBySessionID(gomock.Any(), "123").Return(session, nil)
+				storage.EXPECT().GetDraftAnswers(gomock.Any(), "123").Return(nil, nil)
+
+				return storage, broker
+			},
+			expectedExpired: 0,
+		},
+		{
+			name: "session_load_error_skips_session",
+			setupMocks: func() (*testdata.MockStorage, *testdata.MockMessageBroker) {
+				storage := testdata.NewMockStorage(ctrl)
+				broker := testdata.NewMockMessageBroker(ctrl)
+
+				storage.EXPECT().GetExpiredSessionIDs(gomock.Any(), gomock.Any(), 100).Return(
+					[]string{"123"}, nil)
+				storage.EXPECT().DeleteStaleDrafts(gomock.Any(), 100).Return(0, nil)
+				storage.EXPECT().GetSessionBySessionID(gomock.Any(), "123").Return(nil,
+					errors.New("db error"))
+
+				return storage, broker
+			},
+			expectedExpired: 0,
+		},
+		{
+			name: "stale_drafts_error_does_not_fail_sweep",
+			setupMocks: func() (*testdata.MockStorage, *testdata.MockMessageBroker) {
+				storage := testdata.NewMockStorage(ctrl)
+				broker := testdata.NewMockMessageBroker(ctrl)
+
+				storage.EXPECT().GetExpiredSessionIDs(gomock.Any(), gomock.Any(), 100).Return(
+					nil, nil)
+				storage.EXPECT().DeleteStaleDrafts(gomock.Any(), 100).Return(0,
+					errors.New("db error"))
+
+				return storage, broker
+			},
+			expectedExpired: 0,
+		},
+		{
+			name: "get_expired_sessions_error",
+			setupMocks: func() (*testdata.MockStorage, *testdata.MockMessageBroker) {
+				storage := testdata.NewMockStorage(ctrl)
+				broker := testdata.NewMockMessageBroker(ctrl)
+
+				storage.EXPECT().GetExpiredSessionIDs(gomock.Any(), gomock.Any(), 100).Return(nil,
+					errors.New("db error"))
+
+				return storage, broker
+			},
+			expectedExpired: 0,
+			expectedError:   "GetExpiredSessionIDs",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			storage, broker := tc.setupMocks()
+
+			sweeper, err := cases.NewExpirySweeper(storage, broker)
+			require.NoError(t, err)
+
+			expired, err := sweeper.Sweep(context.Background())
+			if tc.expectedError != "" {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tc.expectedError)
+			} else {
+				require.NoError(t, err)
+			}
+			require.Equal(t, tc.expectedExpired, expired)
+		})
+	}
+}
@@ -0,0 +1,101 @@
+package cases
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/parta4ok/kvs/question/internal/entities"
+)
+
+const (
+	defaultItemAnalysisInterval = 24 * time.Hour
+)
+
+// ItemAnalysisJob recomputes item statistics of every active topic. Statistics of a topic are
+// replaced as a whole, so several replicas may run the job at the same time.
+type ItemAnalysisJob struct {
+	service  ItemAnalysisService
+	storage  ItemAnalysisStorage
+	interval time.Duration
+}
+
+func NewItemAnalysisJob(service ItemAnalysisService, storage ItemAnalysisStorage,
+	opts ...ItemAnalysisJobOption) (*ItemAnalysisJob, error) {
+	if service == nil {
+		return nil, errors.Wrap(entities.ErrInvalidParam, "item analysis service not set")
+	}
+
+	if storage == nil {
+		return nil, errors.Wrap(entities.ErrInvalidParam, "item analysis storage not set")
+	}
+
+	job := &ItemAnalysisJob{
+		service:  service,
+		storage:  storage,
+		interval: defaultItemAnalysisInterval,
+	}
+
+	for _, opt := range opts {
+		opt(job)
+	}
+
+	return job, nil
+}
+
+type ItemAnalysisJobOption func(*ItemAnalysisJob)
+
+func WithItemAnalysisInterval(interval time.Duration) ItemAnalysisJobOption {
+	return func(job *ItemAnalysisJob) {
+		if interval > 0 {
+			job.interval = interval
+		}
+	}
+}
+
+// Run analyzes all topics every interval until ctx is done.
+func (job *ItemAnalysisJob) Run(ctx context.Context) {
+	slog.Info("ItemAnalysisJob started", "interval", job.interval.String())
+
+	ticker := time.NewTicker(job.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			slog.Info("ItemAnalysisJob completed")
+			return
+		case <-ticker.C:
+			if _, err := job.Analyze(ctx); err != nil {
+				slog.Warn("Failed to analyze items", "error", err)
+			}
+		}
+	}
+}
+
+// Analyze computes item statistics of every active topic and returns the number of analyzed
+// topics. A failed topic does not stop analysis of the others.
+func (job *ItemAnalysisJob) Analyze(ctx context.Context) (int, error) {
+	slog.Info("Analyze started")
+
+	topicIDs, err := job.storage.GetTopicIDs(ctx)
+	if err != nil {
+		slog.Error(err.Error())
+		return 0, errors.Wrap(err, "GetTopicIDs")
+	}
+
+	var analyzed int
+	for _, topicID := range topicIDs {
+		if _, err := job.service.AnalyzeTopic(ctx, topicID); err != nil {
+			slog.Warn("Failed to analyze topic", "topic_id", topicID, "error", err)
+			continue
+		}
+
+		analyzed++
+	}
+
+	slog.Info("Analyze completed", "analyzed", analyzed)
+	return analyzed, nil
+}
@@ -0,0 +1,15 @@
+package cases
+
+import (
+	"context"
+
+	"github.com/parta4ok/kvs/question/internal/entities"
+)
+
+//go:generate mockgen -source=./item_analysis_service.go -destination=./testdata/item_analysis_service.go -package=testdata
+type ItemAnalysisService interface {
+	// AnalyzeTopic computes and stores item statistics of active questions of the topic.
+	AnalyzeTopic(ctx context.Context, topicID string) ([]entities.ItemStatistics, error)
+	// GetItemStatistics returns item statistics of the topic computed last time.
+	GetItemStatistics(ctx context.Context, topicID string) ([]entities.ItemStatistics, error)
+}
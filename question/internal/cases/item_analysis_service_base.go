@@ -0,0 +1,79 @@
+package cases
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/parta4ok/kvs/question/internal/entities"
+)
+
+var (
+	_ ItemAnalysisService = (*ItemAnalysisServiceBase)(nil)
+)
+
+// ItemAnalysisServiceBase computes item statistics of questions from completed exam sessions.
+type ItemAnalysisServiceBase struct {
+	storage ItemAnalysisStorage
+}
+
+func NewItemAnalysisServiceBase(storage ItemAnalysisStorage) (*ItemAnalysisServiceBase, error) {
+	if storage == nil {
+		return nil, errors.Wrap(entities.ErrInvalidParam, "item analysis storage not set")
+	}
+
+	return &ItemAnalysisServiceBase{storage: storage}, nil
+}
+
+func (srv *ItemAnalysisServiceBase) AnalyzeTopic(ctx context.Context, topicID string) (
+	[]entities.ItemStatistics, error) {
+	slog.Info("AnalyzeTopic started")
+
+	if _, err := srv.storage.GetTopicByID(ctx, topicID); err != nil {
+		slog.Error(err.Error())
+		return nil, errors.Wrap(err, "GetTopicByID")
+	}
+
+	questions, err := srv.storage.GetTopicQuestions(ctx, topicID)
+	if err != nil {
+		slog.Error(err.Error())
+		return nil, errors.Wrap(err, "GetTopicQuestions")
+	}
+
+	responses, err := srv.storage.GetExamResponses(ctx, topicID)
+	if err != nil {
+		slog.Error(err.Error())
+		return nil, errors.Wrap(err, "GetExamResponses")
+	}
+
+	statistics := entities.AnalyzeItems(questions, responses, time.Now().UTC())
+
+	if err := srv.storage.StoreItemStatistics(ctx, topicID, statistics); err != nil {
+		slog.Error(err.Error())
+		return nil, errors.Wrap(err, "StoreItemStatistics")
+	}
+
+	slog.Info("AnalyzeTopic completed", "topic_id", topicID, "questions", len(statistics))
+	return statistics, nil
+}
+
+func (srv *ItemAnalysisServiceBase) GetItemStatistics(ctx context.Context, topicID string) (
+	[]entities.ItemStatistics, error) {
+	slog.Info("GetItemStatistics started")
+
+	if _, err := srv.storage.GetTopicByID(ctx, topicID); err != nil {
+		slog.Error(err.Error())
+		return nil, errors.Wrap(err, "GetTopicByID")
+	}
+
+	statistics, err := srv.storage.GetItemStatistics(ctx, topicID)
+	if err != nil {
+		slog.Error(err.Error())
+		return nil, errors.Wrap(err, "GetItemStatistics")
+	}
+
+	slog.Info("GetItemStatistics completed")
+	return statistics, nil
+}
@@ -0,0 +1,172 @@
+package cases_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/parta4ok/kvs/question/internal/cases"
+	"github.com/parta4ok/kvs/question/internal/cases/testdata"
+	"github.com/parta4ok/kvs/question/internal/entities"
+)
+
+func TestNewItemAnalysisServiceBase(t *testing.T) {
+	t.Parallel()
+
+	_, err := cases.NewItemAnalysisServiceBase(nil)
+	require.ErrorIs(t, err, entities.ErrInvalidParam)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	service, err := cases.NewItemAnalysisServiceBase(testdata.NewMockItemAnalysisStorage(ctrl))
+	require.NoError(t, err)
+	require.NotNil(t, service)
+}
+
+func TestItemAnalysisServiceBase_AnalyzeTopic(t *testing.T) {
+	t.Parallel()
+
+	question := entities.NewTrueOrFalseSelectionQuestion("1", "Go", "nil map можно читать",
+		true)
+	answer, err := entities.NewUserAnswer("1", []string{"true"})
+	require.NoError(t, err)
+
+	responses := []entities.ExamResponses{{
+		SessionID:   "10",
+		Score:       100,
+		QuestionIDs: []string{"1"},
+		Answers:     []*entities.UserAnswer{answer},
+	}}
+
+	testCases := []struct {
+		name          string
+		setupMocks    func(storage *testdata.MockItemAnalysisStorage)
+		expectedError error
+	}{
+		{
+			name: "success",
+			setupMocks: func(storage *testdata.MockItemAnalysisStorage) {
+				storage.EXPECT().GetTopicByID(gomock.Any(), "1").Return(
+					entities.NewTopic("1", "Go"))
+				storage.EXPECT().GetTopicQuestions(gomock.Any(), "1").Return(
+					[]entities.Question{question}, nil)
+				storage.EXPECT().GetExamResponses(gomock.Any(), "1").Return(responses, nil)
+				storage.EXPECT().StoreItemStatistics(gomock.Any(), "1", gomock.Any()).DoAndReturn(
+					func(_ context.Context, _ string, statistics []entities.ItemStatistics) error {
+						require.Len(t, statistics, 1)
+						require.Equal(t, 1, statistics[0].Correct)
+						return nil
+					})
+			},
+		},
+		{
+			name: "topic_not_found",
+			setupMocks: func(storage *testdata.MockItemAnalysisStorage) {
+				storage.EXPECT().GetTopicByID(gomock.Any(), "1").Return(nil,
+					entities.ErrNotFound)
+			},
+			expectedError: entities.ErrNotFound,
+		},
+		{
+			name: "store_failure",
+			setupMocks: func(storage *testdata.MockItemAnalysisStorage) {
+				storage.EXPECT().GetTopicByID(gomock.Any(), "1").Return(
+					entities.NewTopic("1", "Go"))
+				storage.EXPECT().GetTopicQuestions(gomock.Any(), "1").Return(
+					[]entities.Question{question}, nil)
+				storage.EXPECT().GetExamResponses(gomock.Any(), "1").Return(responses, nil)
+				storage.EXPECT().StoreItemStatistics(gomock.Any(), "1", gomock.Any()).Return(
+					entities.ErrInternal)
+			},
+			expectedError: entities.ErrInternal,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			storage := testdata.NewMockItemAnalysisStorage(ctrl)
+			tc.setupMocks(storage)
+
+			service, err := cases.NewItemAnalysisServiceBase(storage)
+			require.NoError(t, err)
+
+			statistics, err := service.AnalyzeTopic(context.Background(), "1")
+			if tc.expectedError != nil {
+				require.ErrorIs(t, err, tc.expectedError)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Len(t, statistics, 1)
+			require.InDelta(t, 100.0, statistics[0].Difficulty, 1e-9)
+		})
+	}
+}
+
+func TestItemAnalysisServiceBase_GetItemStatistics(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	statistics := []entities.ItemStatistics{{QuestionID: "1", Responses: 3, Correct: 2}}
+
+	storage := testdata.NewMockItemAnalysisStorage(ctrl)
+	storage.EXPECT().GetTopicByID(gomock.Any(), "1").Return(entities.NewTopic("1", "Go"))
+	storage.EXPECT().GetItemStatistics(gomock.Any(), "1").Return(statistics, nil)
+	storage.EXPECT().GetTopicByID(gomock.Any(), "2").Return(nil, entities.ErrNotFound)
+
+	service, err := cases.NewItemAnalysisServiceBase(storage)
+	require.NoError(t, err)
+
+	result, err := service.GetItemStatistics(context.Background(), "1")
+	require.NoError(t, err)
+	require.Equal(t, statistics, result)
+
+	_, err = service.GetItemStatistics(context.Background(), "2")
+	require.ErrorIs(t, err, entities.ErrNotFound)
+}
+
+func TestItemAnalysisJob_Analyze(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	_, err := cases.NewItemAnalysisJob(nil, testdata.NewMockItemAnalysisStorage(ctrl))
+	require.ErrorIs(t, err, entities.ErrInvalidParam)
+
+	_, err = cases.NewItemAnalysisJob(testdata.NewMockItemAnalysisService(ctrl), nil)
+	require.ErrorIs(t, err, entities.ErrInvalidParam)
+
+	storage := testdata.NewMockItemAnalysisStorage(ctrl)
+	service := testdata.NewMockItemAnalysisService(ctrl)
+
+	storage.EXPECT().GetTopicIDs(gomock.Any()).Return([]string{"1", "2", "3"}, nil)
+	service.EXPECT().AnalyzeTopic(gomock.Any(), "1").Return(nil, nil)
+	// a failed topic does not stop analysis of the others
+	service.EXPECT().AnalyzeTopic(gomock.Any(), "2").Return(nil, errors.New("connection reset"))
+	service.EXPECT().AnalyzeTopic(gomock.Any(), "3").Return(nil, nil)
+
+	job, err := cases.NewItemAnalysisJob(service, storage)
+	require.NoError(t, err)
+
+	analyzed, err := job.Analyze(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, 2, analyzed)
+
+	storage.EXPECT().GetTopicIDs(gomock.Any()).Return(nil, entities.ErrInternal)
+
+	_, err = job.Analyze(context.Background())
+	require.ErrorIs(t, err, entities.ErrInternal)
+}
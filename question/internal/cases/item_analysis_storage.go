@@ -0,0 +1,21 @@
+package cases
+
+import (
+	"context"
+
+	"github.com/parta4ok/kvs/question/internal/entities"
+)
+
+//go:generate mockgen -source=item_analysis_storage.go -destination=./testdata/item_analysis_storage.go -package=testdata
+type ItemAnalysisStorage interface {
+	// GetTopicIDs returns ids of active topics.
+	GetTopicIDs(ctx context.Context) ([]string, error)
+	GetTopicByID(ctx context.Context, topicID string) (*entities.Topic, error)
+	GetTopicQuestions(ctx context.Context, topicID string) ([]entities.Question, error)
+	// GetExamResponses returns answers of completed exam sessions with questions of the topic.
+	GetExamResponses(ctx context.Context, topicID string) ([]entities.ExamResponses, error)
+	// StoreItemStatistics replaces statistics of questions of the topic.
+	StoreItemStatistics(ctx context.Context, topicID string,
+		statistics []entities.ItemStatistics) error
+	GetItemStatistics(ctx context.Context, topicID string) ([]entities.ItemStatistics, error)
+}
@@ -0,0 +1,14 @@
+package cases
+
+import (
+	"context"
+)
+
+//go:generate mockgen -source=./mentor_service.go -destination=./testdata/mentor_service.go -package=testdata
+type MentorService interface {
+	// LinkStudent gives the mentor access to resources of the student.
+	LinkStudent(ctx context.Context, mentorID string, studentID string) error
+	UnlinkStudent(ctx context.Context, mentorID string, studentID string) error
+	// GetMentorStudents returns ids of students linked to the mentor.
+	GetMentorStudents(ctx context.Context, mentorID string) ([]string, error)
+}
@@ -0,0 +1,96 @@
+package cases
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/pkg/errors"
+
+	"github.com/parta4ok/kvs/question/internal/entities"
+)
+
+var (
+	_ MentorService = (*MentorServiceBase)(nil)
+)
+
+// MentorServiceBase manages links between mentors and students, the links let mentors view
+// sessions and reports and manage attempts of their students.
+type MentorServiceBase struct {
+	storage MentorStorage
+}
+
+func NewMentorServiceBase(storage MentorStorage) (*MentorServiceBase, error) {
+	if storage == nil {
+		return nil, errors.Wrap(entities.ErrInvalidParam, "mentor storage not set")
+	}
+
+	return &MentorServiceBase{storage: storage}, nil
+}
+
+func (srv *MentorServiceBase) LinkStudent(ctx context.Context, mentorID string,
+	studentID string) error {
+	slog.Info("LinkStudent started")
+
+	if err := srv.validateLink(mentorID, studentID); err != nil {
+		slog.Error(err.Error())
+		return err
+	}
+
+	if err := srv.storage.LinkStudent(ctx, mentorID, studentID); err != nil {
+		slog.Error(err.Error())
+		return errors.Wrap(err, "LinkStudent")
+	}
+
+	slog.Info("LinkStudent completed")
+	return nil
+}
+
+func (srv *MentorServiceBase) UnlinkStudent(ctx context.Context, mentorID string,
+	studentID string) error {
+	slog.Info("UnlinkStudent started")
+
+	if err := srv.validateLink(mentorID, studentID); err != nil {
+		slog.Error(err.Error())
+		return err
+	}
+
+	if err := srv.storage.UnlinkStudent(ctx, mentorID, studentID); err != nil {
+		slog.Error(err.Error())
+		return errors.Wrap(err, "UnlinkStudent")
+	}
+
+	slog.Info("UnlinkStudent completed")
+	return nil
+}
+
+func (srv *MentorServiceBase) GetMentorStudents(ctx context.Context, mentorID string) (
+	[]string, error) {
+	slog.Info("GetMentorStudents started")
+
+	if mentorID == "" {
+		err := errors.Wrap(entities.ErrInvalidParam, "mentor id is empty")
+		slog.Error(err.Error())
+		return nil, err
+	}
+
+	students, err := srv.storage.GetMentorStudents(ctx, mentorID)
+	if err != nil {
+		slog.Error(err.Error())
+		return nil, errors.Wrap(err, "GetMentorStudents")
+	}
+
+	slog.Info("GetMentorStudents completed")
+	return students, nil
+}
+
+func (srv *MentorServiceBase) validateLink(mentorID string, studentID string) error {
+	if mentorID == "" || studentID == "" {
+		return errors.Wrap(entities.ErrInvalidParam, "mentor id and student id must be set")
+	}
+
+	if mentorID == studentID {
+		return errors.Wrap(entities.ErrInvalidParam, "mentor cannot be linked to oneself")
+	}
+
+	return nil
+}
@@ -0,0 +1,138 @@
+package cases_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/parta4ok/kvs/question/internal/cases"
+	"github.com/parta4ok/kvs/question/internal/cases/testdata"
+	"github.com/parta4ok/kvs/question/internal/entities"
+)
+
+func TestNewMentorServiceBase(t *testing.T) {
+	t.Parallel()
+
+	_, err := cases.NewMentorServiceBase(nil)
+	require.ErrorIs(t, err, entities.ErrInvalidParam)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	service, err := cases.NewMentorServiceBase(testdata.NewMockMentorStorage(ctrl))
+	require.NoError(t, err)
+	require.NotNil(t, service)
+}
+
+func TestMentorServiceBase_LinkStudent(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name          string
+		mentorID      string
+		studentID     string
+		setupMocks    func(storage *testdata.MockMentorStorage)
+		expectedError error
+	}{
+		{
+			name:      "success",
+			mentorID:  "5",
+			studentID: "1",
+			setupMocks: func(storage *testdata.MockMentorStorage) {
+				storage.EXPECT().LinkStudent(gomock.Any(), "5", "1").Return(nil)
+			},
+		},
+		{
+			name:          "empty_student",
+			mentorID:      "5",
+			setupMocks:    func(_ *testdata.MockMentorStorage) {},
+			expectedError: entities.ErrInvalidParam,
+		},
+		{
+			name:          "link_to_oneself",
+			mentorID:      "5",
+			studentID:     "5",
+			setupMocks:    func(_ *testdata.MockMentorStorage) {},
+			expectedError: entities.ErrInvalidParam,
+		},
+		{
+			name:      "storage_error",
+			mentorID:  "5",
+			studentID: "1",
+			setupMocks: func(storage *testdata.MockMentorStorage) {
+				storage.EXPECT().LinkStudent(gomock.Any(), "5", "1").Return(entities.ErrInternal)
+			},
+			expectedError: entities.ErrInternal,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			storage := testdata.NewMockMentorStorage(ctrl)
+			tc.setupMocks(storage)
+
+			service, err := cases.NewMentorServiceBase(storage)
+			require.NoError(t, err)
+
+			err = service.LinkStudent(context.Background(), tc.mentorID, tc.studentID)
+			if tc.expectedError != nil {
+				require.ErrorIs(t, err, tc.expectedError)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMentorServiceBase_UnlinkStudent(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	storage := testdata.NewMockMentorStorage(ctrl)
+	storage.EXPECT().UnlinkStudent(gomock.Any(), "5", "1").Return(nil)
+	storage.EXPECT().UnlinkStudent(gomock.Any(), "5", "2").Return(entities.ErrNotFound)
+
+	service, err := cases.NewMentorServiceBase(storage)
+	require.NoError(t, err)
+
+	require.NoError(t, service.UnlinkStudent(context.Background(), "5", "1"))
+	require.ErrorIs(t, service.UnlinkStudent(context.Background(), "5", "2"),
+		entities.ErrNotFound)
+	require.ErrorIs(t, service.UnlinkStudent(context.Background(), "", "2"),
+		entities.ErrInvalidParam)
+}
+
+func TestMentorServiceBase_GetMentorStudents(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	storage := testdata.NewMockMentorStorage(ctrl)
+	storage.EXPECT().GetMentorStudents(gomock.Any(), "5").Return([]string{"1", "2"}, nil)
+	storage.EXPECT().GetMentorStudents(gomock.Any(), "6").Return(nil, errors.New("db error"))
+
+	service, err := cases.NewMentorServiceBase(storage)
+	require.NoError(t, err)
+
+	students, err := service.GetMentorStudents(context.Background(), "5")
+	require.NoError(t, err)
+	require.Equal(t, []string{"1", "2"}, students)
+
+	_, err = service.GetMentorStudents(context.Background(), "6")
+	require.ErrorContains(t, err, "GetMentorStudents")
+
+	_, err = service.GetMentorStudents(context.Background(), "")
+	require.ErrorIs(t, err, entities.ErrInvalidParam)
+}
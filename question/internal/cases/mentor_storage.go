@@ -0,0 +1,16 @@
+package cases
+
+import (
+	"context"
+)
+
+//go:generate mockgen -source=mentor_storage.go -destination=./testdata/mentor_storage.go -package=testdata
+type MentorStorage interface {
+	// LinkStudent links the student to the mentor, linking twice is not an error. A student has
+	// one mentor, so the link replaces the previous one. Returns entities.ErrNotFound if the
+	// mentor or the student does not exist.
+	LinkStudent(ctx context.Context, mentorID string, studentID string) error
+	// UnlinkStudent returns entities.ErrNotFound if the student is not linked to the mentor.
+	UnlinkStudent(ctx context.Context, mentorID string, studentID string) error
+	GetMentorStudents(ctx context.Context, mentorID string) ([]string, error)
+}
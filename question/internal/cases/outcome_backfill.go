@@ -0,0 +1,123 @@
+package cases
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/pkg/errors"
+
+	"github.com/parta4ok/kvs/question/internal/entities"
+)
+
+const (
+	defaultBackfillBatchSize = 100
+)
+
+// OutcomeBackfill stores answer outcomes of sessions completed before outcomes were stored at
+// completion, so repetition cards cover the whole history. Stored outcomes are never
+// overwritten, so several replicas may backfill at the same time.
+type OutcomeBackfill struct {
+	storage   Storage
+	batchSize int
+}
+
+func NewOutcomeBackfill(storage Storage, opts ...OutcomeBackfillOption) (*OutcomeBackfill,
+	error) {
+	if storage == nil {
+		return nil, errors.Wrap(entities.ErrInvalidParam, "storage not set")
+	}
+
+	backfill := &OutcomeBackfill{
+		storage:   storage,
+		batchSize: defaultBackfillBatchSize,
+	}
+
+	for _, opt := range opts {
+		opt(backfill)
+	}
+
+	return backfill, nil
+}
+
+type OutcomeBackfillOption func(*OutcomeBackfill)
+
+func WithOutcomeBackfillBatchSize(batchSize int) OutcomeBackfillOption {
+	return func(backfill *OutcomeBackfill) {
+		if batchSize > 0 {
+			backfill.batchSize = batchSize
+		}
+	}
+}
+
+// Run backfills batches until every completed session is visited or ctx done. Sessions failed
+// to backfill are passed over and retried on the next run.
+func (backfill *OutcomeBackfill) Run(ctx context.Context) {
+	slog.Info("OutcomeBackfill started")
+
+	var (
+		total int
+		after *entities.BackfillCursor
+	)
+	for ctx.Err() == nil {
+		stored, next, err := backfill.Backfill(ctx, after)
+		if err != nil {
+			slog.Warn("Failed to backfill answer outcomes", "error", err)
+			break
+		}
+
+		total += stored
+		if next == nil {
+			break
+		}
+
+		after = next
+	}
+
+	slog.Info("OutcomeBackfill completed", "stored", total)
+}
+
+// Backfill stores outcomes of one batch of sessions after the cursor and returns the number of
+// sessions with stored outcomes and the cursor of the next batch, nil when the batch is the last
+// one. A failed session does not stop the batch.
+func (backfill *OutcomeBackfill) Backfill(ctx context.Context,
+	after *entities.BackfillCursor) (int, *entities.BackfillCursor, error) {
+	slog.Info("Backfill started")
+
+	sessions, err := backfill.storage.GetSessionsWithoutOutcomes(ctx, after, backfill.batchSize)
+	if err != nil {
+		slog.Error(err.Error())
+		return 0, nil, errors.Wrap(err, "GetSessionsWithoutOutcomes")
+	}
+
+	var stored int
+	for _, position := range sessions {
+		if err := backfill.store(ctx, position.SessionID); err != nil {
+			slog.Warn("Failed to backfill answer outcomes", "session_id", position.SessionID,
+				"error", err)
+			continue
+		}
+
+		stored++
+	}
+
+	slog.Info("Backfill completed", "stored", stored)
+
+	if len(sessions) < backfill.batchSize {
+		return stored, nil, nil
+	}
+
+	return stored, &sessions[len(sessions)-1], nil
+}
+
+func (backfill *OutcomeBackfill) store(ctx context.Context, sessionID string) error {
+	session, err := backfill.storage.GetSessionBySessionID(ctx, sessionID)
+	if err != nil {
+		return errors.Wrap(err, "GetSessionBySessionID")
+	}
+
+	if err := backfill.storage.StoreAnswerOutcomes(ctx, session); err != nil {
+		return errors.Wrap(err, "StoreAnswerOutcomes")
+	}
+
+	return nil
+}
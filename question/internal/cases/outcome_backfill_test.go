@@ -0,0 +1,101 @@
+package cases_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/parta4ok/kvs/question/internal/cases"
+	"github.com/parta4ok/kvs/question/internal/cases/testdata"
+	"github.com/parta4ok/kvs/question/internal/entities"
+)
+
+func TestNewOutcomeBackfill_ValidationErrors(t *testing.T) {
+	t.Parallel()
+
+	_, err := cases.NewOutcomeBackfill(nil)
+	require.ErrorIs(t, err, entities.ErrInvalidParam)
+}
+
+func TestOutcomeBackfill_Backfill(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	completedAt := time.Now().UTC()
+	positions := []entities.BackfillCursor{
+		{CompletedAt: completedAt, SessionID: "1"},
+		{CompletedAt: completedAt, SessionID: "2"},
+	}
+
+	session := entities.NewSessionWithCustomState("1", "1", []string{"Go"}, nil)
+	session.ChangeState(entities.NewCompletedSessionState(map[string]entities.Question{
+		"1": entities.NewTrueOrFalseSelectionQuestion("1", "Go", "subject", true),
+	}, session, nil, completedAt, false))
+
+	storage := testdata.NewMockStorage(ctrl)
+	storage.EXPECT().GetSessionsWithoutOutcomes(gomock.Any(), nil, 2).Return(positions, nil)
+	storage.EXPECT().GetSessionBySessionID(gomock.Any(), "1").Return(session, nil)
+	storage.EXPECT().GetSessionBySessionID(gomock.Any(), "2").Return(nil,
+		errors.New("database error"))
+	storage.EXPECT().StoreAnswerOutcomes(gomock.Any(), session).Return(nil)
+
+	backfill, err := cases.NewOutcomeBackfill(storage, cases.WithOutcomeBackfillBatchSize(2))
+	require.NoError(t, err)
+
+	// the full batch continues after its last session, the failed one included
+	stored, next, err := backfill.Backfill(context.Background(), nil)
+	require.NoError(t, err)
+	require.Equal(t, 1, stored)
+	require.Equal(t, &positions[1], next)
+
+	storage.EXPECT().GetSessionsWithoutOutcomes(gomock.Any(), &positions[1], 2).Return(nil,
+		errors.New("database error"))
+
+	_, _, err = backfill.Backfill(context.Background(), &positions[1])
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "GetSessionsWithoutOutcomes")
+}
+
+func TestOutcomeBackfill_Run_FailedBatch(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	completedAt := time.Now().UTC()
+	failedBatch := []entities.BackfillCursor{
+		{CompletedAt: completedAt, SessionID: "1"},
+		{CompletedAt: completedAt, SessionID: "2"},
+	}
+	lastBatch := []entities.BackfillCursor{{CompletedAt: completedAt, SessionID: "3"}}
+
+	session := entities.NewSessionWithCustomState("3", "1", []string{"Go"}, nil)
+	session.ChangeState(entities.NewCompletedSessionState(map[string]entities.Question{}, session,
+		nil, completedAt, false))
+
+	storage := testdata.NewMockStorage(ctrl)
+	gomock.InOrder(
+		storage.EXPECT().GetSessionsWithoutOutcomes(gomock.Any(), nil, 2).Return(failedBatch,
+			nil),
+		storage.EXPECT().GetSessionsWithoutOutcomes(gomock.Any(), &failedBatch[1], 2).Return(
+			lastBatch, nil),
+	)
+	storage.EXPECT().GetSessionBySessionID(gomock.Any(), "1").Return(nil,
+		errors.New("database error"))
+	storage.EXPECT().GetSessionBySessionID(gomock.Any(), "2").Return(nil,
+		errors.New("database error"))
+	storage.EXPECT().GetSessionBySessionID(gomock.Any(), "3").Return(session, nil)
+	storage.EXPECT().StoreAnswerOutcomes(gomock.Any(), session).Return(nil)
+
+	backfill, err := cases.NewOutcomeBackfill(storage, cases.WithOutcomeBackfillBatchSize(2))
+	require.NoError(t, err)
+
+	// the batch where every session fails does not stop the backfill
+	backfill.Run(context.Background())
+}
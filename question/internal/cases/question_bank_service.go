@@ -0,0 +1,37 @@
+package cases
+
+import (
+	"context"
+
+	"github.com/parta4ok/kvs/question/internal/entities"
+)
+
+// QuestionContent describes question data provided by a mentor. Identifier and topic of the
+// question are assigned by the question bank.
+type QuestionContent struct {
+	Type           entities.QuestionType
+	Subject        string
+	Variants       []string
+	CorrectAnswers []string
+	Options        []entities.QuestionOption
+}
+
+//go:generate mockgen -source=./question_bank_service.go -destination=./testdata/question_bank_service.go -package=testdata
+type QuestionBankService interface {
+	CreateTopic(ctx context.Context, name string, opts ...entities.TopicOption) (
+		*entities.Topic, error)
+	UpdateTopic(ctx context.Context, topicID string, name string,
+		opts ...entities.TopicOption) (*entities.Topic, error)
+	DeleteTopic(ctx context.Context, topicID string) error
+	GetTopicQuestions(ctx context.Context, topicID string) ([]entities.Question, error)
+	CreateQuestion(ctx context.Context, topicID string, content QuestionContent) (
+		entities.Question, error)
+	UpdateQuestion(ctx context.Context, questionID string, content QuestionContent) (
+		entities.Question, error)
+	DeleteQuestion(ctx context.Context, questionID string) error
+	// RescoreQuestion queues grading of completed sessions answered with older versions of the
+	// question against its current version, the sessions are graded by the rescore job.
+	RescoreQuestion(ctx context.Context, questionID string) (*entities.RescoreTask, error)
+	// GetRescoreTask returns progress of the last rescoring of the question.
+	GetRescoreTask(ctx context.Context, questionID string) (*entities.RescoreTask, error)
+}
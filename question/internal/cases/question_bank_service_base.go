@@ -0,0 +1,242 @@
+package cases
+
+import (
+	"context"
+	"log/slog"
+	"slices"
+
+	"github.com/pkg/errors"
+
+	"github.com/parta4ok/kvs/question/internal/entities"
+)
+
+var (
+	_ QuestionBankService = (*QuestionBankServiceBase)(nil)
+)
+
+type QuestionBankServiceBase struct {
+	storage QuestionBankStorage
+	factory *entities.QuestionFactory
+}
+
+func NewQuestionBankServiceBase(storage QuestionBankStorage) (*QuestionBankServiceBase, error) {
+	if storage == nil {
+		return nil, errors.Wrap(entities.ErrInvalidParam, "question bank storage not set")
+	}
+
+	return &QuestionBankServiceBase{
+		storage: storage,
+		factory: &entities.QuestionFactory{},
+	}, nil
+}
+
+func (srv *QuestionBankServiceBase) CreateTopic(ctx context.Context, name string,
+	opts ...entities.TopicOption) (*entities.Topic, error) {
+	slog.Info("CreateTopic started")
+
+	topicID, err := srv.storage.NextTopicID(ctx)
+	if err != nil {
+		slog.Error(err.Error())
+		return nil, errors.Wrap(err, "NextTopicID")
+	}
+
+	topic, err := entities.NewTopic(topicID, name, opts...)
+	if err != nil {
+		slog.Error(err.Error())
+		return nil, errors.Wrap(err, "NewTopic")
+	}
+
+	if err := srv.storage.StoreTopic(ctx, topic); err != nil {
+		slog.Error(err.Error())
+		return nil, errors.Wrap(err, "StoreTopic")
+	}
+
+	slog.Info("CreateTopic completed")
+	return topic, nil
+}
+
+func (srv *QuestionBankServiceBase) UpdateTopic(ctx context.Context, topicID string,
+	name string, opts ...entities.TopicOption) (*entities.Topic, error) {
+	slog.Info("UpdateTopic started")
+
+	topic, err := entities.NewTopic(topicID, name, opts...)
+	if err != nil {
+		slog.Error(err.Error())
+		return nil, errors.Wrap(err, "NewTopic")
+	}
+
+	if err := srv.storage.UpdateTopic(ctx, topic); err != nil {
+		slog.Error(err.Error())
+		return nil, errors.Wrap(err, "UpdateTopic")
+	}
+
+	slog.Info("UpdateTopic completed")
+	return topic, nil
+}
+
+func (srv *QuestionBankServiceBase) DeleteTopic(ctx context.Context, topicID string) error {
+	slog.Info("DeleteTopic started")
+
+	if topicID == "" {
+		err := errors.Wrap(entities.ErrInvalidParam, "topicID not set")
+		slog.Error(err.Error())
+		return err
+	}
+
+	if err := srv.storage.DeleteTopic(ctx, topicID); err != nil {
+		slog.Error(err.Error())
+		return errors.Wrap(err, "DeleteTopic")
+	}
+
+	slog.Info("DeleteTopic completed")
+	return nil
+}
+
+func (srv *QuestionBankServiceBase) GetTopicQuestions(ctx context.Context, topicID string) (
+	[]entities.Question, error) {
+	slog.Info("GetTopicQuestions started")
+
+	if _, err := srv.storage.GetTopicByID(ctx, topicID); err != nil {
+		slog.Error(err.Error())
+		return nil, errors.Wrap(err, "GetTopicByID")
+	}
+
+	questions, err := srv.storage.GetTopicQuestions(ctx, topicID)
+	if err != nil {
+		slog.Error(err.Error())
+		return nil, errors.Wrap(err, "GetTopicQuestions")
+	}
+
+	slog.Info("GetTopicQuestions completed")
+	return questions, nil
+}
+
+func (srv *QuestionBankServiceBase) CreateQuestion(ctx context.Context, topicID string,
+	content QuestionContent) (entities.Question, error) {
+	slog.Info("CreateQuestion started")
+
+	topic, err := srv.storage.GetTopicByID(ctx, topicID)
+	if err != nil {
+		slog.Error(err.Error())
+		return nil, errors.Wrap(err, "GetTopicByID")
+	}
+
+	questionID, err := srv.storage.NextQuestionID(ctx)
+	if err != nil {
+		slog.Error(err.Error())
+		return nil, errors.Wrap(err, "NextQuestionID")
+	}
+
+	question, err := srv.newQuestion(questionID, topic.Name(), content)
+	if err != nil {
+		slog.Error(err.Error())
+		return nil, err
+	}
+
+	if err := srv.storage.StoreQuestion(ctx, question); err != nil {
+		slog.Error(err.Error())
+		return nil, errors.Wrap(err, "StoreQuestion")
+	}
+
+	slog.Info("CreateQuestion completed")
+	return question, nil
+}
+
+func (srv *QuestionBankServiceBase) UpdateQuestion(ctx context.Context, questionID string,
+	content QuestionContent) (entities.Question, error) {
+	slog.Info("UpdateQuestion started")
+
+	existing, err := srv.storage.GetQuestionByID(ctx, questionID)
+	if err != nil {
+		slog.Error(err.Error())
+		return nil, errors.Wrap(err, "GetQuestionByID")
+	}
+
+	// every edit adds a version, sessions keep grading with the version they were started with
+	content.Options = append(slices.Clone(content.Options),
+		entities.WithVersion(entities.VersionOf(existing)+1))
+	question, err := srv.newQuestion(existing.ID(), existing.Topic(), content)
+	if err != nil {
+		slog.Error(err.Error())
+		return nil, err
+	}
+
+	if err := srv.storage.UpdateQuestion(ctx, question); err != nil {
+		slog.Error(err.Error())
+		return nil, errors.Wrap(err, "UpdateQuestion")
+	}
+
+	slog.Info("UpdateQuestion completed")
+	return question, nil
+}
+
+func (srv *QuestionBankServiceBase) DeleteQuestion(ctx context.Context, questionID string) error {
+	slog.Info("DeleteQuestion started")
+
+	if questionID == "" {
+		err := errors.Wrap(entities.ErrInvalidParam, "questionID not set")
+		slog.Error(err.Error())
+		return err
+	}
+
+	if err := srv.storage.DeleteQuestion(ctx, questionID); err != nil {
+		slog.Error(err.Error())
+		return errors.Wrap(err, "DeleteQuestion")
+	}
+
+	slog.Info("DeleteQuestion completed")
+	return nil
+}
+
+// RescoreQuestion queues rescoring with the current version of the question. Repeated requests
+// while the task runs return its progress.
+func (srv *QuestionBankServiceBase) RescoreQuestion(ctx context.Context, questionID string) (
+	*entities.RescoreTask, error) {
+	slog.Info("RescoreQuestion started")
+
+	question, err := srv.storage.GetQuestionByID(ctx, questionID)
+	if err != nil {
+		slog.Error(err.Error())
+		return nil, errors.Wrap(err, "GetQuestionByID")
+	}
+
+	task, err := srv.storage.StoreRescoreTask(ctx, question.ID(), entities.VersionOf(question))
+	if err != nil {
+		slog.Error(err.Error())
+		return nil, errors.Wrap(err, "StoreRescoreTask")
+	}
+
+	slog.Info("RescoreQuestion completed")
+	return task, nil
+}
+
+func (srv *QuestionBankServiceBase) GetRescoreTask(ctx context.Context, questionID string) (
+	*entities.RescoreTask, error) {
+	slog.Info("GetRescoreTask started")
+
+	if questionID == "" {
+		err := errors.Wrap(entities.ErrInvalidParam, "questionID not set")
+		slog.Error(err.Error())
+		return nil, err
+	}
+
+	task, err := srv.storage.GetRescoreTask(ctx, questionID)
+	if err != nil {
+		slog.Error(err.Error())
+		return nil, errors.Wrap(err, "GetRescoreTask")
+	}
+
+	slog.Info("GetRescoreTask completed")
+	return task, nil
+}
+
+func (srv *QuestionBankServiceBase) newQuestion(questionID string, topic string,
+	content QuestionContent) (entities.Question, error) {
+	question, err := srv.factory.NewQuestion(questionID, content.Type, topic, content.Subject,
+		content.Variants, content.CorrectAnswers, content.Options...)
+	if err != nil {
+		return nil, errors.Wrap(err, "NewQuestion")
+	}
+
+	return question, nil
+}
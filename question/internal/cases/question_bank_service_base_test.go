@@ -0,0 +1,360 @@
+package cases_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/parta4ok/kvs/question/internal/cases"
+	"github.com/parta4ok/kvs/question/internal/cases/testdata"
+	"github.com/parta4ok/kvs/question/internal/entities"
+)
+
+func TestNewQuestionBankServiceBase(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	service, err := cases.NewQuestionBankServiceBase(testdata.NewMockQuestionBankStorage(ctrl))
+	require.NoError(t, err)
+	require.NotNil(t, service)
+
+	service, err = cases.NewQuestionBankServiceBase(nil)
+	require.ErrorIs(t, err, entities.ErrInvalidParam)
+	require.Nil(t, service)
+}
+
+func TestQuestionBankServiceBase_CreateTopic(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	testCases := []struct {
+		name          string
+		topicName     string
+		setupMocks    func(storage *testdata.MockQuestionBankStorage)
+		expectedError string
+	}{
+		{
+			name:      "success",
+			topicName: "Конкурентность в Go",
+			setupMocks: func(storage *testdata.MockQuestionBankStorage) {
+				storage.EXPECT().NextTopicID(gomock.Any()).Return("4", nil)
+				storage.EXPECT().StoreTopic(gomock.Any(), gomock.Any()).Return(nil)
+			},
+		},
+		{
+			name:      "empty_name",
+			topicName: " ",
+			setupMocks: func(storage *testdata.MockQuestionBankStorage) {
+				storage.EXPECT().NextTopicID(gomock.Any()).Return("4", nil)
+			},
+			expectedError: "NewTopic",
+		},
+		{
+			name:      "next_id_error",
+			topicName: "Конкурентность в Go",
+			setupMocks: func(storage *testdata.MockQuestionBankStorage) {
+				storage.EXPECT().NextTopicID(gomock.Any()).Return("", errors.New("db error"))
+			},
+			expectedError: "NextTopicID",
+		},
+		{
+			name:      "store_error",
+			topicName: "Конкурентность в Go",
+			setupMocks: func(storage *testdata.MockQuestionBankStorage) {
+				storage.EXPECT().NextTopicID(gomock.Any()).Return("4", nil)
+				storage.EXPECT().StoreTopic(gomock.Any(), gomock.Any()).Return(
+					entities.ErrConflict)
+			},
+			expectedError: "StoreTopic",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			storage := testdata.NewMockQuestionBankStorage(ctrl)
+			tc.setupMocks(storage)
+
+			service, err := cases.NewQuestionBankServiceBase(storage)
+			require.NoError(t, err)
+
+			topic, err := service.CreateTopic(context.Background(), tc.topicName)
+			if tc.expectedError != "" {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tc.expectedError)
+				require.Nil(t, topic)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, "4", topic.ID())
+			require.Equal(t, tc.topicName, topic.Name())
+		})
+	}
+}
+
+func TestQuestionBankServiceBase_UpdateAndDeleteTopic(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	storage := testdata.NewMockQuestionBankStorage(ctrl)
+	storage.EXPECT().UpdateTopic(gomock.Any(), gomock.Any()).Return(nil)
+	storage.EXPECT().DeleteTopic(gomock.Any(), "1").Return(nil)
+
+	service, err := cases.NewQuestionBankServiceBase(storage)
+	require.NoError(t, err)
+
+	topic, err := service.UpdateTopic(context.Background(), "1", "Базы данных")
+	require.NoError(t, err)
+	require.Equal(t, "Базы данных", topic.Name())
+
+	_, err = service.UpdateTopic(context.Background(), "", "Базы данных")
+	require.ErrorIs(t, err, entities.ErrInvalidParam)
+
+	require.NoError(t, service.DeleteTopic(context.Background(), "1"))
+	require.ErrorIs(t, service.DeleteTopic(context.Background(), ""), entities.ErrInvalidParam)
+}
+
+func TestQuestionBankServiceBase_CreateQuestion(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	topic, err := entities.NewTopic("1", "Базы данных")
+	require.NoError(t, err)
+
+	validContent := cases.QuestionContent{
+		Type:           entities.SingleSelection,
+		Subject:        "Что делает команда COMMIT?",
+		Variants:       []string{"Отменяет транзакцию", "Сохраняет изменения транзакции"},
+		CorrectAnswers: []string{"Сохраняет изменения транзакции"},
+	}
+
+	testCases := []struct {
+		name          string
+		content       cases.QuestionContent
+		setupMocks    func(storage *testdata.MockQuestionBankStorage)
+		expectedError string
+	}{
+		{
+			name:    "success",
+			content: validContent,
+			setupMocks: func(storage *testdata.MockQuestionBankStorage) {
+				storage.EXPECT().GetTopicByID(gomock.Any(), "1").Return(topic, nil)
+				storage.EXPECT().NextQuestionID(gomock.Any()).Return("42", nil)
+				storage.EXPECT().StoreQuestion(gomock.Any(), gomock.Any()).Return(nil)
+			},
+		},
+		{
+			name: "short_answer_with_normalization",
+			content: cases.QuestionContent{
+				Type:           entities.ShortAnswer,
+				Subject:        "Какой уровень изоляции в PostgreSQL используется по умолчанию?",
+				CorrectAnswers: []string{"Read Committed"},
+				Options: []entities.QuestionOption{
+					entities.WithAnswerNormalization(entities.AnswerNormalization{
+						FoldCase:       true,
+						TrimSpace:      true,
+						FoldLookalikes: true,
+					}),
+				},
+			},
+			setupMocks: func(storage *testdata.MockQuestionBankStorage) {
+				storage.EXPECT().GetTopicByID(gomock.Any(), "1").Return(topic, nil)
+				storage.EXPECT().NextQuestionID(gomock.Any()).Return("42", nil)
+				storage.EXPECT().StoreQuestion(gomock.Any(), gomock.Any()).Return(nil)
+			},
+		},
+		{
+			name:    "topic_not_found",
+			content: validContent,
+			setupMocks: func(storage *testdata.MockQuestionBankStorage) {
+				storage.EXPECT().GetTopicByID(gomock.Any(), "1").Return(nil,
+					entities.ErrNotFound)
+			},
+			expectedError: "GetTopicByID",
+		},
+		{
+			name: "invalid_content",
+			content: cases.QuestionContent{
+				Type:           entities.SingleSelection,
+				Subject:        "Что делает команда COMMIT?",
+				Variants:       []string{"Отменяет транзакцию"},
+				CorrectAnswers: []string{"Сохраняет изменения транзакции"},
+			},
+			setupMocks: func(storage *testdata.MockQuestionBankStorage) {
+				storage.EXPECT().GetTopicByID(gomock.Any(), "1").Return(topic, nil)
+				storage.EXPECT().NextQuestionID(gomock.Any()).Return("42", nil)
+			},
+			expectedError: "NewQuestion",
+		},
+		{
+			name:    "store_error",
+			content: validContent,
+			setupMocks: func(storage *testdata.MockQuestionBankStorage) {
+				storage.EXPECT().GetTopicByID(gomock.Any(), "1").Return(topic, nil)
+				storage.EXPECT().NextQuestionID(gomock.Any()).Return("42", nil)
+				storage.EXPECT().StoreQuestion(gomock.Any(), gomock.Any()).Return(
+					errors.New("db error"))
+			},
+			expectedError: "StoreQuestion",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			storage := testdata.NewMockQuestionBankStorage(ctrl)
+			tc.setupMocks(storage)
+
+			service, err := cases.NewQuestionBankServiceBase(storage)
+			require.NoError(t, err)
+
+			question, err := service.CreateQuestion(context.Background(), "1", tc.content)
+			if tc.expectedError != "" {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tc.expectedError)
+				require.Nil(t, question)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, "42", question.ID())
+			require.Equal(t, topic.Name(), question.Topic())
+			require.Equal(t, tc.content.CorrectAnswers, question.CorrectAnswers())
+		})
+	}
+}
+
+func TestQuestionBankServiceBase_UpdateQuestion(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	existing := entities.NewTrueOrFalseSelectionQuestion("7", "Базы данных",
+		"Индексы всегда ускоряют вставку", true)
+
+	storage := testdata.NewMockQuestionBankStorage(ctrl)
+	storage.EXPECT().GetQuestionByID(gomock.Any(), "7").Return(existing, nil)
+	storage.EXPECT().UpdateQuestion(gomock.Any(), gomock.Any()).Return(nil)
+
+	service, err := cases.NewQuestionBankServiceBase(storage)
+	require.NoError(t, err)
+
+	question, err := service.UpdateQuestion(context.Background(), "7", cases.QuestionContent{
+		Type:           entities.TrueOrFalse,
+		Subject:        "Индексы всегда ускоряют вставку",
+		CorrectAnswers: []string{"false"},
+	})
+	require.NoError(t, err)
+	require.Equal(t, "7", question.ID())
+	require.Equal(t, "Базы данных", question.Topic())
+	require.Equal(t, []string{"false"}, question.CorrectAnswers())
+	require.Equal(t, 2, entities.VersionOf(question))
+}
+
+func TestQuestionBankServiceBase_RescoreQuestion(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	factory := &entities.QuestionFactory{}
+	fixed, err := factory.NewQuestion("7", entities.TrueOrFalse, "Базы данных",
+		"Индексы всегда ускоряют вставку", nil, []string{"false"}, entities.WithVersion(2))
+	require.NoError(t, err)
+
+	task := &entities.RescoreTask{QuestionID: "7", Version: 2, Status: entities.RescorePending}
+
+	storage := testdata.NewMockQuestionBankStorage(ctrl)
+	storage.EXPECT().GetQuestionByID(gomock.Any(), "7").Return(fixed, nil)
+	storage.EXPECT().StoreRescoreTask(gomock.Any(), "7", 2).Return(task, nil)
+	storage.EXPECT().GetQuestionByID(gomock.Any(), "8").Return(nil, entities.ErrNotFound)
+	storage.EXPECT().GetRescoreTask(gomock.Any(), "7").Return(task, nil)
+	storage.EXPECT().GetRescoreTask(gomock.Any(), "8").Return(nil, entities.ErrNotFound)
+
+	service, err := cases.NewQuestionBankServiceBase(storage)
+	require.NoError(t, err)
+
+	queued, err := service.RescoreQuestion(context.Background(), "7")
+	require.NoError(t, err)
+	require.Equal(t, task, queued)
+
+	_, err = service.RescoreQuestion(context.Background(), "8")
+	require.ErrorIs(t, err, entities.ErrNotFound)
+
+	progress, err := service.GetRescoreTask(context.Background(), "7")
+	require.NoError(t, err)
+	require.Equal(t, task, progress)
+
+	_, err = service.GetRescoreTask(context.Background(), "8")
+	require.ErrorIs(t, err, entities.ErrNotFound)
+
+	_, err = service.GetRescoreTask(context.Background(), "")
+	require.ErrorIs(t, err, entities.ErrInvalidParam)
+}
+
+func TestQuestionBankServiceBase_UpdateQuestion_Deleted(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	storage := testdata.NewMockQuestionBankStorage(ctrl)
+	storage.EXPECT().GetQuestionByID(gomock.Any(), "7").Return(nil, entities.ErrNotFound)
+
+	service, err := cases.NewQuestionBankServiceBase(storage)
+	require.NoError(t, err)
+
+	_, err = service.UpdateQuestion(context.Background(), "7", cases.QuestionContent{
+		Type:           entities.TrueOrFalse,
+		Subject:        "Индексы всегда ускоряют вставку",
+		CorrectAnswers: []string{"false"},
+	})
+	require.ErrorIs(t, err, entities.ErrNotFound)
+}
+
+func TestQuestionBankServiceBase_GetTopicQuestionsAndDelete(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	topic, err := entities.NewTopic("1", "Базы данных")
+	require.NoError(t, err)
+
+	storage := testdata.NewMockQuestionBankStorage(ctrl)
+	storage.EXPECT().GetTopicByID(gomock.Any(), "1").Return(topic, nil)
+	storage.EXPECT().GetTopicQuestions(gomock.Any(), "1").Return([]entities.Question{}, nil)
+	storage.EXPECT().GetTopicByID(gomock.Any(), "2").Return(nil, entities.ErrNotFound)
+	storage.EXPECT().DeleteQuestion(gomock.Any(), "7").Return(nil)
+
+	service, err := cases.NewQuestionBankServiceBase(storage)
+	require.NoError(t, err)
+
+	questions, err := service.GetTopicQuestions(context.Background(), "1")
+	require.NoError(t, err)
+	require.Empty(t, questions)
+
+	_, err = service.GetTopicQuestions(context.Background(), "2")
+	require.ErrorIs(t, err, entities.ErrNotFound)
+
+	require.NoError(t, service.DeleteQuestion(context.Background(), "7"))
+	require.ErrorIs(t, service.DeleteQuestion(context.Background(), ""),
+		entities.ErrInvalidParam)
+}
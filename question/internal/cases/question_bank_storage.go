@@ -0,0 +1,43 @@
+package cases
+
+import (
+	"context"
+	"time"
+
+	"github.com/parta4ok/kvs/question/internal/entities"
+)
+
+//go:generate mockgen -source=question_bank_storage.go -destination=./testdata/question_bank_storage.go -package=testdata
+type QuestionBankStorage interface {
+	NextTopicID(ctx context.Context) (string, error)
+	StoreTopic(ctx context.Context, topic *entities.Topic) error
+	// UpdateTopic returns entities.ErrConflict when the topic used by sessions or attempt grants
+	// is renamed.
+	UpdateTopic(ctx context.Context, topic *entities.Topic) error
+	DeleteTopic(ctx context.Context, topicID string) error
+	GetTopicByID(ctx context.Context, topicID string) (*entities.Topic, error)
+	GetTopicQuestions(ctx context.Context, topicID string) ([]entities.Question, error)
+	NextQuestionID(ctx context.Context) (string, error)
+	StoreQuestion(ctx context.Context, question entities.Question) error
+	UpdateQuestion(ctx context.Context, question entities.Question) error
+	DeleteQuestion(ctx context.Context, questionID string) error
+	GetQuestionByID(ctx context.Context, questionID string) (entities.Question, error)
+	// GetSessionsToRescore returns up to limit completed sessions graded with versions of the
+	// question older than the given one, ordered by id after afterSessionID.
+	GetSessionsToRescore(ctx context.Context, questionID string, version int,
+		afterSessionID string, limit int) ([]string, error)
+	GetSessionBySessionID(ctx context.Context, sessionID string) (*entities.Session, error)
+	// UpdateSessionResult saves result of the rescored session and versions of its questions.
+	UpdateSessionResult(ctx context.Context, session *entities.Session) error
+	// StoreRescoreTask queues rescoring of the question with the version, the unfinished task of
+	// the same version is kept.
+	StoreRescoreTask(ctx context.Context, questionID string, version int) (
+		*entities.RescoreTask, error)
+	GetRescoreTask(ctx context.Context, questionID string) (*entities.RescoreTask, error)
+	// ClaimRescoreTask takes the pending task or the running one which progress was not saved
+	// since staleBefore. It returns entities.ErrNotFound when there are no such tasks.
+	ClaimRescoreTask(ctx context.Context, staleBefore time.Time) (*entities.RescoreTask, error)
+	// UpdateRescoreTask saves progress of the claimed task. It returns entities.ErrConflict when
+	// the task was claimed again or started over.
+	UpdateRescoreTask(ctx context.Context, task *entities.RescoreTask) error
+}
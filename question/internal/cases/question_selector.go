@@ -0,0 +1,15 @@
+package cases
+
+import (
+	"github.com/parta4ok/kvs/question/internal/entities"
+)
+
+// QuestionSelector composes one topic of a new session from the active questions of the topic.
+//
+//go:generate mockgen -source=./question_selector.go -destination=./testdata/question_selector.go -package=testdata
+type QuestionSelector interface {
+	// Select returns up to limit questions of the candidates. History describes previous
+	// sessions of the user on the topic.
+	Select(candidates []entities.Question, limit int,
+		history entities.TopicHistory) []entities.Question
+}
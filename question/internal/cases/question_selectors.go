@@ -0,0 +1,142 @@
+package cases
+
+import (
+	"math/rand/v2"
+	"sort"
+
+	"github.com/parta4ok/kvs/question/internal/entities"
+)
+
+const (
+	// StrongHistoryScore is the average score from which the topic history counts as strong.
+	StrongHistoryScore = 80.0
+	// WeakHistoryScore is the average score below which the topic history counts as weak.
+	WeakHistoryScore = 50.0
+)
+
+var (
+	_ QuestionSelector = (*UniformSelector)(nil)
+	_ QuestionSelector = (*MixedSelector)(nil)
+	_ QuestionSelector = (*AdaptiveSelector)(nil)
+)
+
+// UniformSelector picks random questions regardless of their difficulty.
+type UniformSelector struct{}
+
+func NewUniformSelector() *UniformSelector {
+	return &UniformSelector{}
+}
+
+func (s *UniformSelector) Select(candidates []entities.Question, limit int,
+	_ entities.TopicHistory) []entities.Question {
+	questions := shuffled(candidates)
+	if len(questions) > limit {
+		questions = questions[:limit]
+	}
+
+	return questions
+}
+
+// MixedSelector keeps the difficulty mix. When there are not enough questions of a difficulty,
+// the session is completed with random questions of the other difficulties.
+type MixedSelector struct {
+	mix entities.DifficultyMix
+}
+
+func NewMixedSelector(mix entities.DifficultyMix) *MixedSelector {
+	return &MixedSelector{mix: mix}
+}
+
+func (s *MixedSelector) Select(candidates []entities.Question, limit int,
+	_ entities.TopicHistory) []entities.Question {
+	return selectByMix(candidates, limit, s.mix)
+}
+
+// AdaptiveSelector picks harder questions for users who scored at least StrongHistoryScore on
+// the topic on average and easier ones for users who scored below WeakHistoryScore. Users
+// without history get the base mix.
+type AdaptiveSelector struct {
+	base entities.DifficultyMix
+}
+
+func NewAdaptiveSelector(base entities.DifficultyMix) *AdaptiveSelector {
+	return &AdaptiveSelector{base: base}
+}
+
+func (s *AdaptiveSelector) Select(candidates []entities.Question, limit int,
+	history entities.TopicHistory) []entities.Question {
+	return selectByMix(candidates, limit, s.mixFor(history))
+}
+
+func (s *AdaptiveSelector) mixFor(history entities.TopicHistory) entities.DifficultyMix {
+	switch {
+	case history.Sessions == 0:
+		return s.base
+	case history.AverageScore >= StrongHistoryScore:
+		return entities.DifficultyMix{Easy: 10, Medium: 40, Hard: 50}
+	case history.AverageScore < WeakHistoryScore:
+		return entities.DifficultyMix{Easy: 50, Medium: 40, Hard: 10}
+	}
+
+	return s.base
+}
+
+func selectByMix(candidates []entities.Question, limit int,
+	mix entities.DifficultyMix) []entities.Question {
+	byDifficulty := make(map[entities.Difficulty][]entities.Question)
+	for _, question := range shuffled(candidates) {
+		difficulty := entities.DifficultyOf(question)
+		byDifficulty[difficulty] = append(byDifficulty[difficulty], question)
+	}
+
+	selected := make([]entities.Question, 0, limit)
+	rest := make([]entities.Question, 0)
+	for difficulty, quota := range mixQuotas(limit, mix) {
+		questions := byDifficulty[difficulty]
+		taken := min(quota, len(questions))
+		selected = append(selected, questions[:taken]...)
+		rest = append(rest, questions[taken:]...)
+	}
+
+	rest = shuffled(rest)
+	for len(selected) < limit && len(rest) > 0 {
+		selected = append(selected, rest[0])
+		rest = rest[1:]
+	}
+
+	return selected
+}
+
+// mixQuotas splits limit between difficulties by the largest remainder method, so quotas
+// always sum up to limit.
+func mixQuotas(limit int, mix entities.DifficultyMix) map[entities.Difficulty]int {
+	difficulties := entities.Difficulties()
+	quotas := make(map[entities.Difficulty]int, len(difficulties))
+
+	distributed := 0
+	for _, difficulty := range difficulties {
+		quotas[difficulty] = limit * mix.Share(difficulty) / 100
+		distributed += quotas[difficulty]
+	}
+
+	sort.SliceStable(difficulties, func(i, j int) bool {
+		return limit*mix.Share(difficulties[i])%100 > limit*mix.Share(difficulties[j])%100
+	})
+
+	for i := 0; distributed < limit; i++ {
+		quotas[difficulties[i%len(difficulties)]]++
+		distributed++
+	}
+
+	return quotas
+}
+
+func shuffled(questions []entities.Question) []entities.Question {
+	result := make([]entities.Question, len(questions))
+	copy(result, questions)
+	rand.Shuffle(len(result), func(i, j int) {
+		result[i], result[j] = result[j], result[i]
+	})
+
+	return result
+}
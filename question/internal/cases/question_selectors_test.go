@@ -0,0 +1,217 @@
+package cases_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/parta4ok/kvs/question/internal/cases"
+	"github.com/parta4ok/kvs/question/internal/entities"
+)
+
+func makeRatedQuestions(t *testing.T, counts map[entities.Difficulty]int) []entities.Question {
+	t.Helper()
+
+	factory := &entities.QuestionFactory{}
+	questions := make([]entities.Question, 0)
+	for _, difficulty := range entities.Difficulties() {
+		for i := 0; i < counts[difficulty]; i++ {
+			question, err := factory.NewQuestion(fmt.Sprintf("%s-%d", difficulty, i),
+				entities.TrueOrFalse, "Go", "subject", nil, []string{"true"},
+				entities.WithDifficulty(difficulty))
+			require.NoError(t, err)
+			questions = append(questions, question)
+		}
+	}
+
+	return questions
+}
+
+func countByDifficulty(questions []entities.Question) map[entities.Difficulty]int {
+	counts := make(map[entities.Difficulty]int)
+	for _, question := range questions {
+		counts[entities.DifficultyOf(question)]++
+	}
+
+	return counts
+}
+
+func TestUniformSelector_Select(t *testing.T) {
+	t.Parallel()
+
+	candidates := makeRatedQuestions(t, map[entities.Difficulty]int{entities.DifficultyMedium: 5})
+	selector := cases.NewUniformSelector()
+
+	require.Len(t, selector.Select(candidates, 3, entities.TopicHistory{}), 3)
+	require.Len(t, selector.Select(candidates, 10, entities.TopicHistory{}), 5)
+	require.Empty(t, selector.Select(nil, 10, entities.TopicHistory{}))
+}
+
+func TestMixedSelector_Select(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name       string
+		candidates map[entities.Difficulty]int
+		limit      int
+		expected   map[entities.Difficulty]int
+	}{
+		{
+			name: "exact_mix",
+			candidates: map[entities.Difficulty]int{
+				entities.DifficultyEasy:   10,
+				entities.DifficultyMedium: 10,
+				entities.DifficultyHard:   10,
+			},
+			limit: 10,
+			expected: map[entities.Difficulty]int{
+				entities.DifficultyEasy:   3,
+				entities.DifficultyMedium: 5,
+				entities.DifficultyHard:   2,
+			},
+		},
+		{
+			name: "rounded_mix",
+			candidates: map[entities.Difficulty]int{
+				entities.DifficultyEasy:   10,
+				entities.DifficultyMedium: 10,
+				entities.DifficultyHard:   10,
+			},
+			// 0.9 easy, 1.5 medium and 0.6 hard questions are rounded by the largest remainder
+			limit: 3,
+			expected: map[entities.Difficulty]int{
+				entities.DifficultyEasy:   1,
+				entities.DifficultyMedium: 1,
+				entities.DifficultyHard:   1,
+			},
+		},
+		{
+			name: "not_enough_hard_questions",
+			candidates: map[entities.Difficulty]int{
+				entities.DifficultyEasy:   10,
+				entities.DifficultyMedium: 5,
+			},
+			limit: 10,
+			expected: map[entities.Difficulty]int{
+				entities.DifficultyEasy:   5,
+				entities.DifficultyMedium: 5,
+			},
+		},
+		{
+			name: "not_enough_questions",
+			candidates: map[entities.Difficulty]int{
+				entities.DifficultyEasy: 2,
+				entities.DifficultyHard: 1,
+			},
+			limit: 10,
+			expected: map[entities.Difficulty]int{
+				entities.DifficultyEasy: 2,
+				entities.DifficultyHard: 1,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			selector := cases.NewMixedSelector(entities.DefaultDifficultyMix())
+			selected := selector.Select(makeRatedQuestions(t, tc.candidates), tc.limit,
+				entities.TopicHistory{})
+			require.Equal(t, tc.expected, countByDifficulty(selected))
+		})
+	}
+}
+
+func TestMixedSelector_Select_QuestionTypes(t *testing.T) {
+	t.Parallel()
+
+	factory := &entities.QuestionFactory{}
+	candidates := makeRatedQuestions(t, map[entities.Difficulty]int{
+		entities.DifficultyEasy:   10,
+		entities.DifficultyMedium: 10,
+	})
+	for i := 0; i < 5; i++ {
+		matching, err := factory.NewQuestion(fmt.Sprintf("matching-%d", i), entities.Matching,
+			"Go", "subject", []string{"0", "nil"}, []string{"int => 0", "*int => nil"},
+			entities.WithDifficulty(entities.DifficultyHard))
+		require.NoError(t, err)
+		numeric, err := factory.NewQuestion(fmt.Sprintf("numeric-%d", i), entities.Numeric,
+			"Go", "subject", nil, []string{"4"}, entities.WithDifficulty(entities.DifficultyHard))
+		require.NoError(t, err)
+		candidates = append(candidates, matching, numeric)
+	}
+
+	selector := cases.NewMixedSelector(entities.DefaultDifficultyMix())
+	selected := selector.Select(candidates, 10, entities.TopicHistory{})
+	require.Equal(t, map[entities.Difficulty]int{
+		entities.DifficultyEasy:   3,
+		entities.DifficultyMedium: 5,
+		entities.DifficultyHard:   2,
+	}, countByDifficulty(selected))
+}
+
+func TestAdaptiveSelector_Select(t *testing.T) {
+	t.Parallel()
+
+	candidates := makeRatedQuestions(t, map[entities.Difficulty]int{
+		entities.DifficultyEasy:   10,
+		entities.DifficultyMedium: 10,
+		entities.DifficultyHard:   10,
+	})
+
+	testCases := []struct {
+		name     string
+		history  entities.TopicHistory
+		expected map[entities.Difficulty]int
+	}{
+		{
+			name: "no_history",
+			expected: map[entities.Difficulty]int{
+				entities.DifficultyEasy:   3,
+				entities.DifficultyMedium: 5,
+				entities.DifficultyHard:   2,
+			},
+		},
+		{
+			name:    "strong_history",
+			history: entities.TopicHistory{Sessions: 3, AverageScore: 90},
+			expected: map[entities.Difficulty]int{
+				entities.DifficultyEasy:   1,
+				entities.DifficultyMedium: 4,
+				entities.DifficultyHard:   5,
+			},
+		},
+		{
+			name:    "weak_history",
+			history: entities.TopicHistory{Sessions: 2, AverageScore: 30},
+			expected: map[entities.Difficulty]int{
+				entities.DifficultyEasy:   5,
+				entities.DifficultyMedium: 4,
+				entities.DifficultyHard:   1,
+			},
+		},
+		{
+			name:    "average_history",
+			history: entities.TopicHistory{Sessions: 2, AverageScore: 65},
+			expected: map[entities.Difficulty]int{
+				entities.DifficultyEasy:   3,
+				entities.DifficultyMedium: 5,
+				entities.DifficultyHard:   2,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			selector := cases.NewAdaptiveSelector(entities.DefaultDifficultyMix())
+			selected := selector.Select(candidates, 10, tc.history)
+			require.Equal(t, tc.expected, countByDifficulty(selected))
+		})
+	}
+}
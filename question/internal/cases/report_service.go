@@ -0,0 +1,12 @@
+package cases
+
+import (
+	"context"
+
+	"github.com/parta4ok/kvs/question/internal/entities"
+)
+
+//go:generate mockgen -source=./report_service.go -destination=./testdata/report_service.go -package=testdata
+type ReportService interface {
+	GetKnowledgeReport(ctx context.Context, userID string) (*entities.KnowledgeReport, error)
+}
@@ -0,0 +1,113 @@
+package cases
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/parta4ok/kvs/question/internal/entities"
+)
+
+const (
+	defaultFailedQuestionsLimit = 10
+	defaultTrendDepth           = 10
+)
+
+var (
+	_ ReportService = (*ReportServiceBase)(nil)
+)
+
+// ReportServiceBase builds knowledge reports of students from aggregates of their completed
+// sessions computed by the storage.
+type ReportServiceBase struct {
+	storage              ReportStorage
+	failedQuestionsLimit int
+	trendDepth           int
+}
+
+func NewReportServiceBase(storage ReportStorage, opts ...ReportServiceOption) (
+	*ReportServiceBase, error) {
+	if storage == nil {
+		return nil, errors.Wrap(entities.ErrInvalidParam, "report storage not set")
+	}
+
+	service := &ReportServiceBase{
+		storage:              storage,
+		failedQuestionsLimit: defaultFailedQuestionsLimit,
+		trendDepth:           defaultTrendDepth,
+	}
+
+	for _, opt := range opts {
+		opt(service)
+	}
+
+	return service, nil
+}
+
+type ReportServiceOption func(*ReportServiceBase)
+
+// WithFailedQuestionsLimit sets how many of the most frequently failed questions the report
+// lists.
+func WithFailedQuestionsLimit(limit int) ReportServiceOption {
+	return func(srv *ReportServiceBase) {
+		if limit > 0 {
+			srv.failedQuestionsLimit = limit
+		}
+	}
+}
+
+// WithTrendDepth sets how many recent exam sessions the score trend is determined by.
+func WithTrendDepth(depth int) ReportServiceOption {
+	return func(srv *ReportServiceBase) {
+		if depth >= entities.TrendMinSessions {
+			srv.trendDepth = depth
+		}
+	}
+}
+
+func (srv *ReportServiceBase) GetKnowledgeReport(ctx context.Context, userID string) (
+	*entities.KnowledgeReport, error) {
+	slog.Info("GetKnowledgeReport started")
+
+	if userID == "" {
+		err := errors.Wrap(entities.ErrInvalidParam, "userID not set")
+		slog.Error(err.Error())
+		return nil, err
+	}
+
+	stats, err := srv.storage.GetSessionStats(ctx, userID)
+	if err != nil {
+		slog.Error(err.Error())
+		return nil, errors.Wrap(err, "GetSessionStats")
+	}
+
+	topics, err := srv.storage.GetTopicAccuracy(ctx, userID)
+	if err != nil {
+		slog.Error(err.Error())
+		return nil, errors.Wrap(err, "GetTopicAccuracy")
+	}
+
+	failed, err := srv.storage.GetFailedQuestions(ctx, userID, srv.failedQuestionsLimit)
+	if err != nil {
+		slog.Error(err.Error())
+		return nil, errors.Wrap(err, "GetFailedQuestions")
+	}
+
+	trend, err := srv.storage.GetScoreTrend(ctx, userID, srv.trendDepth)
+	if err != nil {
+		slog.Error(err.Error())
+		return nil, errors.Wrap(err, "GetScoreTrend")
+	}
+
+	slog.Info("GetKnowledgeReport completed")
+	return &entities.KnowledgeReport{
+		UserID:          userID,
+		GeneratedAt:     time.Now().UTC(),
+		Sessions:        stats,
+		Topics:          topics,
+		FailedQuestions: failed,
+		Trend:           trend,
+	}, nil
+}
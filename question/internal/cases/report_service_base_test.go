@@ -0,0 +1,82 @@
+package cases_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/parta4ok/kvs/question/internal/cases"
+	"github.com/parta4ok/kvs/question/internal/cases/testdata"
+	"github.com/parta4ok/kvs/question/internal/entities"
+)
+
+func TestNewReportServiceBase(t *testing.T) {
+	t.Parallel()
+
+	_, err := cases.NewReportServiceBase(nil)
+	require.ErrorIs(t, err, entities.ErrInvalidParam)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	service, err := cases.NewReportServiceBase(testdata.NewMockReportStorage(ctrl))
+	require.NoError(t, err)
+	require.NotNil(t, service)
+}
+
+func TestReportServiceBase_GetKnowledgeReport(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	stats := entities.SessionStats{Total: 3, Exams: 3, Passed: 1, Failed: 1, Expired: 1,
+		AverageDuration: 5 * time.Minute}
+	topics := []entities.TopicAccuracy{{Topic: "Go", Correct: 3, Total: 4}}
+	failed := []entities.FailedQuestion{{QuestionID: "1", Topic: "Go", Failures: 2, Answers: 3}}
+	trend := entities.ScoreTrend{Sessions: 3, Slope: 5}
+
+	storage := testdata.NewMockReportStorage(ctrl)
+	storage.EXPECT().GetSessionStats(gomock.Any(), "1").Return(stats, nil)
+	storage.EXPECT().GetTopicAccuracy(gomock.Any(), "1").Return(topics, nil)
+	storage.EXPECT().GetFailedQuestions(gomock.Any(), "1", 5).Return(failed, nil)
+	storage.EXPECT().GetScoreTrend(gomock.Any(), "1", 20).Return(trend, nil)
+
+	service, err := cases.NewReportServiceBase(storage, cases.WithFailedQuestionsLimit(5),
+		cases.WithTrendDepth(20))
+	require.NoError(t, err)
+
+	report, err := service.GetKnowledgeReport(context.Background(), "1")
+	require.NoError(t, err)
+	require.Equal(t, "1", report.UserID)
+	require.Equal(t, stats, report.Sessions)
+	require.Equal(t, topics, report.Topics)
+	require.Equal(t, failed, report.FailedQuestions)
+	require.Equal(t, entities.TrendImproving, report.Trend.Direction())
+	require.False(t, report.GeneratedAt.IsZero())
+}
+
+func TestReportServiceBase_GetKnowledgeReport_Errors(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	storage := testdata.NewMockReportStorage(ctrl)
+	storage.EXPECT().GetSessionStats(gomock.Any(), "1").Return(entities.SessionStats{}, nil)
+	storage.EXPECT().GetTopicAccuracy(gomock.Any(), "1").Return(nil,
+		errors.New("connection refused"))
+
+	service, err := cases.NewReportServiceBase(storage)
+	require.NoError(t, err)
+
+	_, err = service.GetKnowledgeReport(context.Background(), "")
+	require.ErrorIs(t, err, entities.ErrInvalidParam)
+
+	_, err = service.GetKnowledgeReport(context.Background(), "1")
+	require.ErrorContains(t, err, "GetTopicAccuracy")
+}
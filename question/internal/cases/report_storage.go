@@ -0,0 +1,19 @@
+package cases
+
+import (
+	"context"
+
+	"github.com/parta4ok/kvs/question/internal/entities"
+)
+
+//go:generate mockgen -source=report_storage.go -destination=./testdata/report_storage.go -package=testdata
+type ReportStorage interface {
+	GetSessionStats(ctx context.Context, userID string) (entities.SessionStats, error)
+	// GetTopicAccuracy returns answers of the user by topic and week of the session completion.
+	GetTopicAccuracy(ctx context.Context, userID string) ([]entities.TopicAccuracy, error)
+	// GetFailedQuestions returns questions the user answered wrong most often.
+	GetFailedQuestions(ctx context.Context, userID string, limit int) (
+		[]entities.FailedQuestion, error)
+	// GetScoreTrend returns the trend of scores of the recent exam sessions of the user.
+	GetScoreTrend(ctx context.Context, userID string, depth int) (entities.ScoreTrend, error)
+}
@@ -0,0 +1,219 @@
+package cases
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/parta4ok/kvs/question/internal/entities"
+)
+
+const (
+	defaultRescoreInterval    = 10 * time.Second
+	defaultRescoreBatchSize   = 100
+	defaultRescoreStaleAfter  = 5 * time.Minute
+	defaultRescoreMaxAttempts = 3
+)
+
+// RescoreJob grades completed sessions again with the current version of the question by the
+// queued rescore tasks. Sessions are processed in batches and progress is saved after every
+// batch, so the task interrupted by an error or a restart continues from the last processed
+// session. Several replicas may run the job at the same time, a task is processed by one of
+// them.
+type RescoreJob struct {
+	storage     QuestionBankStorage
+	interval    time.Duration
+	batchSize   int
+	staleAfter  time.Duration
+	maxAttempts int
+}
+
+func NewRescoreJob(storage QuestionBankStorage, opts ...RescoreJobOption) (*RescoreJob, error) {
+	if storage == nil {
+		return nil, errors.Wrap(entities.ErrInvalidParam, "question bank storage not set")
+	}
+
+	job := &RescoreJob{
+		storage:     storage,
+		interval:    defaultRescoreInterval,
+		batchSize:   defaultRescoreBatchSize,
+		staleAfter:  defaultRescoreStaleAfter,
+		maxAttempts: defaultRescoreMaxAttempts,
+	}
+
+	for _, opt := range opts {
+		opt(job)
+	}
+
+	return job, nil
+}
+
+type RescoreJobOption func(*RescoreJob)
+
+func WithRescoreInterval(interval time.Duration) RescoreJobOption {
+	return func(job *RescoreJob) {
+		if interval > 0 {
+			job.interval = interval
+		}
+	}
+}
+
+func WithRescoreBatchSize(batchSize int) RescoreJobOption {
+	return func(job *RescoreJob) {
+		if batchSize > 0 {
+			job.batchSize = batchSize
+		}
+	}
+}
+
+// WithRescoreStaleAfter sets how long a running task may go without saved progress before
+// another job takes it.
+func WithRescoreStaleAfter(staleAfter time.Duration) RescoreJobOption {
+	return func(job *RescoreJob) {
+		if staleAfter > 0 {
+			job.staleAfter = staleAfter
+		}
+	}
+}
+
+// WithRescoreMaxAttempts sets how many times a task is taken before it fails.
+func WithRescoreMaxAttempts(maxAttempts int) RescoreJobOption {
+	return func(job *RescoreJob) {
+		if maxAttempts > 0 {
+			job.maxAttempts = maxAttempts
+		}
+	}
+}
+
+// Run processes queued tasks every interval until ctx is done.
+func (job *RescoreJob) Run(ctx context.Context) {
+	slog.Info("RescoreJob started", "interval", job.interval.String())
+
+	ticker := time.NewTicker(job.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			slog.Info("RescoreJob completed")
+			return
+		case <-ticker.C:
+			if _, err := job.Rescore(ctx); err != nil {
+				slog.Warn("Failed to rescore sessions", "error", err)
+			}
+		}
+	}
+}
+
+// Rescore processes queued tasks one by one until there are none left or ctx is done and
+// returns the number of processed tasks.
+func (job *RescoreJob) Rescore(ctx context.Context) (int, error) {
+	slog.Info("Rescore started")
+
+	var processed int
+	for ctx.Err() == nil {
+		task, err := job.storage.ClaimRescoreTask(ctx, time.Now().UTC().Add(-job.staleAfter))
+		if errors.Is(err, entities.ErrNotFound) {
+			break
+		}
+		if err != nil {
+			slog.Error(err.Error())
+			return processed, errors.Wrap(err, "ClaimRescoreTask")
+		}
+
+		if err := job.process(ctx, task); err != nil {
+			slog.Warn("Failed to process rescore task", "question_id", task.QuestionID,
+				"error", err)
+		}
+
+		processed++
+	}
+
+	slog.Info("Rescore completed", "processed", processed)
+	return processed, nil
+}
+
+// process rescores sessions of the task batch by batch. A failed session is counted and
+// skipped, a failed batch returns the task to the queue.
+func (job *RescoreJob) process(ctx context.Context, task *entities.RescoreTask) error {
+	question, err := job.storage.GetQuestionByID(ctx, task.QuestionID)
+	if err != nil {
+		return job.interrupt(ctx, task, errors.Wrap(err, "GetQuestionByID"))
+	}
+
+	if version := entities.VersionOf(question); version != task.Version {
+		task.Status = entities.RescoreFailed
+		task.Error = "question was edited after rescoring was requested"
+		return job.save(ctx, task)
+	}
+
+	for ctx.Err() == nil {
+		sessionIDs, err := job.storage.GetSessionsToRescore(ctx, task.QuestionID, task.Version,
+			task.LastSessionID, job.batchSize)
+		if err != nil {
+			return job.interrupt(ctx, task, errors.Wrap(err, "GetSessionsToRescore"))
+		}
+
+		for _, sessionID := range sessionIDs {
+			if err := job.rescoreSession(ctx, sessionID, question); err != nil {
+				slog.Warn("Failed to rescore session", "session_id", sessionID, "error", err)
+				task.Failed++
+			} else {
+				task.Rescored++
+			}
+			task.LastSessionID = sessionID
+		}
+
+		if len(sessionIDs) < job.batchSize {
+			task.Finish()
+		}
+
+		if err := job.save(ctx, task); err != nil {
+			return err
+		}
+
+		if task.IsFinished() {
+			return nil
+		}
+	}
+
+	return ctx.Err()
+}
+
+func (job *RescoreJob) rescoreSession(ctx context.Context, sessionID string,
+	question entities.Question) error {
+	session, err := job.storage.GetSessionBySessionID(ctx, sessionID)
+	if err != nil {
+		return errors.Wrap(err, "GetSessionBySessionID")
+	}
+
+	if err := session.Rescore(question); err != nil {
+		return errors.Wrapf(err, "Rescore session %s", sessionID)
+	}
+
+	if err := job.storage.UpdateSessionResult(ctx, session); err != nil {
+		return errors.Wrap(err, "UpdateSessionResult")
+	}
+
+	return nil
+}
+
+func (job *RescoreJob) interrupt(ctx context.Context, task *entities.RescoreTask,
+	cause error) error {
+	task.Interrupt(cause, job.maxAttempts)
+	if err := job.save(ctx, task); err != nil {
+		return err
+	}
+
+	return cause
+}
+
+func (job *RescoreJob) save(ctx context.Context, task *entities.RescoreTask) error {
+	if err := job.storage.UpdateRescoreTask(ctx, task); err != nil {
+		return errors.Wrap(err, "UpdateRescoreTask")
+	}
+
+	return nil
+}
@@ -0,0 +1,201 @@
+package cases_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/parta4ok/kvs/question/internal/cases"
+	"github.com/parta4ok/kvs/question/internal/cases/testdata"
+	"github.com/parta4ok/kvs/question/internal/entities"
+)
+
+func TestNewRescoreJob_ValidationErrors(t *testing.T) {
+	t.Parallel()
+
+	_, err := cases.NewRescoreJob(nil)
+	require.ErrorIs(t, err, entities.ErrInvalidParam)
+}
+
+func rescoreFixtures(t *testing.T) (entities.Question, *entities.Session) {
+	t.Helper()
+
+	factory := &entities.QuestionFactory{}
+	fixed, err := factory.NewQuestion("7", entities.TrueOrFalse, "Базы данных",
+		"Индексы всегда ускоряют вставку", nil, []string{"false"}, entities.WithVersion(2))
+	require.NoError(t, err)
+	outdated := entities.NewTrueOrFalseSelectionQuestion("7", "Базы данных",
+		"Индексы всегда ускоряют вставку", true)
+
+	session := entities.NewSessionWithCustomState("10", "1", []string{"Базы данных"}, nil)
+	answer, err := entities.NewUserAnswer("7", []string{"false"})
+	require.NoError(t, err)
+	session.ChangeState(entities.NewCompletedSessionState(
+		map[string]entities.Question{"7": outdated}, session, []*entities.UserAnswer{answer},
+		time.Now(), false))
+
+	return fixed, session
+}
+
+func TestRescoreJob_Rescore(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	fixed, session := rescoreFixtures(t)
+	task := &entities.RescoreTask{QuestionID: "7", Version: 2, Status: entities.RescoreRunning,
+		Attempts: 1}
+
+	storage := testdata.NewMockQuestionBankStorage(ctrl)
+	gomock.InOrder(
+		storage.EXPECT().ClaimRescoreTask(gomock.Any(), gomock.Any()).Return(task, nil),
+		storage.EXPECT().GetQuestionByID(gomock.Any(), "7").Return(fixed, nil),
+		// the first batch is full, the failed session is counted and skipped
+		storage.EXPECT().GetSessionsToRescore(gomock.Any(), "7", 2, "", 2).Return(
+			[]string{"9", "10"}, nil),
+		storage.EXPECT().GetSessionBySessionID(gomock.Any(), "9").Return(nil,
+			entities.ErrInternal),
+		storage.EXPECT().GetSessionBySessionID(gomock.Any(), "10").Return(session, nil),
+		storage.EXPECT().UpdateSessionResult(gomock.Any(), session).DoAndReturn(
+			func(_ context.Context, session *entities.Session) error {
+				result, err := session.GetSessionResult()
+				require.NoError(t, err)
+				require.Equal(t, entities.OutcomePassed, result.Outcome)
+				return nil
+			}),
+		storage.EXPECT().UpdateRescoreTask(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, task *entities.RescoreTask) error {
+				require.Equal(t, entities.RescoreRunning, task.Status)
+				require.Equal(t, "10", task.LastSessionID)
+				return nil
+			}),
+		// the next batch continues after the last processed session
+		storage.EXPECT().GetSessionsToRescore(gomock.Any(), "7", 2, "10", 2).Return(
+			[]string{}, nil),
+		storage.EXPECT().UpdateRescoreTask(gomock.Any(), gomock.Any()).Return(nil),
+		storage.EXPECT().ClaimRescoreTask(gomock.Any(), gomock.Any()).Return(nil,
+			entities.ErrNotFound),
+	)
+
+	job, err := cases.NewRescoreJob(storage, cases.WithRescoreBatchSize(2))
+	require.NoError(t, err)
+
+	processed, err := job.Rescore(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, 1, processed)
+	require.Equal(t, entities.RescoreFailed, task.Status)
+	require.Equal(t, 1, task.Rescored)
+	require.Equal(t, 1, task.Failed)
+	require.NotEmpty(t, task.Error)
+}
+
+func TestRescoreJob_Rescore_Interruptions(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name        string
+		attempts    int
+		prepare     func(storage *testdata.MockQuestionBankStorage, question entities.Question)
+		wantStatus  entities.RescoreStatus
+		wantErrText string
+	}{
+		{
+			name:     "question_edited",
+			attempts: 1,
+			prepare: func(storage *testdata.MockQuestionBankStorage, _ entities.Question) {
+				outdated := entities.NewTrueOrFalseSelectionQuestion("7", "Базы данных",
+					"Индексы всегда ускоряют вставку", true)
+				storage.EXPECT().GetQuestionByID(gomock.Any(), "7").Return(outdated, nil)
+				storage.EXPECT().UpdateRescoreTask(gomock.Any(), gomock.Any()).Return(nil)
+			},
+			wantStatus:  entities.RescoreFailed,
+			wantErrText: "question was edited",
+		},
+		{
+			name:     "batch_error_retried",
+			attempts: 1,
+			prepare: func(storage *testdata.MockQuestionBankStorage, question entities.Question) {
+				storage.EXPECT().GetQuestionByID(gomock.Any(), "7").Return(question, nil)
+				storage.EXPECT().GetSessionsToRescore(gomock.Any(), "7", 2, "", 100).Return(nil,
+					entities.ErrInternal)
+				storage.EXPECT().UpdateRescoreTask(gomock.Any(), gomock.Any()).Return(nil)
+			},
+			wantStatus:  entities.RescorePending,
+			wantErrText: "GetSessionsToRescore",
+		},
+		{
+			name:     "batch_error_out_of_attempts",
+			attempts: 3,
+			prepare: func(storage *testdata.MockQuestionBankStorage, question entities.Question) {
+				storage.EXPECT().GetQuestionByID(gomock.Any(), "7").Return(question, nil)
+				storage.EXPECT().GetSessionsToRescore(gomock.Any(), "7", 2, "", 100).Return(nil,
+					entities.ErrInternal)
+				storage.EXPECT().UpdateRescoreTask(gomock.Any(), gomock.Any()).Return(nil)
+			},
+			wantStatus:  entities.RescoreFailed,
+			wantErrText: "GetSessionsToRescore",
+		},
+		{
+			name:     "taken_over",
+			attempts: 1,
+			prepare: func(storage *testdata.MockQuestionBankStorage, question entities.Question) {
+				storage.EXPECT().GetQuestionByID(gomock.Any(), "7").Return(question, nil)
+				storage.EXPECT().GetSessionsToRescore(gomock.Any(), "7", 2, "", 100).Return(
+					[]string{}, nil)
+				storage.EXPECT().UpdateRescoreTask(gomock.Any(), gomock.Any()).Return(
+					entities.ErrConflict)
+			},
+			wantStatus: entities.RescoreCompleted,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			fixed, _ := rescoreFixtures(t)
+			task := &entities.RescoreTask{QuestionID: "7", Version: 2,
+				Status: entities.RescoreRunning, Attempts: tc.attempts}
+
+			storage := testdata.NewMockQuestionBankStorage(ctrl)
+			storage.EXPECT().ClaimRescoreTask(gomock.Any(), gomock.Any()).Return(task, nil)
+			tc.prepare(storage, fixed)
+			storage.EXPECT().ClaimRescoreTask(gomock.Any(), gomock.Any()).Return(nil,
+				entities.ErrNotFound)
+
+			job, err := cases.NewRescoreJob(storage)
+			require.NoError(t, err)
+
+			processed, err := job.Rescore(context.Background())
+			require.NoError(t, err)
+			require.Equal(t, 1, processed)
+			require.Equal(t, tc.wantStatus, task.Status)
+			require.Contains(t, task.Error, tc.wantErrText)
+		})
+	}
+}
+
+func TestRescoreJob_Rescore_ClaimError(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	storage := testdata.NewMockQuestionBankStorage(ctrl)
+	storage.EXPECT().ClaimRescoreTask(gomock.Any(), gomock.Any()).Return(nil,
+		entities.ErrInternal)
+
+	job, err := cases.NewRescoreJob(storage)
+	require.NoError(t, err)
+
+	processed, err := job.Rescore(context.Background())
+	require.ErrorIs(t, err, entities.ErrInternal)
+	require.Zero(t, processed)
+}
@@ -2,6 +2,7 @@ package cases
 
 import (
 	"context"
+	"time"
 
 	"github.com/parta4ok/kvs/question/internal/entities"
 )
@@ -10,8 +11,28 @@ import (
 type SessionService interface {
 	CompleteSession(ctx context.Context, sessionID string, answers []*entities.UserAnswer) (
 		*entities.SessionResult, error)
-	CreateSession(ctx context.Context, userID string, topics []string) (
-		string, map[string]entities.Question, error)
-	GetAllCompletedUserSessions(ctx context.Context, userID string) ([]*entities.Session, error)
+	CreateSession(ctx context.Context, userID string, topics []string,
+		mode entities.SessionMode) (string, []entities.Question, error)
+	GetCompletedUserSessions(ctx context.Context, userID string, query entities.HistoryQuery) (
+		*entities.HistoryPage, error)
 	ShowTopics(ctx context.Context) ([]string, error)
+	GetSession(ctx context.Context, sessionID string) (*entities.Session, error)
+	SaveDraftAnswer(ctx context.Context, sessionID string, answer *entities.UserAnswer) error
+	ResumeSession(ctx context.Context, sessionID string) (*ResumedSession, error)
+	PauseSession(ctx context.Context, sessionID string) (*entities.Session, error)
+	UnpauseSession(ctx context.Context, sessionID string) (*entities.Session, error)
+	CheckAnswer(ctx context.Context, sessionID string, answer *entities.UserAnswer) (
+		*entities.AnswerFeedback, error)
+	GetSessionReview(ctx context.Context, sessionID string, reviewer entities.Reviewer) (
+		*entities.SessionReview, error)
+	CreateReviewSession(ctx context.Context, userID string, topics []string) (string,
+		[]entities.Question, error)
+	GetDueReviews(ctx context.Context, userID string) ([]entities.DueReviews, error)
+}
+
+// ResumedSession is the active session with answers saved before its completion.
+type ResumedSession struct {
+	Session   *entities.Session
+	Drafts    []*entities.UserAnswer
+	Remaining time.Duration
 }
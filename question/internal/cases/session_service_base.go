@@ -3,6 +3,7 @@ package cases
 import (
 	"context"
 	"log/slog"
+	"math/rand/v2"
 	"time"
 
 	"github.com/pkg/errors"
@@ -11,24 +12,32 @@ import (
 )
 
 const (
-	defaultTopicDuration = time.Minute * 10
+	defaultTopicDuration     = time.Minute * 10
+	defaultQuestionsPerTopic = 10
+	defaultReviewSessionSize = 20
 )
 
 type SessionServiceBase struct {
-	storage        Storage
-	sessionStorage entities.SessionStorage
-	generator      entities.IDGenerator
-	topicDuration  time.Duration
+	storage           Storage
+	attempts          AttemptService
+	generator         entities.IDGenerator
+	topicDuration     time.Duration
+	policy            entities.SessionPolicy
+	questionsPerTopic int
+	difficultyMix     entities.DifficultyMix
+	selectors         map[entities.SelectionStrategy]QuestionSelector
+	leitner           entities.LeitnerSchedule
+	reviewSessionSize int
 }
 
-func NewSessionServiceBase(storage Storage, sessionStorage entities.SessionStorage,
+func NewSessionServiceBase(storage Storage, attempts AttemptService,
 	generator entities.IDGenerator, opts ...SessionServiceOption) (*SessionServiceBase, error) {
 	if storage == nil {
 		return nil, errors.Wrapf(entities.ErrInvalidParam, "storage not set")
 	}
 
-	if sessionStorage == nil {
-		return nil, errors.Wrapf(entities.ErrInvalidParam, "session storage not set")
+	if attempts == nil {
+		return nil, errors.Wrapf(entities.ErrInvalidParam, "attempt service not set")
 	}
 
 	if generator == nil {
@@ -36,14 +45,39 @@ func NewSessionServiceBase(storage Storage, sessionStorage entities.SessionStora
 	}
 
 	service := &SessionServiceBase{
-		storage:        storage,
-		sessionStorage: sessionStorage,
-		generator:      generator,
-		topicDuration:  defaultTopicDuration,
+		storage:           storage,
+		attempts:          attempts,
+		generator:         generator,
+		topicDuration:     defaultTopicDuration,
+		policy:            entities.DefaultSessionPolicy(),
+		questionsPerTopic: defaultQuestionsPerTopic,
+		difficultyMix:     entities.DefaultDifficultyMix(),
+		selectors:         make(map[entities.SelectionStrategy]QuestionSelector),
+		leitner:           entities.DefaultLeitnerSchedule(),
+		reviewSessionSize: defaultReviewSessionSize,
 	}
 
 	service.setOptions(opts...)
 
+	if err := service.difficultyMix.Validate(); err != nil {
+		return nil, errors.Wrap(err, "Validate")
+	}
+
+	if err := service.leitner.Validate(); err != nil {
+		return nil, errors.Wrap(err, "Validate")
+	}
+
+	defaultSelectors := map[entities.SelectionStrategy]QuestionSelector{
+		entities.UniformSelection:  NewUniformSelector(),
+		entities.MixedSelection:    NewMixedSelector(service.difficultyMix),
+		entities.AdaptiveSelection: NewAdaptiveSelector(service.difficultyMix),
+	}
+	for strategy, selector := range defaultSelectors {
+		if _, ok := service.selectors[strategy]; !ok {
+			service.selectors[strategy] = selector
+		}
+	}
+
 	return service, nil
 }
 
@@ -55,6 +89,88 @@ func WithCustomSessionDuration(dur time.Duration) SessionServiceOption {
 	}
 }
 
+// WithScoringStrategy sets how answers of new sessions are scored. Already created sessions
+// keep the strategy they were created with.
+func WithScoringStrategy(strategy entities.ScoringStrategy) SessionServiceOption {
+	return func(srv *SessionServiceBase) {
+		if strategy != nil {
+			srv.policy.Scoring = strategy
+		}
+	}
+}
+
+// WithPassThresholdResolution sets how thresholds of several topics are combined into the
+// session threshold.
+func WithPassThresholdResolution(resolution string) SessionServiceOption {
+	return func(srv *SessionServiceBase) {
+		if resolution != "" {
+			srv.policy.PassThreshold.Resolution = resolution
+		}
+	}
+}
+
+// WithReviewVisibility sets when students see reviews of new sessions. Already created
+// sessions keep the visibility they were created with.
+func WithReviewVisibility(visibility entities.ReviewVisibility) SessionServiceOption {
+	return func(srv *SessionServiceBase) {
+		if visibility != "" {
+			srv.policy.Review.Visibility = visibility
+		}
+	}
+}
+
+// WithShuffle sets whether new sessions show variants and questions in a random order. Every
+// session gets its own seed, so its order stays the same.
+func WithShuffle(variants bool, questions bool) SessionServiceOption {
+	return func(srv *SessionServiceBase) {
+		srv.policy.Shuffle.Variants = variants
+		srv.policy.Shuffle.Questions = questions
+	}
+}
+
+// WithQuestionsPerTopic sets how many questions of every topic new sessions consist of.
+func WithQuestionsPerTopic(count int) SessionServiceOption {
+	return func(srv *SessionServiceBase) {
+		if count > 0 {
+			srv.questionsPerTopic = count
+		}
+	}
+}
+
+// WithDifficultyMix sets the difficulty mix of the mixed selection strategy. The adaptive
+// strategy uses it for users without a strong or weak history of the topic.
+func WithDifficultyMix(mix entities.DifficultyMix) SessionServiceOption {
+	return func(srv *SessionServiceBase) {
+		srv.difficultyMix = mix
+	}
+}
+
+// WithQuestionSelector replaces the selector of the strategy.
+func WithQuestionSelector(strategy entities.SelectionStrategy,
+	selector QuestionSelector) SessionServiceOption {
+	return func(srv *SessionServiceBase) {
+		if selector != nil {
+			srv.selectors[strategy] = selector
+		}
+	}
+}
+
+// WithLeitnerSchedule sets repetition intervals of the Leitner boxes of review sessions.
+func WithLeitnerSchedule(schedule entities.LeitnerSchedule) SessionServiceOption {
+	return func(srv *SessionServiceBase) {
+		srv.leitner = schedule
+	}
+}
+
+// WithReviewSessionSize sets the maximum number of questions of review sessions.
+func WithReviewSessionSize(size int) SessionServiceOption {
+	return func(srv *SessionServiceBase) {
+		if size > 0 {
+			srv.reviewSessionSize = size
+		}
+	}
+}
+
 func (srv *SessionServiceBase) setOptions(opts ...SessionServiceOption) {
 	for _, opt := range opts {
 		opt(srv)
@@ -75,48 +191,193 @@ func (srv *SessionServiceBase) ShowTopics(ctx context.Context) ([]string, error)
 }
 
 func (srv *SessionServiceBase) CreateSession(ctx context.Context, userID string,
-	topics []string) (string, map[string]entities.Question, error) {
+	topics []string, mode entities.SessionMode) (string, []entities.Question, error) {
 	slog.Info("CreateSession started")
 
-	session, err := entities.NewSession(userID, topics, srv.generator, srv.sessionStorage)
-	if err != nil {
+	if mode != entities.ExamMode && mode != entities.PracticeMode {
+		err := errors.Wrapf(entities.ErrInvalidParam, "unknown session mode: %s", mode)
 		slog.Error(err.Error())
-		return "", nil, errors.Wrap(err, "NewSession")
+		return "", nil, err
 	}
 
-	forbidded, err := session.IsDailySessionLimitReached(ctx, userID, topics)
+	session, err := srv.newSession(ctx, userID, topics, mode)
 	if err != nil {
 		slog.Error(err.Error())
-		return "", nil, errors.Wrap(err, "IsDailySessionLimitReached")
+		return "", nil, err
 	}
 
-	if forbidded {
-		return "", nil, errors.Wrap(entities.ErrForbidden, "creating new session for this user")
+	// practice sessions are not limited by the attempt policy
+	if mode == entities.ExamMode {
+		if err := srv.attempts.CheckAttempt(ctx, userID, topics); err != nil {
+			slog.Error(err.Error())
+			return "", nil, errors.Wrap(err, "CheckAttempt")
+		}
 	}
 
-	questions, err := srv.storage.GetQuesions(ctx, topics)
+	questions, err := srv.selectQuestions(ctx, userID, topics)
 	if err != nil {
 		slog.Error(err.Error())
-		return "", nil, errors.Wrap(err, "GetQuesions")
+		return "", nil, err
+	}
+
+	presented, err := srv.startSession(ctx, session, questions)
+	if err != nil {
+		slog.Error(err.Error())
+		return "", nil, err
+	}
+
+	slog.Info("CreateService completed")
+	return session.GetSesionID(), presented, nil
+}
+
+// newSession creates the session with pass thresholds and pause limits of its topics and the
+// seed of its order.
+func (srv *SessionServiceBase) newSession(ctx context.Context, userID string, topics []string,
+	mode entities.SessionMode) (*entities.Session, error) {
+	thresholds, err := srv.storage.GetPassThresholds(ctx, topics)
+	if err != nil {
+		return nil, errors.Wrap(err, "GetPassThresholds")
+	}
+
+	pauseLimits, err := srv.storage.GetPauseLimits(ctx, topics)
+	if err != nil {
+		return nil, errors.Wrap(err, "GetPauseLimits")
+	}
+
+	policy := srv.policy
+	policy.Mode = mode
+	policy.PassThreshold.Topics = thresholds
+	policy.Pause = entities.ResolvePausePolicy(topics, pauseLimits)
+	if policy.Shuffle.IsEnabled() {
+		policy.Shuffle.Seed = rand.Uint64() //nolint:gosec // ok, the order is not a secret
+	}
+
+	session, err := entities.NewSession(userID, topics, srv.generator,
+		entities.WithPolicy(policy))
+	if err != nil {
+		return nil, errors.Wrap(err, "NewSession")
 	}
 
+	return session, nil
+}
+
+// startSession activates the session with the questions and stores it. Questions are returned
+// as the session shows them.
+func (srv *SessionServiceBase) startSession(ctx context.Context, session *entities.Session,
+	questions []entities.Question) ([]entities.Question, error) {
 	questionsMap := make(map[string]entities.Question, len(questions))
 	for _, question := range questions {
 		questionsMap[question.ID()] = question
 	}
 
-	if err = session.SetQuestions(questionsMap, srv.topicDuration); err != nil {
-		slog.Error(err.Error())
-		return "", nil, errors.Wrap(err, "SetQuestions")
+	if err := session.SetQuestions(questionsMap, srv.topicDuration); err != nil {
+		return nil, errors.Wrap(err, "SetQuestions")
 	}
 
 	if err := srv.storage.StoreSession(ctx, session); err != nil {
-		slog.Error(err.Error())
-		return "", nil, errors.Wrap(err, "StoreSession")
+		return nil, errors.Wrap(err, "StoreSession")
 	}
 
-	slog.Info("CreateService completed")
-	return session.GetSesionID(), questionsMap, nil
+	presented, err := session.GetQuestions()
+	if err != nil {
+		return nil, errors.Wrap(err, "GetQuestions")
+	}
+
+	return presented, nil
+}
+
+// selectQuestions composes every topic of the new session by the selection strategy of the
+// topic.
+func (srv *SessionServiceBase) selectQuestions(ctx context.Context, userID string,
+	topics []string) ([]entities.Question, error) {
+	strategies, err := srv.storage.GetSelectionStrategies(ctx, topics)
+	if err != nil {
+		return nil, errors.Wrap(err, "GetSelectionStrategies")
+	}
+
+	candidates, err := srv.sampleCandidates(ctx, topics, strategies)
+	if err != nil {
+		return nil, err
+	}
+
+	// the history is loaded only when a topic depends on it
+	history := make(map[string]entities.TopicHistory)
+	for _, strategy := range strategies {
+		if strategy == entities.AdaptiveSelection {
+			history, err = srv.storage.GetTopicHistory(ctx, userID, topics)
+			if err != nil {
+				return nil, errors.Wrap(err, "GetTopicHistory")
+			}
+			break
+		}
+	}
+
+	candidatesByTopic := make(map[string][]entities.Question, len(topics))
+	for _, question := range candidates {
+		candidatesByTopic[question.Topic()] = append(candidatesByTopic[question.Topic()],
+			question)
+	}
+
+	questions := make([]entities.Question, 0, len(topics)*srv.questionsPerTopic)
+	for _, topic := range topics {
+		strategy, ok := strategies[topic]
+		if !ok {
+			strategy = entities.DefaultSelectionStrategy
+		}
+
+		selector, ok := srv.selectors[strategy]
+		if !ok {
+			return nil, errors.Wrapf(entities.ErrInternal,
+				"selector of strategy %s of topic %s not set", strategy, topic)
+		}
+
+		questions = append(questions, selector.Select(candidatesByTopic[topic],
+			srv.questionsPerTopic, history[topic])...)
+	}
+
+	return questions, nil
+}
+
+// sampleCandidates loads random candidates of the topics in the database: uniform topics get
+// exactly the questions of the session, topics keeping a difficulty mix get up to the session
+// size of questions of every difficulty.
+func (srv *SessionServiceBase) sampleCandidates(ctx context.Context, topics []string,
+	strategies map[string]entities.SelectionStrategy) ([]entities.Question, error) {
+	uniformTopics := make([]string, 0, len(topics))
+	mixedTopics := make([]string, 0, len(topics))
+	for _, topic := range topics {
+		strategy, ok := strategies[topic]
+		if !ok {
+			strategy = entities.DefaultSelectionStrategy
+		}
+
+		if strategy == entities.UniformSelection {
+			uniformTopics = append(uniformTopics, topic)
+			continue
+		}
+		mixedTopics = append(mixedTopics, topic)
+	}
+
+	candidates := make([]entities.Question, 0, len(topics)*srv.questionsPerTopic)
+
+	if len(uniformTopics) > 0 {
+		questions, err := srv.storage.GetQuesions(ctx, uniformTopics, srv.questionsPerTopic)
+		if err != nil {
+			return nil, errors.Wrap(err, "GetQuesions")
+		}
+		candidates = append(candidates, questions...)
+	}
+
+	if len(mixedTopics) > 0 {
+		questions, err := srv.storage.GetQuestionsByDifficulty(ctx, mixedTopics,
+			srv.questionsPerTopic)
+		if err != nil {
+			return nil, errors.Wrap(err, "GetQuestionsByDifficulty")
+		}
+		candidates = append(candidates, questions...)
+	}
+
+	return candidates, nil
 }
 
 func (srv *SessionServiceBase) CompleteSession(
@@ -131,7 +392,13 @@ func (srv *SessionServiceBase) CompleteSession(
 		return nil, errors.Wrap(err, "GetSessionBySessionID")
 	}
 
-	if err := session.SetUserAnswer(answers); err != nil {
+	drafts, err := srv.storage.GetDraftAnswers(ctx, sessionID)
+	if err != nil {
+		slog.Error(err.Error())
+		return nil, errors.Wrap(err, "GetDraftAnswers")
+	}
+
+	if err := session.SetUserAnswer(entities.MergeAnswers(drafts, answers)); err != nil {
 		slog.Error(err.Error())
 		return nil, errors.Wrap(err, "SetUserAnswer")
 	}
@@ -149,13 +416,173 @@ func (srv *SessionServiceBase) CompleteSession(
 
 	sessionResult.UserID = session.GetUserID()
 	sessionResult.Topics = session.GetTopics()
+	sessionResult.Mode = session.GetPolicy().Mode
 
 	return sessionResult, nil
 }
 
-func (srv *SessionServiceBase) GetAllCompletedUserSessions(ctx context.Context, userID string) (
-	[]*entities.Session, error) {
-	slog.Info("GetAllCompletedUserSessions started")
+func (srv *SessionServiceBase) GetSession(ctx context.Context, sessionID string) (
+	*entities.Session, error) {
+	slog.Info("GetSession started")
+
+	if sessionID == "" {
+		err := errors.Wrap(entities.ErrInvalidParam, "sessionID not set")
+		slog.Error(err.Error())
+		return nil, err
+	}
+
+	session, err := srv.storage.GetSessionBySessionID(ctx, sessionID)
+	if err != nil {
+		slog.Error(err.Error())
+		return nil, errors.Wrap(err, "GetSessionBySessionID")
+	}
+
+	slog.Info("GetSession completed")
+	return session, nil
+}
+
+func (srv *SessionServiceBase) SaveDraftAnswer(ctx context.Context, sessionID string,
+	answer *entities.UserAnswer) error {
+	slog.Info("SaveDraftAnswer started")
+
+	session, err := srv.storage.GetSessionBySessionID(ctx, sessionID)
+	if err != nil {
+		slog.Error(err.Error())
+		return errors.Wrap(err, "GetSessionBySessionID")
+	}
+
+	if err := session.CheckDraftAnswer(answer, time.Now().UTC()); err != nil {
+		slog.Error(err.Error())
+		return errors.Wrap(err, "CheckDraftAnswer")
+	}
+
+	if err := srv.storage.StoreDraftAnswer(ctx, sessionID, answer); err != nil {
+		slog.Error(err.Error())
+		return errors.Wrap(err, "StoreDraftAnswer")
+	}
+
+	slog.Info("SaveDraftAnswer completed")
+	return nil
+}
+
+func (srv *SessionServiceBase) ResumeSession(ctx context.Context, sessionID string) (
+	*ResumedSession, error) {
+	slog.Info("ResumeSession started")
+
+	session, err := srv.storage.GetSessionBySessionID(ctx, sessionID)
+	if err != nil {
+		slog.Error(err.Error())
+		return nil, errors.Wrap(err, "GetSessionBySessionID")
+	}
+
+	if session.GetStatus() != entities.ActiveState {
+		err := errors.Wrapf(entities.ErrInvalidState, "%s can not be resumed", session.GetStatus())
+		slog.Error(err.Error())
+		return nil, err
+	}
+
+	remaining, err := session.GetRemainingTime(time.Now().UTC())
+	if err != nil {
+		slog.Error(err.Error())
+		return nil, errors.Wrap(err, "GetRemainingTime")
+	}
+
+	if remaining == 0 && session.GetPolicy().IsTimed() {
+		err := errors.Wrap(entities.ErrInvalidState, "session expired")
+		slog.Error(err.Error())
+		return nil, err
+	}
+
+	drafts, err := srv.storage.GetDraftAnswers(ctx, sessionID)
+	if err != nil {
+		slog.Error(err.Error())
+		return nil, errors.Wrap(err, "GetDraftAnswers")
+	}
+
+	slog.Info("ResumeSession completed")
+	return &ResumedSession{
+		Session:   session,
+		Drafts:    drafts,
+		Remaining: remaining,
+	}, nil
+}
+
+// PauseSession stops the clock of the active session if its pause policy allows it.
+func (srv *SessionServiceBase) PauseSession(ctx context.Context, sessionID string) (
+	*entities.Session, error) {
+	slog.Info("PauseSession started")
+
+	session, err := srv.storage.GetSessionBySessionID(ctx, sessionID)
+	if err != nil {
+		slog.Error(err.Error())
+		return nil, errors.Wrap(err, "GetSessionBySessionID")
+	}
+
+	if err := session.Pause(time.Now().UTC()); err != nil {
+		slog.Error(err.Error())
+		return nil, errors.Wrap(err, "Pause")
+	}
+
+	if err := srv.storage.StoreSession(ctx, session); err != nil {
+		slog.Error(err.Error())
+		return nil, errors.Wrap(err, "StoreSession")
+	}
+
+	slog.Info("PauseSession completed")
+	return session, nil
+}
+
+// UnpauseSession starts the clock of the paused session again.
+func (srv *SessionServiceBase) UnpauseSession(ctx context.Context, sessionID string) (
+	*entities.Session, error) {
+	slog.Info("UnpauseSession started")
+
+	session, err := srv.storage.GetSessionBySessionID(ctx, sessionID)
+	if err != nil {
+		slog.Error(err.Error())
+		return nil, errors.Wrap(err, "GetSessionBySessionID")
+	}
+
+	if err := session.Resume(time.Now().UTC()); err != nil {
+		slog.Error(err.Error())
+		return nil, errors.Wrap(err, "Resume")
+	}
+
+	if err := srv.storage.StoreSession(ctx, session); err != nil {
+		slog.Error(err.Error())
+		return nil, errors.Wrap(err, "StoreSession")
+	}
+
+	slog.Info("UnpauseSession completed")
+	return session, nil
+}
+
+// CheckAnswer evaluates the answer of the practice session without completing the session.
+func (srv *SessionServiceBase) CheckAnswer(ctx context.Context, sessionID string,
+	answer *entities.UserAnswer) (*entities.AnswerFeedback, error) {
+	slog.Info("CheckAnswer started")
+
+	session, err := srv.storage.GetSessionBySessionID(ctx, sessionID)
+	if err != nil {
+		slog.Error(err.Error())
+		return nil, errors.Wrap(err, "GetSessionBySessionID")
+	}
+
+	feedback, err := session.CheckAnswer(answer)
+	if err != nil {
+		slog.Error(err.Error())
+		return nil, errors.Wrap(err, "CheckAnswer")
+	}
+
+	slog.Info("CheckAnswer completed")
+	return feedback, nil
+}
+
+// GetCompletedUserSessions returns the page of results of the completed sessions of the user as
+// they were graded at completion, with totals of all sessions matching the query.
+func (srv *SessionServiceBase) GetCompletedUserSessions(ctx context.Context, userID string,
+	query entities.HistoryQuery) (*entities.HistoryPage, error) {
+	slog.Info("GetCompletedUserSessions started")
 
 	if userID == "" {
 		err := errors.Wrap(entities.ErrInvalidParam, "userID not set")
@@ -163,13 +590,35 @@ func (srv *SessionServiceBase) GetAllCompletedUserSessions(ctx context.Context,
 		return nil, err
 	}
 
-	sessions, err := srv.storage.GetAllCompletedUserSessions(ctx, userID)
+	page, err := srv.storage.GetResultSnapshots(ctx, userID, query)
 	if err != nil {
-		err = errors.Wrap(err, "get all completed user sessions failure")
+		err = errors.Wrap(err, "get result snapshots failure")
 		slog.Error(err.Error())
 		return nil, err
 	}
 
-	slog.Info("GetAllCompletedUserSessions completed")
-	return sessions, nil
+	slog.Info("GetCompletedUserSessions completed")
+	return page, nil
+}
+
+// GetSessionReview returns answers of the completed session with the correct ones and
+// explanations, if the session review policy lets the reviewer see them.
+func (srv *SessionServiceBase) GetSessionReview(ctx context.Context, sessionID string,
+	reviewer entities.Reviewer) (*entities.SessionReview, error) {
+	slog.Info("GetSessionReview started")
+
+	session, err := srv.storage.GetSessionBySessionID(ctx, sessionID)
+	if err != nil {
+		slog.Error(err.Error())
+		return nil, errors.Wrap(err, "GetSessionBySessionID")
+	}
+
+	review, err := session.GetReview(reviewer, time.Now().UTC())
+	if err != nil {
+		slog.Error(err.Error())
+		return nil, errors.Wrap(err, "GetReview")
+	}
+
+	slog.Info("GetSessionReview completed")
+	return review, nil
 }
@@ -3,6 +3,7 @@ package cases_test
 import (
 	"context"
 	"errors"
+	"fmt"
 	"testing"
 	"time"
 
@@ -22,10 +23,10 @@ func TestNewSessionServiceBase_Success(t *testing.T) {
 	defer ctrl.Finish()
 
 	storage := testdata.NewMockStorage(ctrl)
-	sessionStorage := entitiesTestdata.NewMockSessionStorage(ctrl)
+	attempts := testdata.NewMockAttemptService(ctrl)
 	generator := entitiesTestdata.NewMockIDGenerator(ctrl)
 
-	service, err := cases.NewSessionServiceBase(storage, sessionStorage, generator)
+	service, err := cases.NewSessionServiceBase(storage, attempts, generator)
 
 	require.NoError(t, err)
 	require.NotNil(t, service)
@@ -38,17 +39,141 @@ func TestNewSessionServiceBase_WithCustomDuration(t *testing.T) {
 	defer ctrl.Finish()
 
 	storage := testdata.NewMockStorage(ctrl)
-	sessionStorage := entitiesTestdata.NewMockSessionStorage(ctrl)
+	attempts := testdata.NewMockAttemptService(ctrl)
 	generator := entitiesTestdata.NewMockIDGenerator(ctrl)
 	customDuration := time.Minute * 15
 
-	service, err := cases.NewSessionServiceBase(storage, sessionStorage, generator,
+	service, err := cases.NewSessionServiceBase(storage, attempts, generator,
 		cases.WithCustomSessionDuration(customDuration))
 
 	require.NoError(t, err)
 	require.NotNil(t, service)
 }
 
+func TestSessionServiceBase_CreateSession_WithScoringStrategy(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	penalty := 0.5
+	strategy, err := entities.NewScoringStrategy(entities.NegativeMarkingScoring, &penalty)
+	require.NoError(t, err)
+
+	storage := testdata.NewMockStorage(ctrl)
+	storage.EXPECT().GetPassThresholds(gomock.Any(), []string{"Go"}).Return(nil, nil)
+	storage.EXPECT().GetPauseLimits(gomock.Any(), []string{"Go"}).Return(nil, nil)
+	attempts := testdata.NewMockAttemptService(ctrl)
+	generator := entitiesTestdata.NewMockIDGenerator(ctrl)
+
+	generator.EXPECT().GenerateID().Return("123")
+	attempts.EXPECT().CheckAttempt(gomock.Any(), "1",
+		[]string{"Go"}).Return(nil)
+	storage.EXPECT().GetQuesions(gomock.Any(), []string{"Go"}, gomock.Any()).Return(
+		[]entities.Question{entities.NewTrueOrFalseSelectionQuestion("1", "Go", "subject", true)},
+		nil)
+	storage.EXPECT().GetSelectionStrategies(gomock.Any(), []string{"Go"}).Return(nil, nil)
+	storage.EXPECT().StoreSession(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, session *entities.Session) error {
+			require.Equal(t, strategy, session.GetPolicy().Scoring)
+			return nil
+		})
+
+	service, err := cases.NewSessionServiceBase(storage, attempts, generator,
+		cases.WithScoringStrategy(strategy))
+	require.NoError(t, err)
+
+	_, _, err = service.CreateSession(context.Background(), "1", []string{"Go"},
+		entities.ExamMode)
+	require.NoError(t, err)
+}
+
+func TestSessionServiceBase_CreateSession_WithShuffle(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	question := entities.NewSingleSelectionQuestion("1", "Go", "subject",
+		[]string{"a", "b", "c", "d", "e", "f"}, "a")
+
+	storage := testdata.NewMockStorage(ctrl)
+	storage.EXPECT().GetPassThresholds(gomock.Any(), []string{"Go"}).Return(nil, nil)
+	storage.EXPECT().GetPauseLimits(gomock.Any(), []string{"Go"}).Return(nil, nil)
+	attempts := testdata.NewMockAttemptService(ctrl)
+	generator := entitiesTestdata.NewMockIDGenerator(ctrl)
+
+	generator.EXPECT().GenerateID().Return("123")
+	attempts.EXPECT().CheckAttempt(gomock.Any(), "1", []string{"Go"}).Return(nil)
+	storage.EXPECT().GetQuesions(gomock.Any(), []string{"Go"}, gomock.Any()).Return(
+		[]entities.Question{question}, nil)
+	storage.EXPECT().GetSelectionStrategies(gomock.Any(), []string{"Go"}).Return(nil, nil)
+
+	var policy entities.ShufflePolicy
+	storage.EXPECT().StoreSession(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, session *entities.Session) error {
+			policy = session.GetPolicy().Shuffle
+			return nil
+		})
+
+	service, err := cases.NewSessionServiceBase(storage, attempts, generator,
+		cases.WithShuffle(true, false))
+	require.NoError(t, err)
+
+	_, questions, err := service.CreateSession(context.Background(), "1", []string{"Go"},
+		entities.ExamMode)
+	require.NoError(t, err)
+
+	require.True(t, policy.Variants)
+	require.False(t, policy.Questions)
+	require.NotZero(t, policy.Seed)
+
+	// the student sees the variants in the order derived from the stored seed
+	require.Len(t, questions, 1)
+	expected := policy.ShuffleVariants(map[string]entities.Question{"1": question})
+	require.Equal(t, expected["1"].Variants(), questions[0].Variants())
+}
+
+func TestSessionServiceBase_CreateSession_WithPassThresholds(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	storage := testdata.NewMockStorage(ctrl)
+	attempts := testdata.NewMockAttemptService(ctrl)
+	generator := entitiesTestdata.NewMockIDGenerator(ctrl)
+
+	thresholds := map[string]float64{"Go": 80}
+	pauseLimits := map[string]int{"Go": 2}
+
+	generator.EXPECT().GenerateID().Return("123")
+	attempts.EXPECT().CheckAttempt(gomock.Any(), "1",
+		[]string{"Go"}).Return(nil)
+	storage.EXPECT().GetPassThresholds(gomock.Any(), []string{"Go"}).Return(thresholds, nil)
+	storage.EXPECT().GetPauseLimits(gomock.Any(), []string{"Go"}).Return(pauseLimits, nil)
+	storage.EXPECT().GetQuesions(gomock.Any(), []string{"Go"}, gomock.Any()).Return(
+		[]entities.Question{entities.NewTrueOrFalseSelectionQuestion("1", "Go", "subject", true)},
+		nil)
+	storage.EXPECT().GetSelectionStrategies(gomock.Any(), []string{"Go"}).Return(nil, nil)
+	storage.EXPECT().StoreSession(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, session *entities.Session) error {
+			policy := session.GetPolicy().PassThreshold
+			require.Equal(t, entities.WeightedThreshold, policy.Resolution)
+			require.Equal(t, thresholds, policy.Topics)
+			require.Equal(t, 2, session.GetPolicy().Pause.MaxPauses)
+			return nil
+		})
+
+	service, err := cases.NewSessionServiceBase(storage, attempts, generator,
+		cases.WithPassThresholdResolution(entities.WeightedThreshold))
+	require.NoError(t, err)
+
+	_, _, err = service.CreateSession(context.Background(), "1", []string{"Go"},
+		entities.ExamMode)
+	require.NoError(t, err)
+}
+
 func TestNewSessionServiceBase_ValidationErrors(t *testing.T) {
 	t.Parallel()
 
@@ -56,32 +181,32 @@ func TestNewSessionServiceBase_ValidationErrors(t *testing.T) {
 	defer ctrl.Finish()
 
 	storage := testdata.NewMockStorage(ctrl)
-	sessionStorage := entitiesTestdata.NewMockSessionStorage(ctrl)
+	attempts := testdata.NewMockAttemptService(ctrl)
 	generator := entitiesTestdata.NewMockIDGenerator(ctrl)
 
 	testCases := []struct {
 		name          string
-		setupMocks    func() (cases.Storage, entities.SessionStorage, entities.IDGenerator)
+		setupMocks    func() (cases.Storage, cases.AttemptService, entities.IDGenerator)
 		expectedError string
 	}{
 		{
 			name: "nil_storage",
-			setupMocks: func() (cases.Storage, entities.SessionStorage, entities.IDGenerator) {
-				return nil, sessionStorage, generator
+			setupMocks: func() (cases.Storage, cases.AttemptService, entities.IDGenerator) {
+				return nil, attempts, generator
 			},
 			expectedError: "storage not set",
 		},
 		{
 			name: "nil_session_storage",
-			setupMocks: func() (cases.Storage, entities.SessionStorage, entities.IDGenerator) {
+			setupMocks: func() (cases.Storage, cases.AttemptService, entities.IDGenerator) {
 				return storage, nil, generator
 			},
-			expectedError: "session storage not set",
+			expectedError: "attempt service not set",
 		},
 		{
 			name: "nil_generator",
-			setupMocks: func() (cases.Storage, entities.SessionStorage, entities.IDGenerator) {
-				return storage, sessionStorage, nil
+			setupMocks: func() (cases.Storage, cases.AttemptService, entities.IDGenerator) {
+				return storage, attempts, nil
 			},
 			expectedError: "generator not set",
 		},
@@ -92,9 +217,9 @@ func TestNewSessionServiceBase_ValidationErrors(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			t.Parallel()
 
-			storage, sessionStorage, generator := tc.setupMocks()
+			storage, attempts, generator := tc.setupMocks()
 
-			service, err := cases.NewSessionServiceBase(storage, sessionStorage, generator)
+			service, err := cases.NewSessionServiceBase(storage, attempts, generator)
 
 			require.Error(t, err)
 			require.Nil(t, service)
@@ -111,37 +236,41 @@ func TestSessionServiceBase_ShowTopics(t *testing.T) {
 
 	testCases := []struct {
 		name       string
-		setupMocks func() (*testdata.MockStorage, *entitiesTestdata.MockSessionStorage,
+		setupMocks func() (*testdata.MockStorage, *testdata.MockAttemptService,
 			*entitiesTestdata.MockIDGenerator)
 		expectedTopics []string
 		expectedError  string
 	}{
 		{
 			name: "success",
-			setupMocks: func() (*testdata.MockStorage, *entitiesTestdata.MockSessionStorage,
+			setupMocks: func() (*testdata.MockStorage, *testdata.MockAttemptService,
 				*entitiesTestdata.MockIDGenerator) {
 				storage := testdata.NewMockStorage(ctrl)
-				sessionStorage := entitiesTestdata.NewMockSessionStorage(ctrl)
+				storage.EXPECT().GetPassThresholds(gomock.Any(), gomock.Any()).Return(nil, nil)
+				storage.EXPECT().GetPauseLimits(gomock.Any(), gomock.Any()).Return(nil, nil)
+				attempts := testdata.NewMockAttemptService(ctrl)
 				generator := entitiesTestdata.NewMockIDGenerator(ctrl)
 
 				storage.EXPECT().GetTopics(gomock.Any()).Return([]string{"Go", "Databases"}, nil)
 
-				return storage, sessionStorage, generator
+				return storage, attempts, generator
 			},
 			expectedTopics: []string{"Go", "Databases"},
 			expectedError:  "",
 		},
 		{
 			name: "storage_error",
-			setupMocks: func() (*testdata.MockStorage, *entitiesTestdata.MockSessionStorage,
+			setupMocks: func() (*testdata.MockStorage, *testdata.MockAttemptService,
 				*entitiesTestdata.MockIDGenerator) {
 				storage := testdata.NewMockStorage(ctrl)
-				sessionStorage := entitiesTestdata.NewMockSessionStorage(ctrl)
+				storage.EXPECT().GetPassThresholds(gomock.Any(), gomock.Any()).Return(nil, nil)
+				storage.EXPECT().GetPauseLimits(gomock.Any(), gomock.Any()).Return(nil, nil)
+				attempts := testdata.NewMockAttemptService(ctrl)
 				generator := entitiesTestdata.NewMockIDGenerator(ctrl)
 
 				storage.EXPECT().GetTopics(gomock.Any()).Return(nil, errors.New("database error"))
 
-				return storage, sessionStorage, generator
+				return storage, attempts, generator
 			},
 			expectedTopics: nil,
 			expectedError:  "GetTopics",
@@ -153,9 +282,9 @@ func TestSessionServiceBase_ShowTopics(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			t.Parallel()
 
-			storage, sessionStorage, generator := tc.setupMocks()
+			storage, attempts, generator := tc.setupMocks()
 
-			service, err := cases.NewSessionServiceBase(storage, sessionStorage, generator)
+			service, err := cases.NewSessionServiceBase(storage, attempts, generator)
 			require.NoError(t, err)
 
 			ctx := context.Background()
@@ -184,7 +313,7 @@ func TestSessionServiceBase_CreateSession(t *testing.T) {
 		name       string
 		userID     string
 		topics     []string
-		setupMocks func() (*testdata.MockStorage, *entitiesTestdata.MockSessionStorage,
+		setupMocks func() (*testdata.MockStorage, *testdata.MockAttemptService,
 			*entitiesTestdata.MockIDGenerator)
 		expectedSessionID string
 		expectedError     string
@@ -193,83 +322,114 @@ func TestSessionServiceBase_CreateSession(t *testing.T) {
 			name:   "success",
 			userID: "1",
 			topics: []string{"Go"},
-			setupMocks: func() (*testdata.MockStorage, *entitiesTestdata.MockSessionStorage,
+			setupMocks: func() (*testdata.MockStorage, *testdata.MockAttemptService,
 				*entitiesTestdata.MockIDGenerator) {
 				storage := testdata.NewMockStorage(ctrl)
-				sessionStorage := entitiesTestdata.NewMockSessionStorage(ctrl)
+				storage.EXPECT().GetPassThresholds(gomock.Any(), gomock.Any()).Return(nil, nil)
+				storage.EXPECT().GetPauseLimits(gomock.Any(), gomock.Any()).Return(nil, nil)
+				attempts := testdata.NewMockAttemptService(ctrl)
 				generator := entitiesTestdata.NewMockIDGenerator(ctrl)
 
 				generator.EXPECT().GenerateID().Return("123")
-				sessionStorage.EXPECT().IsDailySessionLimitReached(gomock.Any(), "1",
-					[]string{"Go"}).Return(false, nil)
+				attempts.EXPECT().CheckAttempt(gomock.Any(), "1",
+					[]string{"Go"}).Return(nil)
 
 				mockQuestion := entitiesTestdata.NewMockQuestion(ctrl)
 				mockQuestion.EXPECT().ID().Return("1").AnyTimes()
-				storage.EXPECT().GetQuesions(gomock.Any(), []string{"Go"}).Return(
+				mockQuestion.EXPECT().Topic().Return("Go").AnyTimes()
+				storage.EXPECT().GetQuesions(gomock.Any(), []string{"Go"}, gomock.Any()).Return(
 					[]entities.Question{mockQuestion}, nil)
+				storage.EXPECT().GetSelectionStrategies(gomock.Any(), []string{"Go"}).Return(nil,
+					nil)
 
 				storage.EXPECT().StoreSession(gomock.Any(), gomock.Any()).Return(nil)
 
-				return storage, sessionStorage, generator
+				return storage, attempts, generator
 			},
 			expectedSessionID: "123",
 			expectedError:     "",
 		},
 		{
-			name:   "daily_limit_reached",
+			name:   "get_pass_thresholds_error",
 			userID: "1",
 			topics: []string{"Go"},
-			setupMocks: func() (*testdata.MockStorage, *entitiesTestdata.MockSessionStorage,
+			setupMocks: func() (*testdata.MockStorage, *testdata.MockAttemptService,
 				*entitiesTestdata.MockIDGenerator) {
 				storage := testdata.NewMockStorage(ctrl)
-				sessionStorage := entitiesTestdata.NewMockSessionStorage(ctrl)
+				attempts := testdata.NewMockAttemptService(ctrl)
+				generator := entitiesTestdata.NewMockIDGenerator(ctrl)
+
+				storage.EXPECT().GetPassThresholds(gomock.Any(), []string{"Go"}).Return(nil,
+					errors.New("thresholds error"))
+
+				return storage, attempts, generator
+			},
+			expectedSessionID: "",
+			expectedError:     "GetPassThresholds",
+		},
+		{
+			name:   "attempts_limit_reached",
+			userID: "1",
+			topics: []string{"Go"},
+			setupMocks: func() (*testdata.MockStorage, *testdata.MockAttemptService,
+				*entitiesTestdata.MockIDGenerator) {
+				storage := testdata.NewMockStorage(ctrl)
+				storage.EXPECT().GetPassThresholds(gomock.Any(), gomock.Any()).Return(nil, nil)
+				storage.EXPECT().GetPauseLimits(gomock.Any(), gomock.Any()).Return(nil, nil)
+				attempts := testdata.NewMockAttemptService(ctrl)
 				generator := entitiesTestdata.NewMockIDGenerator(ctrl)
 
 				generator.EXPECT().GenerateID().Return("123")
-				sessionStorage.EXPECT().IsDailySessionLimitReached(gomock.Any(), "1",
-					[]string{"Go"}).Return(true, nil)
+				attempts.EXPECT().CheckAttempt(gomock.Any(), "1", []string{"Go"}).Return(
+					entities.ErrForbidden)
 
-				return storage, sessionStorage, generator
+				return storage, attempts, generator
 			},
 			expectedSessionID: "0",
-			expectedError:     "creating new session for this user",
+			expectedError:     "CheckAttempt",
 		},
 		{
-			name:   "session_storage_error",
+			name:   "attempt_service_error",
 			userID: "1",
 			topics: []string{"Go"},
-			setupMocks: func() (*testdata.MockStorage, *entitiesTestdata.MockSessionStorage,
+			setupMocks: func() (*testdata.MockStorage, *testdata.MockAttemptService,
 				*entitiesTestdata.MockIDGenerator) {
 				storage := testdata.NewMockStorage(ctrl)
-				sessionStorage := entitiesTestdata.NewMockSessionStorage(ctrl)
+				storage.EXPECT().GetPassThresholds(gomock.Any(), gomock.Any()).Return(nil, nil)
+				storage.EXPECT().GetPauseLimits(gomock.Any(), gomock.Any()).Return(nil, nil)
+				attempts := testdata.NewMockAttemptService(ctrl)
 				generator := entitiesTestdata.NewMockIDGenerator(ctrl)
 
 				generator.EXPECT().GenerateID().Return("123")
-				sessionStorage.EXPECT().IsDailySessionLimitReached(gomock.Any(), "1",
-					[]string{"Go"}).Return(false, errors.New("storage error"))
+				attempts.EXPECT().CheckAttempt(gomock.Any(), "1", []string{"Go"}).Return(
+					errors.New("storage error"))
 
-				return storage, sessionStorage, generator
+				return storage, attempts, generator
 			},
 			expectedSessionID: "0",
-			expectedError:     "IsDailySessionLimitReached",
+			expectedError:     "CheckAttempt",
 		},
 		{
 			name:   "get_questions_error",
 			userID: "1",
 			topics: []string{"Go"},
-			setupMocks: func() (*testdata.MockStorage, *entitiesTestdata.MockSessionStorage,
+			setupMocks: func() (*testdata.MockStorage, *testdata.MockAttemptService,
 				*entitiesTestdata.MockIDGenerator) {
 				storage := testdata.NewMockStorage(ctrl)
-				sessionStorage := entitiesTestdata.NewMockSessionStorage(ctrl)
+				storage.EXPECT().GetPassThresholds(gomock.Any(), gomock.Any()).Return(nil, nil)
+				storage.EXPECT().GetPauseLimits(gomock.Any(), gomock.Any()).Return(nil, nil)
+				attempts := testdata.NewMockAttemptService(ctrl)
 				generator := entitiesTestdata.NewMockIDGenerator(ctrl)
 
 				generator.EXPECT().GenerateID().Return("123")
-				sessionStorage.EXPECT().IsDailySessionLimitReached(gomock.Any(),
-					"1", []string{"Go"}).Return(false, nil)
-				storage.EXPECT().GetQuesions(gomock.Any(), []string{"Go"}).Return(nil,
+				attempts.EXPECT().CheckAttempt(gomock.Any(),
+					"1", []string{"Go"}).Return(nil)
+				storage.EXPECT().GetSelectionStrategies(gomock.Any(), []string{"Go"}).Return(nil,
+					nil)
+				storage.EXPECT().GetQuesions(gomock.Any(), []string{"Go"}, gomock.Any()).Return(nil,
 					errors.New("questions error"))
 
-				return storage, sessionStorage, generator
+				return storage, attempts, generator
 			},
 			expectedSessionID: "0",
 			expectedError:     "GetQuesions",
@@ -278,24 +438,29 @@ func TestSessionServiceBase_CreateSession(t *testing.T) {
 			name:   "store_session_error",
 			userID: "1",
 			topics: []string{"Go"},
-			setupMocks: func() (*testdata.MockStorage, *entitiesTestdata.MockSessionStorage,
+			setupMocks: func() (*testdata.MockStorage, *testdata.MockAttemptService,
 				*entitiesTestdata.MockIDGenerator) {
 				storage := testdata.NewMockStorage(ctrl)
-				sessionStorage := entitiesTestdata.NewMockSessionStorage(ctrl)
+				storage.EXPECT().GetPassThresholds(gomock.Any(), gomock.Any()).Return(nil, nil)
+				storage.EXPECT().GetPauseLimits(gomock.Any(), gomock.Any()).Return(nil, nil)
+				attempts := testdata.NewMockAttemptService(ctrl)
 				generator := entitiesTestdata.NewMockIDGenerator(ctrl)
 
 				generator.EXPECT().GenerateID().Return("123")
-				sessionStorage.EXPECT().IsDailySessionLimitReached(gomock.Any(), "1",
-					[]string{"Go"}).Return(false, nil)
+				attempts.EXPECT().CheckAttempt(gomock.Any(), "1",
+					[]string{"Go"}).Return(nil)
 
 				mockQuestion := entitiesTestdata.NewMockQuestion(ctrl)
 				mockQuestion.EXPECT().ID().Return("1").AnyTimes()
-				storage.EXPECT().GetQuesions(gomock.Any(), []string{"Go"}).Return(
+				mockQuestion.EXPECT().Topic().Return("Go").AnyTimes()
+				storage.EXPECT().GetQuesions(gomock.Any(), []string{"Go"}, gomock.Any()).Return(
 					[]entities.Question{mockQuestion}, nil)
+				storage.EXPECT().GetSelectionStrategies(gomock.Any(), []string{"Go"}).Return(nil,
+					nil)
 				storage.EXPECT().StoreSession(gomock.Any(), gomock.Any()).Return(
 					errors.New("store error"))
 
-				return storage, sessionStorage, generator
+				return storage, attempts, generator
 			},
 			expectedSessionID: "0",
 			expectedError:     "StoreSession",
@@ -304,13 +469,15 @@ func TestSessionServiceBase_CreateSession(t *testing.T) {
 			name:   "new_session_error_invalid_user_id",
 			userID: "",
 			topics: []string{"Go"},
-			setupMocks: func() (*testdata.MockStorage, *entitiesTestdata.MockSessionStorage,
+			setupMocks: func() (*testdata.MockStorage, *testdata.MockAttemptService,
 				*entitiesTestdata.MockIDGenerator) {
 				storage := testdata.NewMockStorage(ctrl)
-				sessionStorage := entitiesTestdata.NewMockSessionStorage(ctrl)
+				storage.EXPECT().GetPassThresholds(gomock.Any(), gomock.Any()).Return(nil, nil)
+				storage.EXPECT().GetPauseLimits(gomock.Any(), gomock.Any()).Return(nil, nil)
+				attempts := testdata.NewMockAttemptService(ctrl)
 				generator := entitiesTestdata.NewMockIDGenerator(ctrl)
 
-				return storage, sessionStorage, generator
+				return storage, attempts, generator
 			},
 			expectedSessionID: "",
 			expectedError:     "NewSession",
@@ -319,13 +486,15 @@ func TestSessionServiceBase_CreateSession(t *testing.T) {
 			name:   "new_session_error_empty_topics",
 			userID: "1",
 			topics: []string{},
-			setupMocks: func() (*testdata.MockStorage, *entitiesTestdata.MockSessionStorage,
+			setupMocks: func() (*testdata.MockStorage, *testdata.MockAttemptService,
 				*entitiesTestdata.MockIDGenerator) {
 				storage := testdata.NewMockStorage(ctrl)
-				sessionStorage := entitiesTestdata.NewMockSessionStorage(ctrl)
+				storage.EXPECT().GetPassThresholds(gomock.Any(), gomock.Any()).Return(nil, nil)
+				storage.EXPECT().GetPauseLimits(gomock.Any(), gomock.Any()).Return(nil, nil)
+				attempts := testdata.NewMockAttemptService(ctrl)
 				generator := entitiesTestdata.NewMockIDGenerator(ctrl)
 
-				return storage, sessionStorage, generator
+				return storage, attempts, generator
 			},
 			expectedSessionID: "",
 			expectedError:     "NewSession",
@@ -334,20 +503,24 @@ func TestSessionServiceBase_CreateSession(t *testing.T) {
 			name:   "set_questions_error",
 			userID: "1",
 			topics: []string{"Go"},
-			setupMocks: func() (*testdata.MockStorage, *entitiesTestdata.MockSessionStorage,
+			setupMocks: func() (*testdata.MockStorage, *testdata.MockAttemptService,
 				*entitiesTestdata.MockIDGenerator) {
 				storage := testdata.NewMockStorage(ctrl)
-				sessionStorage := entitiesTestdata.NewMockSessionStorage(ctrl)
+				storage.EXPECT().GetPassThresholds(gomock.Any(), gomock.Any()).Return(nil, nil)
+				storage.EXPECT().GetPauseLimits(gomock.Any(), gomock.Any()).Return(nil, nil)
+				attempts := testdata.NewMockAttemptService(ctrl)
 				generator := entitiesTestdata.NewMockIDGenerator(ctrl)
 
 				generator.EXPECT().GenerateID().Return("123")
-				sessionStorage.EXPECT().IsDailySessionLimitReached(gomock.Any(), "1",
-					[]string{"Go"}).Return(false, nil)
+				attempts.EXPECT().CheckAttempt(gomock.Any(), "1",
+					[]string{"Go"}).Return(nil)
 
-				storage.EXPECT().GetQuesions(gomock.Any(), []string{"Go"}).Return(
+				storage.EXPECT().GetQuesions(gomock.Any(), []string{"Go"}, gomock.Any()).Return(
 					[]entities.Question{}, nil)
+				storage.EXPECT().GetSelectionStrategies(gomock.Any(), []string{"Go"}).Return(nil,
+					nil)
 
-				return storage, sessionStorage, generator
+				return storage, attempts, generator
 			},
 			expectedSessionID: "",
 			expectedError:     "SetQuestions",
@@ -359,13 +532,14 @@ func TestSessionServiceBase_CreateSession(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			t.Parallel()
 
-			storage, sessionStorage, generator := tc.setupMocks()
+			storage, attempts, generator := tc.setupMocks()
 
-			service, err := cases.NewSessionServiceBase(storage, sessionStorage, generator)
+			service, err := cases.NewSessionServiceBase(storage, attempts, generator)
 			require.NoError(t, err)
 
 			ctx := context.Background()
-			sessionID, questions, err := service.CreateSession(ctx, tc.userID, tc.topics)
+			sessionID, questions, err := service.CreateSession(ctx, tc.userID, tc.topics,
+				entities.ExamMode)
 
 			if tc.expectedError != "" {
 				require.Error(t, err)
@@ -392,7 +566,7 @@ func TestSessionServiceBase_CompleteSession(t *testing.T) {
 		name       string
 		sessionID  string
 		answers    []*entities.UserAnswer
-		setupMocks func() (*testdata.MockStorage, *entitiesTestdata.MockSessionStorage,
+		setupMocks func() (*testdata.MockStorage, *testdata.MockAttemptService,
 			*entitiesTestdata.MockIDGenerator)
 		expectedResult *entities.SessionResult
 		expectedError  string
@@ -401,10 +575,10 @@ func TestSessionServiceBase_CompleteSession(t *testing.T) {
 			name:      "success",
 			sessionID: "123",
 			answers:   []*entities.UserAnswer{},
-			setupMocks: func() (*testdata.MockStorage, *entitiesTestdata.MockSessionStorage,
+			setupMocks: func() (*testdata.MockStorage, *testdata.MockAttemptService,
 				*entitiesTestdata.MockIDGenerator) {
 				storage := testdata.NewMockStorage(ctrl)
-				sessionStorage := entitiesTestdata.NewMockSessionStorage(ctrl)
+				attempts := testdata.NewMockAttemptService(ctrl)
 				generator := entitiesTestdata.NewMockIDGenerator(ctrl)
 
 				mockState := entitiesTestdata.NewMockSessionState(ctrl)
@@ -415,19 +589,21 @@ func TestSessionServiceBase_CompleteSession(t *testing.T) {
 					Grade:     "100%",
 				}
 
+				storage.EXPECT().GetDraftAnswers(gomock.Any(), "123").Return(nil, nil)
 				storage.EXPECT().GetSessionBySessionID(gomock.Any(), "123").Return(session,
 					nil)
 				mockState.EXPECT().SetUserAnswer([]*entities.UserAnswer{}).Return(nil)
 				mockState.EXPECT().GetSessionResult().Return(expectedResult, nil)
 				storage.EXPECT().StoreSession(gomock.Any(), session).Return(nil)
 
-				return storage, sessionStorage, generator
+				return storage, attempts, generator
 			},
 			expectedResult: &entities.SessionResult{
 				IsSuccess: true,
 				Grade:     "100%",
 				UserID:    "1",
 				Topics:    []string{"Go"},
+				Mode:      entities.ExamMode,
 			},
 			expectedError: "",
 		},
@@ -435,16 +611,16 @@ func TestSessionServiceBase_CompleteSession(t *testing.T) {
 			name:      "session_not_found",
 			sessionID: "999",
 			answers:   []*entities.UserAnswer{},
-			setupMocks: func() (*testdata.MockStorage, *entitiesTestdata.MockSessionStorage,
+			setupMocks: func() (*testdata.MockStorage, *testdata.MockAttemptService,
 				*entitiesTestdata.MockIDGenerator) {
 				storage := testdata.NewMockStorage(ctrl)
-				sessionStorage := entitiesTestdata.NewMockSessionStorage(ctrl)
+				attempts := testdata.NewMockAttemptService(ctrl)
 				generator := entitiesTestdata.NewMockIDGenerator(ctrl)
 
 				storage.EXPECT().GetSessionBySessionID(gomock.Any(), "999").Return(nil,
 					errors.New("session not found"))
 
-				return storage, sessionStorage, generator
+				return storage, attempts, generator
 			},
 			expectedResult: nil,
 			expectedError:  "GetSessionBySessionID",
@@ -453,22 +629,23 @@ func TestSessionServiceBase_CompleteSession(t *testing.T) {
 			name:      "get_session_result_error",
 			sessionID: "123",
 			answers:   []*entities.UserAnswer{},
-			setupMocks: func() (*testdata.MockStorage, *entitiesTestdata.MockSessionStorage,
+			setupMocks: func() (*testdata.MockStorage, *testdata.MockAttemptService,
 				*entitiesTestdata.MockIDGenerator) {
 				storage := testdata.NewMockStorage(ctrl)
-				sessionStorage := entitiesTestdata.NewMockSessionStorage(ctrl)
+				attempts := testdata.NewMockAttemptService(ctrl)
 				generator := entitiesTestdata.NewMockIDGenerator(ctrl)
 
 				mockState := entitiesTestdata.NewMockSessionState(ctrl)
 				session := entities.NewSessionWithCustomState("123", "1", []string{"Go"}, mockState)
 
+				storage.EXPECT().GetDraftAnswers(gomock.Any(), "123").Return(nil, nil)
 				storage.EXPECT().GetSessionBySessionID(gomock.Any(), "123").Return(session,
 					nil)
 				mockState.EXPECT().SetUserAnswer([]*entities.UserAnswer{}).Return(nil)
 				mockState.EXPECT().GetSessionResult().Return(nil,
 					errors.New("session not completed"))
 
-				return storage, sessionStorage, generator
+				return storage, attempts, generator
 			},
 			expectedResult: nil,
 			expectedError:  "GetSessionResult",
@@ -477,10 +654,10 @@ func TestSessionServiceBase_CompleteSession(t *testing.T) {
 			name:      "store_session_error",
 			sessionID: "123",
 			answers:   []*entities.UserAnswer{},
-			setupMocks: func() (*testdata.MockStorage, *entitiesTestdata.MockSessionStorage,
+			setupMocks: func() (*testdata.MockStorage, *testdata.MockAttemptService,
 				*entitiesTestdata.MockIDGenerator) {
 				storage := testdata.NewMockStorage(ctrl)
-				sessionStorage := entitiesTestdata.NewMockSessionStorage(ctrl)
+				attempts := testdata.NewMockAttemptService(ctrl)
 				generator := entitiesTestdata.NewMockIDGenerator(ctrl)
 
 				mockState := entitiesTestdata.NewMockSessionState(ctrl)
@@ -490,6 +667,7 @@ func TestSessionServiceBase_CompleteSession(t *testing.T) {
 					Grade:     "100%",
 				}
 
+				storage.EXPECT().GetDraftAnswers(gomock.Any(), "123").Return(nil, nil)
 				storage.EXPECT().GetSessionBySessionID(gomock.Any(), "123").Return(session,
 					nil)
 				mockState.EXPECT().SetUserAnswer([]*entities.UserAnswer{}).Return(nil)
@@ -497,7 +675,7 @@ func TestSessionServiceBase_CompleteSession(t *testing.T) {
 				storage.EXPECT().StoreSession(gomock.Any(), session).Return(
 					errors.New("store error"))
 
-				return storage, sessionStorage, generator
+				return storage, attempts, generator
 			},
 			expectedResult: nil,
 			expectedError:  "StoreSession",
@@ -506,21 +684,22 @@ func TestSessionServiceBase_CompleteSession(t *testing.T) {
 			name:      "set_user_answer_error",
 			sessionID: "123",
 			answers:   []*entities.UserAnswer{},
-			setupMocks: func() (*testdata.MockStorage, *entitiesTestdata.MockSessionStorage,
+			setupMocks: func() (*testdata.MockStorage, *testdata.MockAttemptService,
 				*entitiesTestdata.MockIDGenerator) {
 				storage := testdata.NewMockStorage(ctrl)
-				sessionStorage := entitiesTestdata.NewMockSessionStorage(ctrl)
+				attempts := testdata.NewMockAttemptService(ctrl)
 				generator := entitiesTestdata.NewMockIDGenerator(ctrl)
 
 				mockState := entitiesTestdata.NewMockSessionState(ctrl)
 				session := entities.NewSessionWithCustomState("123", "1", []string{"Go"}, mockState)
 
+				storage.EXPECT().GetDraftAnswers(gomock.Any(), "123").Return(nil, nil)
 				storage.EXPECT().GetSessionBySessionID(gomock.Any(), "123").Return(session,
 					nil)
 				mockState.EXPECT().SetUserAnswer([]*entities.UserAnswer{}).Return(
 					errors.New("invalid answers"))
 
-				return storage, sessionStorage, generator
+				return storage, attempts, generator
 			},
 			expectedResult: nil,
 			expectedError:  "SetUserAnswer",
@@ -532,9 +711,9 @@ func TestSessionServiceBase_CompleteSession(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			t.Parallel()
 
-			storage, sessionStorage, generator := tc.setupMocks()
+			storage, attempts, generator := tc.setupMocks()
 
-			service, err := cases.NewSessionServiceBase(storage, sessionStorage, generator)
+			service, err := cases.NewSessionServiceBase(storage, attempts, generator)
 			require.NoError(t, err)
 
 			ctx := context.Background()
@@ -551,3 +730,488 @@ func TestSessionServiceBase_CompleteSession(t *testing.T) {
 		})
 	}
 }
+
+func newActiveTestSession(t *testing.T, ctrl *gomock.Controller,
+	startedAt time.Time) *entities.Session {
+	t.Helper()
+
+	generator := entitiesTestdata.NewMockIDGenerator(ctrl)
+	generator.EXPECT().GenerateID().Return("123")
+
+	session, err := entities.NewSession("1", []string{"Go"}, generator, entities.WithNilState())
+	require.NoError(t, err)
+
+	questions := map[string]entities.Question{
+		"1": entities.NewTrueOrFalseSelectionQuestion("1", "Go", "nil map можно читать", true),
+		"2": entities.NewTrueOrFalseSelectionQuestion("2", "Go", "nil map можно писать", false),
+	}
+	session.ChangeState(entities.NewActiveSessionState(questions, session, time.Minute*10,
+		entities.WithStartedAt(startedAt)))
+
+	return session
+}
+
+func TestSessionServiceBase_SaveDraftAnswer(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	testCases := []struct {
+		name          string
+		questionID    string
+		startedAt     time.Time
+		storeErr      error
+		expectedError string
+	}{
+		{
+			name:       "success",
+			questionID: "1",
+			startedAt:  time.Now().UTC(),
+		},
+		{
+			name:          "question_not_in_session",
+			questionID:    "3",
+			startedAt:     time.Now().UTC(),
+			expectedError: "CheckDraftAnswer",
+		},
+		{
+			name:          "session_expired",
+			questionID:    "1",
+			startedAt:     time.Now().UTC().Add(-time.Hour),
+			expectedError: "session expired",
+		},
+		{
+			name:          "store_error",
+			questionID:    "1",
+			startedAt:     time.Now().UTC(),
+			storeErr:      errors.New("db error"),
+			expectedError: "StoreDraftAnswer",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			storage := testdata.NewMockStorage(ctrl)
+			session := newActiveTestSession(t, ctrl, tc.startedAt)
+
+			answer, err := entities.NewUserAnswer(tc.questionID, []string{"true"})
+			require.NoError(t, err)
+
+			storage.EXPECT().GetSessionBySessionID(gomock.Any(), "123").Return(session, nil)
+			if tc.expectedError == "" || tc.storeErr != nil {
+				storage.EXPECT().StoreDraftAnswer(gomock.Any(), "123", answer).Return(tc.storeErr)
+			}
+
+			service, err := cases.NewSessionServiceBase(storage,
+				testdata.NewMockAttemptService(ctrl),
+				entitiesTestdata.NewMockIDGenerator(ctrl))
+			require.NoError(t, err)
+
+			err = service.SaveDraftAnswer(context.Background(), "123", answer)
+			if tc.expectedError != "" {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tc.expectedError)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestSessionServiceBase_ResumeSession(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	storage := testdata.NewMockStorage(ctrl)
+	session := newActiveTestSession(t, ctrl, time.Now().UTC().Add(-time.Minute))
+
+	draft, err := entities.NewUserAnswer("1", []string{"true"})
+	require.NoError(t, err)
+
+	storage.EXPECT().GetSessionBySessionID(gomock.Any(), "123").Return(session, nil)
+	storage.EXPECT().GetDraftAnswers(gomock.Any(), "123").Return(
+		[]*entities.UserAnswer{draft}, nil)
+
+	service, err := cases.NewSessionServiceBase(storage,
+		testdata.NewMockAttemptService(ctrl), entitiesTestdata.NewMockIDGenerator(ctrl))
+	require.NoError(t, err)
+
+	resumed, err := service.ResumeSession(context.Background(), "123")
+	require.NoError(t, err)
+	require.Equal(t, session, resumed.Session)
+	require.Equal(t, []*entities.UserAnswer{draft}, resumed.Drafts)
+	require.True(t, resumed.Remaining > time.Minute*8 && resumed.Remaining <= time.Minute*9)
+}
+
+func TestSessionServiceBase_CompleteSession_MergesDrafts(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	storage := testdata.NewMockStorage(ctrl)
+	session := newActiveTestSession(t, ctrl, time.Now().UTC())
+
+	draft, err := entities.NewUserAnswer("1", []string{"true"})
+	require.NoError(t, err)
+	staleDraft, err := entities.NewUserAnswer("2", []string{"true"})
+	require.NoError(t, err)
+	final, err := entities.NewUserAnswer("2", []string{"false"})
+	require.NoError(t, err)
+
+	storage.EXPECT().GetSessionBySessionID(gomock.Any(), "123").Return(session, nil)
+	storage.EXPECT().GetDraftAnswers(gomock.Any(), "123").Return(
+		[]*entities.UserAnswer{draft, staleDraft}, nil)
+	storage.EXPECT().StoreSession(gomock.Any(), session).Return(nil)
+
+	service, err := cases.NewSessionServiceBase(storage,
+		testdata.NewMockAttemptService(ctrl), entitiesTestdata.NewMockIDGenerator(ctrl))
+	require.NoError(t, err)
+
+	result, err := service.CompleteSession(context.Background(), "123",
+		[]*entities.UserAnswer{final})
+	require.NoError(t, err)
+	require.Equal(t, 2, result.CorrectCount)
+	require.Equal(t, entities.OutcomePassed, result.Outcome)
+}
+
+func TestSessionServiceBase_GetSession(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	storage := testdata.NewMockStorage(ctrl)
+	session := newActiveTestSession(t, ctrl, time.Now().UTC())
+
+	storage.EXPECT().GetSessionBySessionID(gomock.Any(), "123").Return(session, nil)
+	storage.EXPECT().GetSessionBySessionID(gomock.Any(), "999").Return(nil,
+		fmt.Errorf("session: %w", entities.ErrNotFound))
+
+	service, err := cases.NewSessionServiceBase(storage,
+		testdata.NewMockAttemptService(ctrl), entitiesTestdata.NewMockIDGenerator(ctrl))
+	require.NoError(t, err)
+
+	got, err := service.GetSession(context.Background(), "123")
+	require.NoError(t, err)
+	require.Equal(t, session, got)
+
+	_, err = service.GetSession(context.Background(), "999")
+	require.ErrorIs(t, err, entities.ErrNotFound)
+
+	_, err = service.GetSession(context.Background(), "")
+	require.ErrorIs(t, err, entities.ErrInvalidParam)
+}
+
+func TestSessionServiceBase_PauseAndUnpauseSession(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	generator := entitiesTestdata.NewMockIDGenerator(ctrl)
+	generator.EXPECT().GenerateID().Return("123")
+
+	policy := entities.DefaultSessionPolicy()
+	policy.Pause.MaxPauses = 1
+
+	session, err := entities.NewSession("1", []string{"Go"}, generator, entities.WithNilState(),
+		entities.WithPolicy(policy))
+	require.NoError(t, err)
+
+	questions := map[string]entities.Question{
+		"1": entities.NewTrueOrFalseSelectionQuestion("1", "Go", "nil map можно читать", true),
+	}
+	session.ChangeState(entities.NewActiveSessionState(questions, session, time.Minute*10,
+		entities.WithStartedAt(time.Now().UTC().Add(-time.Minute*2)),
+		entities.WithActiveStatePolicy(policy)))
+
+	storage := testdata.NewMockStorage(ctrl)
+	storage.EXPECT().GetSessionBySessionID(gomock.Any(), "123").Return(session, nil).Times(3)
+	storage.EXPECT().StoreSession(gomock.Any(), session).Return(nil).Times(2)
+
+	service, err := cases.NewSessionServiceBase(storage,
+		testdata.NewMockAttemptService(ctrl), entitiesTestdata.NewMockIDGenerator(ctrl))
+	require.NoError(t, err)
+
+	paused, err := service.PauseSession(context.Background(), "123")
+	require.NoError(t, err)
+	require.Equal(t, entities.PausedState, paused.GetStatus())
+
+	_, err = service.PauseSession(context.Background(), "123")
+	require.ErrorIs(t, err, entities.ErrInvalidState)
+
+	unpaused, err := service.UnpauseSession(context.Background(), "123")
+	require.NoError(t, err)
+	require.Equal(t, entities.ActiveState, unpaused.GetStatus())
+
+	remaining, err := unpaused.GetRemainingTime(time.Now().UTC())
+	require.NoError(t, err)
+	require.True(t, remaining > time.Minute*7 && remaining <= time.Minute*8)
+}
+
+func TestSessionServiceBase_CreateSession_Practice(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	storage := testdata.NewMockStorage(ctrl)
+	// practice sessions are not checked by the attempt policy
+	attempts := testdata.NewMockAttemptService(ctrl)
+	generator := entitiesTestdata.NewMockIDGenerator(ctrl)
+
+	generator.EXPECT().GenerateID().Return("123")
+	storage.EXPECT().GetPassThresholds(gomock.Any(), []string{"Go"}).Return(nil, nil)
+	storage.EXPECT().GetPauseLimits(gomock.Any(), []string{"Go"}).Return(nil, nil)
+	storage.EXPECT().GetQuesions(gomock.Any(), []string{"Go"}, gomock.Any()).Return(
+		[]entities.Question{entities.NewTrueOrFalseSelectionQuestion("1", "Go", "subject", true)},
+		nil)
+	storage.EXPECT().GetSelectionStrategies(gomock.Any(), []string{"Go"}).Return(nil, nil)
+	storage.EXPECT().StoreSession(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, session *entities.Session) error {
+			require.Equal(t, entities.PracticeMode, session.GetPolicy().Mode)
+			return nil
+		})
+
+	service, err := cases.NewSessionServiceBase(storage, attempts, generator)
+	require.NoError(t, err)
+
+	_, _, err = service.CreateSession(context.Background(), "1", []string{"Go"},
+		entities.PracticeMode)
+	require.NoError(t, err)
+
+	_, _, err = service.CreateSession(context.Background(), "1", []string{"Go"}, "quiz")
+	require.ErrorIs(t, err, entities.ErrInvalidParam)
+}
+
+func TestSessionServiceBase_CheckAnswer(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	newSession := func(mode entities.SessionMode) *entities.Session {
+		generator := entitiesTestdata.NewMockIDGenerator(ctrl)
+		generator.EXPECT().GenerateID().Return("123")
+
+		policy := entities.DefaultSessionPolicy()
+		policy.Mode = mode
+
+		session, err := entities.NewSession("1", []string{"Go"}, generator, entities.WithNilState(),
+			entities.WithPolicy(policy))
+		require.NoError(t, err)
+
+		questions := map[string]entities.Question{
+			"1": entities.NewTrueOrFalseSelectionQuestion("1", "Go", "nil map можно читать", true),
+		}
+		session.ChangeState(entities.NewActiveSessionState(questions, session, time.Minute,
+			entities.WithStartedAt(time.Now().UTC().Add(-time.Hour)),
+			entities.WithActiveStatePolicy(policy)))
+
+		return session
+	}
+
+	storage := testdata.NewMockStorage(ctrl)
+	storage.EXPECT().GetSessionBySessionID(gomock.Any(), "practice").Return(
+		newSession(entities.PracticeMode), nil)
+	storage.EXPECT().GetSessionBySessionID(gomock.Any(), "exam").Return(
+		newSession(entities.ExamMode), nil)
+
+	service, err := cases.NewSessionServiceBase(storage,
+		testdata.NewMockAttemptService(ctrl), entitiesTestdata.NewMockIDGenerator(ctrl))
+	require.NoError(t, err)
+
+	answer, err := entities.NewUserAnswer("1", []string{"false"})
+	require.NoError(t, err)
+
+	// practice session is untimed, so the answer is checked long after the time limit
+	feedback, err := service.CheckAnswer(context.Background(), "practice", answer)
+	require.NoError(t, err)
+	require.Equal(t, "1", feedback.QuestionID)
+	require.False(t, feedback.IsCorrect)
+	require.Equal(t, []string{"true"}, feedback.CorrectAnswers)
+
+	_, err = service.CheckAnswer(context.Background(), "exam", answer)
+	require.ErrorIs(t, err, entities.ErrForbidden)
+}
+
+func TestSessionServiceBase_GetSessionReview(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	generator := entitiesTestdata.NewMockIDGenerator(ctrl)
+	generator.EXPECT().GenerateID().Return("123")
+
+	policy := entities.DefaultSessionPolicy()
+	policy.Review.Visibility = entities.ReviewMentorOnly
+
+	session, err := entities.NewSession("1", []string{"Go"}, generator, entities.WithNilState(),
+		entities.WithPolicy(policy))
+	require.NoError(t, err)
+
+	answer, err := entities.NewUserAnswer("1", []string{"true"})
+	require.NoError(t, err)
+
+	questions := map[string]entities.Question{
+		"1": entities.NewTrueOrFalseSelectionQuestion("1", "Go", "nil map можно читать", true),
+	}
+	session.ChangeState(entities.NewCompletedSessionState(questions, session,
+		[]*entities.UserAnswer{answer}, time.Now().UTC(), false,
+		entities.WithCompletedStatePolicy(policy)))
+
+	storage := testdata.NewMockStorage(ctrl)
+	storage.EXPECT().GetSessionBySessionID(gomock.Any(), "123").Return(session, nil).Times(2)
+
+	service, err := cases.NewSessionServiceBase(storage,
+		testdata.NewMockAttemptService(ctrl), entitiesTestdata.NewMockIDGenerator(ctrl))
+	require.NoError(t, err)
+
+	_, err = service.GetSessionReview(context.Background(), "123", entities.StudentReviewer)
+	require.ErrorIs(t, err, entities.ErrForbidden)
+
+	review, err := service.GetSessionReview(context.Background(), "123",
+		entities.MentorReviewer)
+	require.NoError(t, err)
+	require.Len(t, review.Items, 1)
+	require.True(t, review.Items[0].IsCorrect)
+	require.Equal(t, 1.0, review.Items[0].Credit)
+}
+
+func TestSessionServiceBase_CreateSession_QuestionSelection(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	topics := []string{"Go", "SQL"}
+	goQuestion := entities.NewTrueOrFalseSelectionQuestion("1", "Go", "subject", true)
+	sqlQuestion := entities.NewTrueOrFalseSelectionQuestion("2", "SQL", "subject", true)
+	history := entities.TopicHistory{Sessions: 2, AverageScore: 90}
+
+	storage := testdata.NewMockStorage(ctrl)
+	attempts := testdata.NewMockAttemptService(ctrl)
+	generator := entitiesTestdata.NewMockIDGenerator(ctrl)
+	adaptive := testdata.NewMockQuestionSelector(ctrl)
+
+	generator.EXPECT().GenerateID().Return("123")
+	storage.EXPECT().GetPassThresholds(gomock.Any(), topics).Return(nil, nil)
+	storage.EXPECT().GetPauseLimits(gomock.Any(), topics).Return(nil, nil)
+	attempts.EXPECT().CheckAttempt(gomock.Any(), "1", topics).Return(nil)
+	// the uniform topic gets the session questions, the adaptive one gets the sample of every
+	// difficulty
+	storage.EXPECT().GetQuesions(gomock.Any(), []string{"SQL"}, 5).Return(
+		[]entities.Question{sqlQuestion}, nil)
+	storage.EXPECT().GetQuestionsByDifficulty(gomock.Any(), []string{"Go"}, 5).Return(
+		[]entities.Question{goQuestion}, nil)
+	storage.EXPECT().GetSelectionStrategies(gomock.Any(), topics).Return(
+		map[string]entities.SelectionStrategy{"Go": entities.AdaptiveSelection}, nil)
+	storage.EXPECT().GetTopicHistory(gomock.Any(), "1", topics).Return(
+		map[string]entities.TopicHistory{"Go": history}, nil)
+	adaptive.EXPECT().Select([]entities.Question{goQuestion}, 5, history).Return(
+		[]entities.Question{goQuestion})
+	storage.EXPECT().StoreSession(gomock.Any(), gomock.Any()).Return(nil)
+
+	service, err := cases.NewSessionServiceBase(storage, attempts, generator,
+		cases.WithQuestionsPerTopic(5),
+		cases.WithQuestionSelector(entities.AdaptiveSelection, adaptive))
+	require.NoError(t, err)
+
+	_, questions, err := service.CreateSession(context.Background(), "1", topics,
+		entities.ExamMode)
+	require.NoError(t, err)
+	require.Equal(t, []entities.Question{goQuestion, sqlQuestion}, questions)
+
+	// the selection strategy of the topic is unknown to the service
+	generator.EXPECT().GenerateID().Return("124")
+	storage.EXPECT().GetPassThresholds(gomock.Any(), topics).Return(nil, nil)
+	storage.EXPECT().GetPauseLimits(gomock.Any(), topics).Return(nil, nil)
+	storage.EXPECT().GetQuesions(gomock.Any(), []string{"SQL"}, 5).Return(
+		[]entities.Question{sqlQuestion}, nil)
+	storage.EXPECT().GetQuestionsByDifficulty(gomock.Any(), []string{"Go"}, 5).Return(
+		[]entities.Question{goQuestion}, nil)
+	storage.EXPECT().GetSelectionStrategies(gomock.Any(), topics).Return(
+		map[string]entities.SelectionStrategy{"Go": "hardest"}, nil)
+
+	_, _, err = service.CreateSession(context.Background(), "1", topics, entities.PracticeMode)
+	require.ErrorIs(t, err, entities.ErrInternal)
+
+	generator.EXPECT().GenerateID().Return("125")
+	storage.EXPECT().GetPassThresholds(gomock.Any(), topics).Return(nil, nil)
+	storage.EXPECT().GetPauseLimits(gomock.Any(), topics).Return(nil, nil)
+	storage.EXPECT().GetSelectionStrategies(gomock.Any(), topics).Return(nil,
+		errors.New("strategies error"))
+
+	_, _, err = service.CreateSession(context.Background(), "1", topics, entities.PracticeMode)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "GetSelectionStrategies")
+
+	generator.EXPECT().GenerateID().Return("126")
+	storage.EXPECT().GetPassThresholds(gomock.Any(), topics).Return(nil, nil)
+	storage.EXPECT().GetPauseLimits(gomock.Any(), topics).Return(nil, nil)
+	storage.EXPECT().GetSelectionStrategies(gomock.Any(), topics).Return(
+		map[string]entities.SelectionStrategy{"Go": entities.MixedSelection,
+			"SQL": entities.MixedSelection}, nil)
+	storage.EXPECT().GetQuestionsByDifficulty(gomock.Any(), topics, 5).Return(nil,
+		errors.New("questions error"))
+
+	_, _, err = service.CreateSession(context.Background(), "1", topics, entities.PracticeMode)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "GetQuestionsByDifficulty")
+}
+
+func TestNewSessionServiceBase_InvalidDifficultyMix(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	_, err := cases.NewSessionServiceBase(testdata.NewMockStorage(ctrl),
+		testdata.NewMockAttemptService(ctrl), entitiesTestdata.NewMockIDGenerator(ctrl),
+		cases.WithDifficultyMix(entities.DifficultyMix{Easy: 50, Medium: 60}))
+	require.ErrorIs(t, err, entities.ErrInvalidParam)
+}
+
+func TestSessionServiceBase_GetCompletedUserSessions(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	query, err := entities.NewHistoryQuery(entities.HistoryFilter{Topic: "Go",
+		Outcomes: []entities.Outcome{entities.OutcomePassed}}, entities.SortByScore,
+		entities.Descending, 10, nil)
+	require.NoError(t, err)
+
+	page := &entities.HistoryPage{
+		Sessions: []*entities.ResultSnapshot{{SessionID: "2"}, {SessionID: "1"}},
+		Totals:   entities.HistoryTotals{Total: 2, Passed: 2},
+	}
+
+	storage := testdata.NewMockStorage(ctrl)
+	storage.EXPECT().GetResultSnapshots(gomock.Any(), "1", query).Return(page, nil)
+	storage.EXPECT().GetResultSnapshots(gomock.Any(), "2", query).Return(nil,
+		errors.New("database error"))
+
+	service, err := cases.NewSessionServiceBase(storage,
+		testdata.NewMockAttemptService(ctrl), entitiesTestdata.NewMockIDGenerator(ctrl))
+	require.NoError(t, err)
+
+	result, err := service.GetCompletedUserSessions(context.Background(), "1", query)
+	require.NoError(t, err)
+	require.Equal(t, page, result)
+
+	_, err = service.GetCompletedUserSessions(context.Background(), "2", query)
+	require.ErrorContains(t, err, "database error")
+
+	_, err = service.GetCompletedUserSessions(context.Background(), "", query)
+	require.ErrorIs(t, err, entities.ErrInvalidParam)
+}
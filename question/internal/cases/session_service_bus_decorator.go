@@ -66,6 +66,12 @@ func (service *SessionServiceBusDecorator) CompleteSession(ctx context.Context,
 		return nil, err
 	}
 
+	// practice sessions are not reported to mentors
+	if sessionResult.Mode == entities.PracticeMode {
+		slog.Info("CompleteSession in SessionServiceBusDecorator completed")
+		return sessionResult, nil
+	}
+
 	go func() {
 		msgCtx, cancel := context.WithTimeout(context.Background(), timeoutEventDefault)
 		defer cancel()
@@ -82,10 +88,10 @@ func (service *SessionServiceBusDecorator) CompleteSession(ctx context.Context,
 }
 
 func (service *SessionServiceBusDecorator) CreateSession(ctx context.Context, userID string,
-	topics []string) (
-	string, map[string]entities.Question, error) {
+	topics []string, mode entities.SessionMode) (
+	string, []entities.Question, error) {
 	slog.Info("CreateSession in SessionServiceBusDecorator started")
-	sessionID, questions, err := service.sessionService.CreateSession(ctx, userID, topics)
+	sessionID, questions, err := service.sessionService.CreateSession(ctx, userID, topics, mode)
 	if err != nil {
 		err = errors.Wrap(err, "CreateSession in SessionServiceBusDecorator")
 		slog.Error(err.Error())
@@ -96,18 +102,18 @@ func (service *SessionServiceBusDecorator) CreateSession(ctx context.Context, us
 	return sessionID, questions, nil
 }
 
-func (service *SessionServiceBusDecorator) GetAllCompletedUserSessions(ctx context.Context,
-	userID string) ([]*entities.Session, error) {
-	slog.Info("GetAllCompletedUserSessions in SessionServiceBusDecorator started")
-	sessions, err := service.sessionService.GetAllCompletedUserSessions(ctx, userID)
+func (service *SessionServiceBusDecorator) GetCompletedUserSessions(ctx context.Context,
+	userID string, query entities.HistoryQuery) (*entities.HistoryPage, error) {
+	slog.Info("GetCompletedUserSessions in SessionServiceBusDecorator started")
+	page, err := service.sessionService.GetCompletedUserSessions(ctx, userID, query)
 	if err != nil {
-		err = errors.Wrap(err, "GetAllCompletedUserSessions in SessionServiceBusDecorator")
+		err = errors.Wrap(err, "GetCompletedUserSessions in SessionServiceBusDecorator")
 		slog.Error(err.Error())
 		return nil, err
 	}
 
-	slog.Info("GetAllCompletedUserSessions in SessionServiceBusDecorator completed")
-	return sessions, nil
+	slog.Info("GetCompletedUserSessions in SessionServiceBusDecorator completed")
+	return page, nil
 }
 
 func (service *SessionServiceBusDecorator) ShowTopics(ctx context.Context) ([]string, error) {
@@ -122,3 +128,128 @@ func (service *SessionServiceBusDecorator) ShowTopics(ctx context.Context) ([]st
 	slog.Info("ShowTopics in SessionServiceBusDecorator completed")
 	return topics, nil
 }
+
+func (service *SessionServiceBusDecorator) GetSession(ctx context.Context, sessionID string) (
+	*entities.Session, error) {
+	slog.Info("GetSession in SessionServiceBusDecorator started")
+	session, err := service.sessionService.GetSession(ctx, sessionID)
+	if err != nil {
+		err = errors.Wrap(err, "GetSession in SessionServiceBusDecorator")
+		slog.Error(err.Error())
+		return nil, err
+	}
+
+	slog.Info("GetSession in SessionServiceBusDecorator completed")
+	return session, nil
+}
+
+func (service *SessionServiceBusDecorator) SaveDraftAnswer(ctx context.Context, sessionID string,
+	answer *entities.UserAnswer) error {
+	slog.Info("SaveDraftAnswer in SessionServiceBusDecorator started")
+	if err := service.sessionService.SaveDraftAnswer(ctx, sessionID, answer); err != nil {
+		err = errors.Wrap(err, "SaveDraftAnswer in SessionServiceBusDecorator")
+		slog.Error(err.Error())
+		return err
+	}
+
+	slog.Info("SaveDraftAnswer in SessionServiceBusDecorator completed")
+	return nil
+}
+
+func (service *SessionServiceBusDecorator) ResumeSession(ctx context.Context, sessionID string) (
+	*ResumedSession, error) {
+	slog.Info("ResumeSession in SessionServiceBusDecorator started")
+	resumed, err := service.sessionService.ResumeSession(ctx, sessionID)
+	if err != nil {
+		err = errors.Wrap(err, "ResumeSession in SessionServiceBusDecorator")
+		slog.Error(err.Error())
+		return nil, err
+	}
+
+	slog.Info("ResumeSession in SessionServiceBusDecorator completed")
+	return resumed, nil
+}
+
+func (service *SessionServiceBusDecorator) PauseSession(ctx context.Context, sessionID string) (
+	*entities.Session, error) {
+	slog.Info("PauseSession in SessionServiceBusDecorator started")
+	session, err := service.sessionService.PauseSession(ctx, sessionID)
+	if err != nil {
+		err = errors.Wrap(err, "PauseSession in SessionServiceBusDecorator")
+		slog.Error(err.Error())
+		return nil, err
+	}
+
+	slog.Info("PauseSession in SessionServiceBusDecorator completed")
+	return session, nil
+}
+
+func (service *SessionServiceBusDecorator) UnpauseSession(ctx context.Context, sessionID string) (
+	*entities.Session, error) {
+	slog.Info("UnpauseSession in SessionServiceBusDecorator started")
+	session, err := service.sessionService.UnpauseSession(ctx, sessionID)
+	if err != nil {
+		err = errors.Wrap(err, "UnpauseSession in SessionServiceBusDecorator")
+		slog.Error(err.Error())
+		return nil, err
+	}
+
+	slog.Info("UnpauseSession in SessionServiceBusDecorator completed")
+	return session, nil
+}
+
+func (service *SessionServiceBusDecorator) CheckAnswer(ctx context.Context, sessionID string,
+	answer *entities.UserAnswer) (*entities.AnswerFeedback, error) {
+	slog.Info("CheckAnswer in SessionServiceBusDecorator started")
+	feedback, err := service.sessionService.CheckAnswer(ctx, sessionID, answer)
+	if err != nil {
+		err = errors.Wrap(err, "CheckAnswer in SessionServiceBusDecorator")
+		slog.Error(err.Error())
+		return nil, err
+	}
+
+	slog.Info("CheckAnswer in SessionServiceBusDecorator completed")
+	return feedback, nil
+}
+
+func (service *SessionServiceBusDecorator) GetSessionReview(ctx context.Context, sessionID string,
+	reviewer entities.Reviewer) (*entities.SessionReview, error) {
+	slog.Info("GetSessionReview in SessionServiceBusDecorator started")
+	review, err := service.sessionService.GetSessionReview(ctx, sessionID, reviewer)
+	if err != nil {
+		err = errors.Wrap(err, "GetSessionReview in SessionServiceBusDecorator")
+		slog.Error(err.Error())
+		return nil, err
+	}
+
+	slog.Info("GetSessionReview in SessionServiceBusDecorator completed")
+	return review, nil
+}
+
+func (service *SessionServiceBusDecorator) CreateReviewSession(ctx context.Context,
+	userID string, topics []string) (string, []entities.Question, error) {
+	slog.Info("CreateReviewSession in SessionServiceBusDecorator started")
+	sessionID, questions, err := service.sessionService.CreateReviewSession(ctx, userID, topics)
+	if err != nil {
+		err = errors.Wrap(err, "CreateReviewSession in SessionServiceBusDecorator")
+		slog.Error(err.Error())
+		return "", nil, err
+	}
+
+	slog.Info("CreateReviewSession in SessionServiceBusDecorator completed")
+	return sessionID, questions, nil
+}
+
+func (service *SessionServiceBusDecorator) GetDueReviews(ctx context.Context, userID string) (
+	[]entities.DueReviews, error) {
+	slog.Info("GetDueReviews in SessionServiceBusDecorator started")
+	reviews, err := service.sessionService.GetDueReviews(ctx, userID)
+	if err != nil {
+		err = errors.Wrap(err, "GetDueReviews in SessionServiceBusDecorator")
+		slog.Error(err.Error())
+		return nil, err
+	}
+
+	slog.Info("GetDueReviews in SessionServiceBusDecorator completed")
+	return reviews, nil
+}
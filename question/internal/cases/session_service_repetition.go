@@ -0,0 +1,138 @@
+package cases
+
+import (
+	"context"
+	"log/slog"
+	"slices"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/parta4ok/kvs/question/internal/entities"
+)
+
+// CreateReviewSession creates the practice session of questions due for repetition by the
+// Leitner schedule, questions answered wrong last time go first. Empty topics mean all topics
+// with questions due.
+func (srv *SessionServiceBase) CreateReviewSession(ctx context.Context, userID string,
+	topics []string) (string, []entities.Question, error) {
+	slog.Info("CreateReviewSession started")
+
+	if userID == "" {
+		err := errors.Wrap(entities.ErrInvalidParam, "userID not set")
+		slog.Error(err.Error())
+		return "", nil, err
+	}
+
+	cards, err := srv.repetitionCards(ctx, userID, topics)
+	if err != nil {
+		slog.Error(err.Error())
+		return "", nil, err
+	}
+
+	due := entities.DueCards(cards, time.Now().UTC())
+	if len(due) > srv.reviewSessionSize {
+		due = due[:srv.reviewSessionSize]
+	}
+
+	selected, sessionTopics, err := srv.reviewQuestions(ctx, due)
+	if err != nil {
+		slog.Error(err.Error())
+		return "", nil, err
+	}
+
+	if len(selected) == 0 {
+		err := errors.Wrap(entities.ErrNotFound, "no questions due for review")
+		slog.Warn(err.Error())
+		return "", nil, err
+	}
+
+	// review sessions are practice ones, so they are not limited by the attempt policy
+	session, err := srv.newSession(ctx, userID, sessionTopics, entities.PracticeMode)
+	if err != nil {
+		slog.Error(err.Error())
+		return "", nil, err
+	}
+
+	presented, err := srv.startSession(ctx, session, selected)
+	if err != nil {
+		slog.Error(err.Error())
+		return "", nil, err
+	}
+
+	slog.Info("CreateReviewSession completed")
+	return session.GetSesionID(), presented, nil
+}
+
+// GetDueReviews counts questions due for repetition by topic.
+func (srv *SessionServiceBase) GetDueReviews(ctx context.Context, userID string) (
+	[]entities.DueReviews, error) {
+	slog.Info("GetDueReviews started")
+
+	if userID == "" {
+		err := errors.Wrap(entities.ErrInvalidParam, "userID not set")
+		slog.Error(err.Error())
+		return nil, err
+	}
+
+	cards, err := srv.repetitionCards(ctx, userID, nil)
+	if err != nil {
+		slog.Error(err.Error())
+		return nil, err
+	}
+
+	slog.Info("GetDueReviews completed")
+	return entities.CountDueReviews(cards, time.Now().UTC()), nil
+}
+
+// repetitionCards replays stored answer outcomes of the user to the active questions of the
+// topics. Empty topics mean all active topics.
+func (srv *SessionServiceBase) repetitionCards(ctx context.Context, userID string,
+	topics []string) ([]entities.RepetitionCard, error) {
+	outcomes, err := srv.storage.GetRepetitionOutcomes(ctx, userID, topics)
+	if err != nil {
+		return nil, errors.Wrap(err, "GetRepetitionOutcomes")
+	}
+
+	return srv.leitner.BuildCards(outcomes), nil
+}
+
+// reviewQuestions loads questions of the due cards in the order of the cards. Questions deleted
+// after the cards were built are skipped.
+func (srv *SessionServiceBase) reviewQuestions(ctx context.Context,
+	due []entities.RepetitionCard) ([]entities.Question, []string, error) {
+	if len(due) == 0 {
+		return []entities.Question{}, []string{}, nil
+	}
+
+	questionIDs := make([]string, 0, len(due))
+	for _, card := range due {
+		questionIDs = append(questionIDs, card.QuestionID)
+	}
+
+	activeQuestions, err := srv.storage.GetActiveQuestions(ctx, questionIDs)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "GetActiveQuestions")
+	}
+
+	questions := make(map[string]entities.Question, len(activeQuestions))
+	for _, question := range activeQuestions {
+		questions[question.ID()] = question
+	}
+
+	selected := make([]entities.Question, 0, len(due))
+	topics := make([]string, 0)
+	for _, card := range due {
+		question, ok := questions[card.QuestionID]
+		if !ok {
+			continue
+		}
+
+		selected = append(selected, question)
+		if !slices.Contains(topics, question.Topic()) {
+			topics = append(topics, question.Topic())
+		}
+	}
+
+	return selected, topics, nil
+}
@@ -0,0 +1,215 @@
+package cases_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/parta4ok/kvs/question/internal/cases"
+	"github.com/parta4ok/kvs/question/internal/cases/testdata"
+	"github.com/parta4ok/kvs/question/internal/entities"
+	entitiesTestdata "github.com/parta4ok/kvs/question/internal/entities/testdata"
+)
+
+func newCompletedTestSession(t *testing.T, ctrl *gomock.Controller, startedAt time.Time,
+	questions []entities.Question, answers []*entities.UserAnswer) *entities.Session {
+	t.Helper()
+
+	generator := entitiesTestdata.NewMockIDGenerator(ctrl)
+	generator.EXPECT().GenerateID().Return("100")
+
+	session, err := entities.NewSession("1", []string{"Go"}, generator, entities.WithNilState())
+	require.NoError(t, err)
+
+	questionsMap := make(map[string]entities.Question, len(questions))
+	for _, question := range questions {
+		questionsMap[question.ID()] = question
+	}
+
+	session.ChangeState(entities.NewCompletedSessionState(questionsMap, session, answers,
+		startedAt, false))
+
+	return session
+}
+
+func repetitionTestQuestions() []entities.Question {
+	return []entities.Question{
+		entities.NewTrueOrFalseSelectionQuestion("1", "Go", "nil map можно читать", true),
+		entities.NewTrueOrFalseSelectionQuestion("2", "Go", "nil map можно писать", false),
+		entities.NewTrueOrFalseSelectionQuestion("3", "Базы данных", "COMMIT отменяет транзакцию",
+			false),
+	}
+}
+
+func repetitionTestAnswers(t *testing.T) []*entities.UserAnswer {
+	t.Helper()
+
+	correct, err := entities.NewUserAnswer("1", []string{"true"})
+	require.NoError(t, err)
+	wrong, err := entities.NewUserAnswer("2", []string{"true"})
+	require.NoError(t, err)
+
+	return []*entities.UserAnswer{correct, wrong}
+}
+
+func TestSessionServiceBase_CreateReviewSession(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	questions := repetitionTestQuestions()
+	completed := newCompletedTestSession(t, ctrl, time.Now().UTC().Add(-72*time.Hour),
+		questions, repetitionTestAnswers(t))
+
+	outcomes, err := completed.GetAnswerOutcomes()
+	require.NoError(t, err)
+
+	storage := testdata.NewMockStorage(ctrl)
+	// the topic of the third question is deleted, so the storage skips its outcome
+	storage.EXPECT().GetRepetitionOutcomes(gomock.Any(), "1", []string(nil)).Return(
+		outcomes[:2], nil)
+	// only questions of the session are loaded, the one answered wrong goes first
+	storage.EXPECT().GetActiveQuestions(gomock.Any(), []string{"2"}).Return(questions[1:2], nil)
+	storage.EXPECT().GetPassThresholds(gomock.Any(), []string{"Go"}).Return(nil, nil)
+	storage.EXPECT().GetPauseLimits(gomock.Any(), []string{"Go"}).Return(nil, nil)
+	storage.EXPECT().StoreSession(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, session *entities.Session) error {
+			require.Equal(t, entities.PracticeMode, session.GetPolicy().Mode)
+			return nil
+		})
+
+	generator := entitiesTestdata.NewMockIDGenerator(ctrl)
+	generator.EXPECT().GenerateID().Return("123")
+
+	// attempts are not checked for review sessions
+	service, err := cases.NewSessionServiceBase(storage, testdata.NewMockAttemptService(ctrl),
+		generator, cases.WithReviewSessionSize(1))
+	require.NoError(t, err)
+
+	sessionID, selected, err := service.CreateReviewSession(context.Background(), "1", nil)
+	require.NoError(t, err)
+	require.Equal(t, "123", sessionID)
+	require.Len(t, selected, 1)
+	require.Equal(t, "2", selected[0].ID())
+}
+
+func TestSessionServiceBase_CreateReviewSession_NothingDue(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	questions := repetitionTestQuestions()
+	completed := newCompletedTestSession(t, ctrl, time.Now().UTC(), questions[:1],
+		repetitionTestAnswers(t)[:1])
+
+	outcomes, err := completed.GetAnswerOutcomes()
+	require.NoError(t, err)
+
+	storage := testdata.NewMockStorage(ctrl)
+	storage.EXPECT().GetRepetitionOutcomes(gomock.Any(), "1", []string{"Go"}).Return(outcomes,
+		nil)
+
+	service, err := cases.NewSessionServiceBase(storage, testdata.NewMockAttemptService(ctrl),
+		entitiesTestdata.NewMockIDGenerator(ctrl))
+	require.NoError(t, err)
+
+	_, _, err = service.CreateReviewSession(context.Background(), "1", []string{"Go"})
+	require.ErrorIs(t, err, entities.ErrNotFound)
+
+	_, _, err = service.CreateReviewSession(context.Background(), "", nil)
+	require.ErrorIs(t, err, entities.ErrInvalidParam)
+}
+
+func TestSessionServiceBase_GetDueReviews(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	questions := repetitionTestQuestions()
+	completed := newCompletedTestSession(t, ctrl, time.Now().UTC().Add(-36*time.Hour),
+		questions, repetitionTestAnswers(t))
+
+	outcomes, err := completed.GetAnswerOutcomes()
+	require.NoError(t, err)
+
+	storage := testdata.NewMockStorage(ctrl)
+	storage.EXPECT().GetRepetitionOutcomes(gomock.Any(), "1", []string(nil)).Return(outcomes,
+		nil)
+
+	service, err := cases.NewSessionServiceBase(storage, testdata.NewMockAttemptService(ctrl),
+		entitiesTestdata.NewMockIDGenerator(ctrl), cases.WithLeitnerSchedule(
+			entities.LeitnerSchedule{Intervals: []time.Duration{24 * time.Hour, 48 * time.Hour}}))
+	require.NoError(t, err)
+
+	reviews, err := service.GetDueReviews(context.Background(), "1")
+	require.NoError(t, err)
+	require.Len(t, reviews, 2)
+
+	require.Equal(t, "Go", reviews[0].Topic)
+	require.Equal(t, 1, reviews[0].Due)
+	require.Equal(t, 1, reviews[0].Failed)
+	require.False(t, reviews[0].NextDueAt.IsZero())
+
+	require.Equal(t, entities.DueReviews{Topic: "Базы данных", Due: 1, Failed: 1},
+		reviews[1])
+}
+
+func TestSessionServiceBase_Repetition_StorageErrors(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	questions := repetitionTestQuestions()
+	completed := newCompletedTestSession(t, ctrl, time.Now().UTC().Add(-72*time.Hour),
+		questions, repetitionTestAnswers(t))
+
+	outcomes, err := completed.GetAnswerOutcomes()
+	require.NoError(t, err)
+
+	storage := testdata.NewMockStorage(ctrl)
+	storage.EXPECT().GetRepetitionOutcomes(gomock.Any(), "1", []string(nil)).Return(nil,
+		errors.New("outcomes error"))
+	storage.EXPECT().GetRepetitionOutcomes(gomock.Any(), "1", []string{"Go"}).Return(
+		outcomes[:2], nil)
+	storage.EXPECT().GetActiveQuestions(gomock.Any(), gomock.Any()).Return(nil,
+		errors.New("questions error"))
+	// the due question was deleted after the outcomes were read
+	storage.EXPECT().GetRepetitionOutcomes(gomock.Any(), "1", []string{"Go"}).Return(
+		outcomes[:2], nil)
+	storage.EXPECT().GetActiveQuestions(gomock.Any(), gomock.Any()).Return(nil, nil)
+
+	service, err := cases.NewSessionServiceBase(storage, testdata.NewMockAttemptService(ctrl),
+		entitiesTestdata.NewMockIDGenerator(ctrl))
+	require.NoError(t, err)
+
+	_, err = service.GetDueReviews(context.Background(), "1")
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "GetRepetitionOutcomes")
+
+	_, _, err = service.CreateReviewSession(context.Background(), "1", []string{"Go"})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "GetActiveQuestions")
+
+	_, _, err = service.CreateReviewSession(context.Background(), "1", []string{"Go"})
+	require.ErrorIs(t, err, entities.ErrNotFound)
+}
+
+func TestNewSessionServiceBase_InvalidLeitnerSchedule(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	_, err := cases.NewSessionServiceBase(testdata.NewMockStorage(ctrl),
+		testdata.NewMockAttemptService(ctrl), entitiesTestdata.NewMockIDGenerator(ctrl),
+		cases.WithLeitnerSchedule(entities.LeitnerSchedule{}))
+	require.ErrorIs(t, err, entities.ErrInvalidParam)
+}
@@ -0,0 +1,124 @@
+package cases
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/pkg/errors"
+
+	"github.com/parta4ok/kvs/question/internal/entities"
+)
+
+// SnapshotBackfill stores result snapshots of sessions completed before snapshots were stored
+// at completion. The stored snapshot is never overwritten, so several replicas may backfill at
+// the same time.
+type SnapshotBackfill struct {
+	storage   Storage
+	batchSize int
+}
+
+func NewSnapshotBackfill(storage Storage, opts ...SnapshotBackfillOption) (*SnapshotBackfill,
+	error) {
+	if storage == nil {
+		return nil, errors.Wrap(entities.ErrInvalidParam, "storage not set")
+	}
+
+	backfill := &SnapshotBackfill{
+		storage:   storage,
+		batchSize: defaultBackfillBatchSize,
+	}
+
+	for _, opt := range opts {
+		opt(backfill)
+	}
+
+	return backfill, nil
+}
+
+type SnapshotBackfillOption func(*SnapshotBackfill)
+
+func WithBackfillBatchSize(batchSize int) SnapshotBackfillOption {
+	return func(backfill *SnapshotBackfill) {
+		if batchSize > 0 {
+			backfill.batchSize = batchSize
+		}
+	}
+}
+
+// Run backfills batches until every completed session was tried or ctx done. Sessions which
+// failed are skipped, so they are tried again on the next start.
+func (backfill *SnapshotBackfill) Run(ctx context.Context) {
+	slog.Info("SnapshotBackfill started")
+
+	var (
+		total int
+		after *entities.BackfillCursor
+	)
+	for ctx.Err() == nil {
+		stored, next, err := backfill.Backfill(ctx, after)
+		if err != nil {
+			slog.Warn("Failed to backfill result snapshots", "error", err)
+			break
+		}
+
+		total += stored
+		if next == nil {
+			break
+		}
+
+		after = next
+	}
+
+	slog.Info("SnapshotBackfill completed", "stored", total)
+}
+
+// Backfill stores snapshots of one batch of sessions after the cursor and returns the number of
+// stored snapshots and the cursor of the next batch, nil when the batch is the last one. A
+// failed session does not stop the batch.
+func (backfill *SnapshotBackfill) Backfill(ctx context.Context,
+	after *entities.BackfillCursor) (int, *entities.BackfillCursor, error) {
+	slog.Info("Backfill started")
+
+	sessions, err := backfill.storage.GetSessionsWithoutSnapshot(ctx, after, backfill.batchSize)
+	if err != nil {
+		slog.Error(err.Error())
+		return 0, nil, errors.Wrap(err, "GetSessionsWithoutSnapshot")
+	}
+
+	var stored int
+	for _, position := range sessions {
+		if err := backfill.store(ctx, position.SessionID); err != nil {
+			slog.Warn("Failed to backfill result snapshot", "session_id", position.SessionID,
+				"error", err)
+			continue
+		}
+
+		stored++
+	}
+
+	slog.Info("Backfill completed", "stored", stored)
+
+	if len(sessions) < backfill.batchSize {
+		return stored, nil, nil
+	}
+
+	return stored, &sessions[len(sessions)-1], nil
+}
+
+func (backfill *SnapshotBackfill) store(ctx context.Context, sessionID string) error {
+	session, err := backfill.storage.GetSessionBySessionID(ctx, sessionID)
+	if err != nil {
+		return errors.Wrap(err, "GetSessionBySessionID")
+	}
+
+	snapshot, err := session.Snapshot()
+	if err != nil {
+		return errors.Wrap(err, "Snapshot")
+	}
+
+	if err := backfill.storage.StoreResultSnapshot(ctx, snapshot); err != nil {
+		return errors.Wrap(err, "StoreResultSnapshot")
+	}
+
+	return nil
+}
@@ -0,0 +1,145 @@
+package cases_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/parta4ok/kvs/question/internal/cases"
+	"github.com/parta4ok/kvs/question/internal/cases/testdata"
+	"github.com/parta4ok/kvs/question/internal/entities"
+)
+
+func TestNewSnapshotBackfill_ValidationErrors(t *testing.T) {
+	t.Parallel()
+
+	_, err := cases.NewSnapshotBackfill(nil)
+	require.ErrorIs(t, err, entities.ErrInvalidParam)
+}
+
+func TestSnapshotBackfill_Backfill(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	newCompletedSession := func(sessionID string) *entities.Session {
+		session := entities.NewSessionWithCustomState(sessionID, "1", []string{"Go"}, nil)
+		session.ChangeState(entities.NewCompletedSessionState(map[string]entities.Question{
+			"1": entities.NewTrueOrFalseSelectionQuestion("1", "Go", "subject", true),
+		}, session, nil, time.Now(), false))
+
+		return session
+	}
+
+	completedAt := time.Now().UTC()
+	positions := []entities.BackfillCursor{
+		{CompletedAt: completedAt, SessionID: "1"},
+		{CompletedAt: completedAt, SessionID: "2"},
+		{CompletedAt: completedAt, SessionID: "3"},
+	}
+
+	storage := testdata.NewMockStorage(ctrl)
+	storage.EXPECT().GetSessionsWithoutSnapshot(gomock.Any(), nil, 3).Return(positions, nil)
+	storage.EXPECT().GetSessionBySessionID(gomock.Any(), "1").Return(
+		newCompletedSession("1"), nil)
+	storage.EXPECT().GetSessionBySessionID(gomock.Any(), "2").Return(nil,
+		errors.New("database error"))
+	storage.EXPECT().GetSessionBySessionID(gomock.Any(), "3").Return(
+		newCompletedSession("3"), nil)
+	storage.EXPECT().StoreResultSnapshot(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, snapshot *entities.ResultSnapshot) error {
+			require.Len(t, snapshot.Items, 1)
+			require.False(t, snapshot.Items[0].Answered)
+			require.Equal(t, entities.OutcomeFailed, snapshot.Result.Outcome)
+			return nil
+		}).Times(2)
+
+	backfill, err := cases.NewSnapshotBackfill(storage, cases.WithBackfillBatchSize(3))
+	require.NoError(t, err)
+
+	// the full batch continues after its last session, the failed one included
+	stored, next, err := backfill.Backfill(context.Background(), nil)
+	require.NoError(t, err)
+	require.Equal(t, 2, stored)
+	require.Equal(t, &positions[2], next)
+
+	storage.EXPECT().GetSessionsWithoutSnapshot(gomock.Any(), &positions[2], 3).Return(nil,
+		errors.New("database error"))
+
+	_, _, err = backfill.Backfill(context.Background(), &positions[2])
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "GetSessionsWithoutSnapshot")
+}
+
+func TestSnapshotBackfill_Run(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	completedAt := time.Now().UTC()
+	position := entities.BackfillCursor{CompletedAt: completedAt, SessionID: "1"}
+
+	session := entities.NewSessionWithCustomState("1", "1", []string{"Go"}, nil)
+	session.ChangeState(entities.NewCompletedSessionState(map[string]entities.Question{}, session,
+		nil, completedAt, false))
+
+	storage := testdata.NewMockStorage(ctrl)
+	gomock.InOrder(
+		storage.EXPECT().GetSessionsWithoutSnapshot(gomock.Any(), nil, 1).Return(
+			[]entities.BackfillCursor{position}, nil),
+		storage.EXPECT().GetSessionsWithoutSnapshot(gomock.Any(), &position, 1).Return(
+			[]entities.BackfillCursor{}, nil),
+	)
+	storage.EXPECT().GetSessionBySessionID(gomock.Any(), "1").Return(session, nil)
+	storage.EXPECT().StoreResultSnapshot(gomock.Any(), gomock.Any()).Return(nil)
+
+	backfill, err := cases.NewSnapshotBackfill(storage, cases.WithBackfillBatchSize(1))
+	require.NoError(t, err)
+
+	// stops when every session has a snapshot
+	backfill.Run(context.Background())
+}
+
+func TestSnapshotBackfill_Run_FailedBatch(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	completedAt := time.Now().UTC()
+	failedBatch := []entities.BackfillCursor{
+		{CompletedAt: completedAt, SessionID: "1"},
+		{CompletedAt: completedAt, SessionID: "2"},
+	}
+	lastBatch := []entities.BackfillCursor{{CompletedAt: completedAt, SessionID: "3"}}
+
+	session := entities.NewSessionWithCustomState("3", "1", []string{"Go"}, nil)
+	session.ChangeState(entities.NewCompletedSessionState(map[string]entities.Question{}, session,
+		nil, completedAt, false))
+
+	storage := testdata.NewMockStorage(ctrl)
+	gomock.InOrder(
+		storage.EXPECT().GetSessionsWithoutSnapshot(gomock.Any(), nil, 2).Return(failedBatch,
+			nil),
+		storage.EXPECT().GetSessionsWithoutSnapshot(gomock.Any(), &failedBatch[1], 2).Return(
+			lastBatch, nil),
+	)
+	storage.EXPECT().GetSessionBySessionID(gomock.Any(), "1").Return(nil,
+		errors.New("database error"))
+	storage.EXPECT().GetSessionBySessionID(gomock.Any(), "2").Return(nil,
+		errors.New("database error"))
+	storage.EXPECT().GetSessionBySessionID(gomock.Any(), "3").Return(session, nil)
+	storage.EXPECT().StoreResultSnapshot(gomock.Any(), gomock.Any()).Return(nil)
+
+	backfill, err := cases.NewSnapshotBackfill(storage, cases.WithBackfillBatchSize(2))
+	require.NoError(t, err)
+
+	// the batch where every session fails does not stop the backfill
+	backfill.Run(context.Background())
+}
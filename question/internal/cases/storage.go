@@ -2,6 +2,7 @@ package cases
 
 import (
 	"context"
+	"time"
 
 	"github.com/parta4ok/kvs/question/internal/entities"
 )
@@ -9,8 +10,55 @@ import (
 //go:generate mockgen -source=storage.go -destination=./testdata/storage.go -package=testdata
 type Storage interface {
 	GetTopics(ctx context.Context) ([]string, error)
-	GetQuesions(ctx context.Context, topics []string) ([]entities.Question, error)
+	// GetQuesions returns up to limit random active questions of every requested topic.
+	GetQuesions(ctx context.Context, topics []string, limit int) ([]entities.Question, error)
+	// GetQuestionsByDifficulty returns up to limit random active questions of every difficulty
+	// of every requested topic, selectors keeping a difficulty mix pick questions of them.
+	GetQuestionsByDifficulty(ctx context.Context, topics []string, limit int) (
+		[]entities.Question, error)
+	// GetActiveQuestions returns the requested questions which are active and belong to active
+	// topics.
+	GetActiveQuestions(ctx context.Context, questionIDs []string) ([]entities.Question, error)
+	// GetSelectionStrategies returns how questions of the requested topics are selected.
+	GetSelectionStrategies(ctx context.Context, topics []string) (
+		map[string]entities.SelectionStrategy, error)
+	// GetTopicHistory returns results of the recent completed exam sessions of the user by topic.
+	GetTopicHistory(ctx context.Context, userID string, topics []string) (
+		map[string]entities.TopicHistory, error)
+	GetPassThresholds(ctx context.Context, topics []string) (map[string]float64, error)
+	// GetPauseLimits returns how many times sessions on the requested topics may be paused.
+	GetPauseLimits(ctx context.Context, topics []string) (map[string]int, error)
 	StoreSession(ctx context.Context, session *entities.Session) error
 	GetSessionBySessionID(ctx context.Context, sessionID string) (*entities.Session, error)
 	GetAllCompletedUserSessions(ctx context.Context, userID string) ([]*entities.Session, error)
+	// GetRepetitionOutcomes returns stored answer outcomes of the user to the active questions of
+	// the topics, empty topics mean all topics.
+	GetRepetitionOutcomes(ctx context.Context, userID string, topics []string) (
+		[]entities.AnswerOutcome, error)
+	// GetSessionsWithoutOutcomes returns positions of completed sessions after the cursor which
+	// have no answer outcomes. Nil cursor means from the start.
+	GetSessionsWithoutOutcomes(ctx context.Context, after *entities.BackfillCursor, limit int) (
+		[]entities.BackfillCursor, error)
+	// StoreAnswerOutcomes saves outcomes of the session completed before outcomes were stored,
+	// stored outcomes are never overwritten.
+	StoreAnswerOutcomes(ctx context.Context, session *entities.Session) error
+	// GetResultSnapshots returns the page of results of the completed sessions of the user
+	// matching the query with totals of all matching sessions.
+	GetResultSnapshots(ctx context.Context, userID string, query entities.HistoryQuery) (
+		*entities.HistoryPage, error)
+	// GetSessionsWithoutSnapshot returns positions of completed sessions after the cursor which
+	// have no result snapshot. Nil cursor means from the start.
+	GetSessionsWithoutSnapshot(ctx context.Context, after *entities.BackfillCursor, limit int) (
+		[]entities.BackfillCursor, error)
+	// StoreResultSnapshot saves the snapshot of the session completed before snapshots were
+	// stored, the stored snapshot is never overwritten.
+	StoreResultSnapshot(ctx context.Context, snapshot *entities.ResultSnapshot) error
+	// GetExpiredSessionIDs returns active sessions which deadline passed before now.
+	GetExpiredSessionIDs(ctx context.Context, now time.Time, limit int) ([]string, error)
+	// StoreDraftAnswer saves or overwrites answer to one question of the active session.
+	StoreDraftAnswer(ctx context.Context, sessionID string, answer *entities.UserAnswer) error
+	GetDraftAnswers(ctx context.Context, sessionID string) ([]*entities.UserAnswer, error)
+	// DeleteStaleDrafts removes up to limit drafts left from completed sessions and returns the
+	// number of removed drafts. Drafts of sessions completed now are removed by StoreSession.
+	DeleteStaleDrafts(ctx context.Context, limit int) (int, error)
 }
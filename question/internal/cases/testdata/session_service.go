@@ -9,6 +9,7 @@ import (
 	reflect "reflect"
 
 	gomock "github.com/golang/mock/gomock"
+	cases "github.com/parta4ok/kvs/question/internal/cases"
 	entities "github.com/parta4ok/kvs/question/internal/entities"
 )
 
@@ -35,6 +36,21 @@ func (m *MockSessionService) EXPECT() *MockSessionServiceMockRecorder {
 	return m.recorder
 }
 
+// CheckAnswer mocks base method.
+func (m *MockSessionService) CheckAnswer(ctx context.Context, sessionID string, answer *entities.UserAnswer) (*entities.AnswerFeedback, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckAnswer", ctx, sessionID, answer)
+	ret0, _ := ret[0].(*entities.AnswerFeedback)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CheckAnswer indicates an expected call of CheckAnswer.
+func (mr *MockSessionServiceMockRecorder) CheckAnswer(ctx, sessionID, answer interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckAnswer", reflect.TypeOf((*MockSessionService)(nil).CheckAnswer), ctx, sessionID, answer)
+}
+
 // CompleteSession mocks base method.
 func (m *MockSessionService) CompleteSession(ctx context.Context, sessionID string, answers []*entities.UserAnswer) (*entities.SessionResult, error) {
 	m.ctrl.T.Helper()
@@ -50,35 +66,140 @@ func (mr *MockSessionServiceMockRecorder) CompleteSession(ctx, sessionID, answer
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CompleteSession", reflect.TypeOf((*MockSessionService)(nil).CompleteSession), ctx, sessionID, answers)
 }
 
+// CreateReviewSession mocks base method.
+func (m *MockSessionService) CreateReviewSession(ctx context.Context, userID string, topics []string) (string, []entities.Question, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateReviewSession", ctx, userID, topics)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].([]entities.Question)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// CreateReviewSession indicates an expected call of CreateReviewSession.
+func (mr *MockSessionServiceMockRecorder) CreateReviewSession(ctx, userID, topics interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateReviewSession", reflect.TypeOf((*MockSessionService)(nil).CreateReviewSession), ctx, userID, topics)
+}
+
 // CreateSession mocks base method.
-func (m *MockSessionService) CreateSession(ctx context.Context, userID string, topics []string) (string, map[string]entities.Question, error) {
+func (m *MockSessionService) CreateSession(ctx context.Context, userID string, topics []string, mode entities.SessionMode) (string, []entities.Question, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateSession", ctx, userID, topics)
+	ret := m.ctrl.Call(m, "CreateSession", ctx, userID, topics, mode)
 	ret0, _ := ret[0].(string)
-	ret1, _ := ret[1].(map[string]entities.Question)
+	ret1, _ := ret[1].([]entities.Question)
 	ret2, _ := ret[2].(error)
 	return ret0, ret1, ret2
 }
 
 // CreateSession indicates an expected call of CreateSession.
-func (mr *MockSessionServiceMockRecorder) CreateSession(ctx, userID, topics interface{}) *gomock.Call {
+func (mr *MockSessionServiceMockRecorder) CreateSession(ctx, userID, topics, mode interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSession", reflect.TypeOf((*MockSessionService)(nil).CreateSession), ctx, userID, topics, mode)
+}
+
+// GetCompletedUserSessions mocks base method.
+func (m *MockSessionService) GetCompletedUserSessions(ctx context.Context, userID string, query entities.HistoryQuery) (*entities.HistoryPage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCompletedUserSessions", ctx, userID, query)
+	ret0, _ := ret[0].(*entities.HistoryPage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCompletedUserSessions indicates an expected call of GetCompletedUserSessions.
+func (mr *MockSessionServiceMockRecorder) GetCompletedUserSessions(ctx, userID, query interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCompletedUserSessions", reflect.TypeOf((*MockSessionService)(nil).GetCompletedUserSessions), ctx, userID, query)
+}
+
+// GetDueReviews mocks base method.
+func (m *MockSessionService) GetDueReviews(ctx context.Context, userID string) ([]entities.DueReviews, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDueReviews", ctx, userID)
+	ret0, _ := ret[0].([]entities.DueReviews)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDueReviews indicates an expected call of GetDueReviews.
+func (mr *MockSessionServiceMockRecorder) GetDueReviews(ctx, userID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSession", reflect.TypeOf((*MockSessionService)(nil).CreateSession), ctx, userID, topics)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDueReviews", reflect.TypeOf((*MockSessionService)(nil).GetDueReviews), ctx, userID)
 }
 
-// GetAllCompletedUserSessions mocks base method.
-func (m *MockSessionService) GetAllCompletedUserSessions(ctx context.Context, userID string) ([]*entities.Session, error) {
+// GetSession mocks base method.
+func (m *MockSessionService) GetSession(ctx context.Context, sessionID string) (*entities.Session, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetAllCompletedUserSessions", ctx, userID)
-	ret0, _ := ret[0].([]*entities.Session)
+	ret := m.ctrl.Call(m, "GetSession", ctx, sessionID)
+	ret0, _ := ret[0].(*entities.Session)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetAllCompletedUserSessions indicates an expected call of GetAllCompletedUserSessions.
-func (mr *MockSessionServiceMockRecorder) GetAllCompletedUserSessions(ctx, userID interface{}) *gomock.Call {
+// GetSession indicates an expected call of GetSession.
+func (mr *MockSessionServiceMockRecorder) GetSession(ctx, sessionID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllCompletedUserSessions", reflect.TypeOf((*MockSessionService)(nil).GetAllCompletedUserSessions), ctx, userID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSession", reflect.TypeOf((*MockSessionService)(nil).GetSession), ctx, sessionID)
+}
+
+// GetSessionReview mocks base method.
+func (m *MockSessionService) GetSessionReview(ctx context.Context, sessionID string, reviewer entities.Reviewer) (*entities.SessionReview, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSessionReview", ctx, sessionID, reviewer)
+	ret0, _ := ret[0].(*entities.SessionReview)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSessionReview indicates an expected call of GetSessionReview.
+func (mr *MockSessionServiceMockRecorder) GetSessionReview(ctx, sessionID, reviewer interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSessionReview", reflect.TypeOf((*MockSessionService)(nil).GetSessionReview), ctx, sessionID, reviewer)
+}
+
+// PauseSession mocks base method.
+func (m *MockSessionService) PauseSession(ctx context.Context, sessionID string) (*entities.Session, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PauseSession", ctx, sessionID)
+	ret0, _ := ret[0].(*entities.Session)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PauseSession indicates an expected call of PauseSession.
+func (mr *MockSessionServiceMockRecorder) PauseSession(ctx, sessionID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PauseSession", reflect.TypeOf((*MockSessionService)(nil).PauseSession), ctx, sessionID)
+}
+
+// ResumeSession mocks base method.
+func (m *MockSessionService) ResumeSession(ctx context.Context, sessionID string) (*cases.ResumedSession, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResumeSession", ctx, sessionID)
+	ret0, _ := ret[0].(*cases.ResumedSession)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResumeSession indicates an expected call of ResumeSession.
+func (mr *MockSessionServiceMockRecorder) ResumeSession(ctx, sessionID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResumeSession", reflect.TypeOf((*MockSessionService)(nil).ResumeSession), ctx, sessionID)
+}
+
+// SaveDraftAnswer mocks base method.
+func (m *MockSessionService) SaveDraftAnswer(ctx context.Context, sessionID string, answer *entities.UserAnswer) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveDraftAnswer", ctx, sessionID, answer)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveDraftAnswer indicates an expected call of SaveDraftAnswer.
+func (mr *MockSessionServiceMockRecorder) SaveDraftAnswer(ctx, sessionID, answer interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveDraftAnswer", reflect.TypeOf((*MockSessionService)(nil).SaveDraftAnswer), ctx, sessionID, answer)
 }
 
 // ShowTopics mocks base method.
@@ -95,3 +216,18 @@ func (mr *MockSessionServiceMockRecorder) ShowTopics(ctx interface{}) *gomock.Ca
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ShowTopics", reflect.TypeOf((*MockSessionService)(nil).ShowTopics), ctx)
 }
+
+// UnpauseSession mocks base method.
+func (m *MockSessionService) UnpauseSession(ctx context.Context, sessionID string) (*entities.Session, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnpauseSession", ctx, sessionID)
+	ret0, _ := ret[0].(*entities.Session)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UnpauseSession indicates an expected call of UnpauseSession.
+func (mr *MockSessionServiceMockRecorder) UnpauseSession(ctx, sessionID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnpauseSession", reflect.TypeOf((*MockSessionService)(nil).UnpauseSession), ctx, sessionID)
+}
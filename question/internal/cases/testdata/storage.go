@@ -7,6 +7,7 @@ package testdata
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	gomock "github.com/golang/mock/gomock"
 	entities "github.com/parta4ok/kvs/question/internal/entities"
@@ -35,6 +36,36 @@ func (m *MockStorage) EXPECT() *MockStorageMockRecorder {
 	return m.recorder
 }
 
+// DeleteStaleDrafts mocks base method.
+func (m *MockStorage) DeleteStaleDrafts(ctx context.Context, limit int) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteStaleDrafts", ctx, limit)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteStaleDrafts indicates an expected call of DeleteStaleDrafts.
+func (mr *MockStorageMockRecorder) DeleteStaleDrafts(ctx, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteStaleDrafts", reflect.TypeOf((*MockStorage)(nil).DeleteStaleDrafts), ctx, limit)
+}
+
+// GetActiveQuestions mocks base method.
+func (m *MockStorage) GetActiveQuestions(ctx context.Context, questionIDs []string) ([]entities.Question, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetActiveQuestions", ctx, questionIDs)
+	ret0, _ := ret[0].([]entities.Question)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetActiveQuestions indicates an expected call of GetActiveQuestions.
+func (mr *MockStorageMockRecorder) GetActiveQuestions(ctx, questionIDs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetActiveQuestions", reflect.TypeOf((*MockStorage)(nil).GetActiveQuestions), ctx, questionIDs)
+}
+
 // GetAllCompletedUserSessions mocks base method.
 func (m *MockStorage) GetAllCompletedUserSessions(ctx context.Context, userID string) ([]*entities.Session, error) {
 	m.ctrl.T.Helper()
@@ -50,19 +81,139 @@ func (mr *MockStorageMockRecorder) GetAllCompletedUserSessions(ctx, userID inter
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllCompletedUserSessions", reflect.TypeOf((*MockStorage)(nil).GetAllCompletedUserSessions), ctx, userID)
 }
 
+// GetDraftAnswers mocks base method.
+func (m *MockStorage) GetDraftAnswers(ctx context.Context, sessionID string) ([]*entities.UserAnswer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDraftAnswers", ctx, sessionID)
+	ret0, _ := ret[0].([]*entities.UserAnswer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDraftAnswers indicates an expected call of GetDraftAnswers.
+func (mr *MockStorageMockRecorder) GetDraftAnswers(ctx, sessionID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDraftAnswers", reflect.TypeOf((*MockStorage)(nil).GetDraftAnswers), ctx, sessionID)
+}
+
+// GetExpiredSessionIDs mocks base method.
+func (m *MockStorage) GetExpiredSessionIDs(ctx context.Context, now time.Time, limit int) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetExpiredSessionIDs", ctx, now, limit)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetExpiredSessionIDs indicates an expected call of GetExpiredSessionIDs.
+func (mr *MockStorageMockRecorder) GetExpiredSessionIDs(ctx, now, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetExpiredSessionIDs", reflect.TypeOf((*MockStorage)(nil).GetExpiredSessionIDs), ctx, now, limit)
+}
+
+// GetPassThresholds mocks base method.
+func (m *MockStorage) GetPassThresholds(ctx context.Context, topics []string) (map[string]float64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPassThresholds", ctx, topics)
+	ret0, _ := ret[0].(map[string]float64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPassThresholds indicates an expected call of GetPassThresholds.
+func (mr *MockStorageMockRecorder) GetPassThresholds(ctx, topics interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPassThresholds", reflect.TypeOf((*MockStorage)(nil).GetPassThresholds), ctx, topics)
+}
+
+// GetPauseLimits mocks base method.
+func (m *MockStorage) GetPauseLimits(ctx context.Context, topics []string) (map[string]int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPauseLimits", ctx, topics)
+	ret0, _ := ret[0].(map[string]int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPauseLimits indicates an expected call of GetPauseLimits.
+func (mr *MockStorageMockRecorder) GetPauseLimits(ctx, topics interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPauseLimits", reflect.TypeOf((*MockStorage)(nil).GetPauseLimits), ctx, topics)
+}
+
 // GetQuesions mocks base method.
-func (m *MockStorage) GetQuesions(ctx context.Context, topics []string) ([]entities.Question, error) {
+func (m *MockStorage) GetQuesions(ctx context.Context, topics []string, limit int) ([]entities.Question, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetQuesions", ctx, topics)
+	ret := m.ctrl.Call(m, "GetQuesions", ctx, topics, limit)
 	ret0, _ := ret[0].([]entities.Question)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetQuesions indicates an expected call of GetQuesions.
-func (mr *MockStorageMockRecorder) GetQuesions(ctx, topics interface{}) *gomock.Call {
+func (mr *MockStorageMockRecorder) GetQuesions(ctx, topics, limit interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetQuesions", reflect.TypeOf((*MockStorage)(nil).GetQuesions), ctx, topics)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetQuesions", reflect.TypeOf((*MockStorage)(nil).GetQuesions), ctx, topics, limit)
+}
+
+// GetQuestionsByDifficulty mocks base method.
+func (m *MockStorage) GetQuestionsByDifficulty(ctx context.Context, topics []string, limit int) ([]entities.Question, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetQuestionsByDifficulty", ctx, topics, limit)
+	ret0, _ := ret[0].([]entities.Question)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetQuestionsByDifficulty indicates an expected call of GetQuestionsByDifficulty.
+func (mr *MockStorageMockRecorder) GetQuestionsByDifficulty(ctx, topics, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetQuestionsByDifficulty", reflect.TypeOf((*MockStorage)(nil).GetQuestionsByDifficulty), ctx, topics, limit)
+}
+
+// GetRepetitionOutcomes mocks base method.
+func (m *MockStorage) GetRepetitionOutcomes(ctx context.Context, userID string, topics []string) ([]entities.AnswerOutcome, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRepetitionOutcomes", ctx, userID, topics)
+	ret0, _ := ret[0].([]entities.AnswerOutcome)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRepetitionOutcomes indicates an expected call of GetRepetitionOutcomes.
+func (mr *MockStorageMockRecorder) GetRepetitionOutcomes(ctx, userID, topics interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRepetitionOutcomes", reflect.TypeOf((*MockStorage)(nil).GetRepetitionOutcomes), ctx, userID, topics)
+}
+
+// GetResultSnapshots mocks base method.
+func (m *MockStorage) GetResultSnapshots(ctx context.Context, userID string, query entities.HistoryQuery) (*entities.HistoryPage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetResultSnapshots", ctx, userID, query)
+	ret0, _ := ret[0].(*entities.HistoryPage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetResultSnapshots indicates an expected call of GetResultSnapshots.
+func (mr *MockStorageMockRecorder) GetResultSnapshots(ctx, userID, query interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetResultSnapshots", reflect.TypeOf((*MockStorage)(nil).GetResultSnapshots), ctx, userID, query)
+}
+
+// GetSelectionStrategies mocks base method.
+func (m *MockStorage) GetSelectionStrategies(ctx context.Context, topics []string) (map[string]entities.SelectionStrategy, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSelectionStrategies", ctx, topics)
+	ret0, _ := ret[0].(map[string]entities.SelectionStrategy)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSelectionStrategies indicates an expected call of GetSelectionStrategies.
+func (mr *MockStorageMockRecorder) GetSelectionStrategies(ctx, topics interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSelectionStrategies", reflect.TypeOf((*MockStorage)(nil).GetSelectionStrategies), ctx, topics)
 }
 
 // GetSessionBySessionID mocks base method.
@@ -80,6 +231,51 @@ func (mr *MockStorageMockRecorder) GetSessionBySessionID(ctx, sessionID interfac
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSessionBySessionID", reflect.TypeOf((*MockStorage)(nil).GetSessionBySessionID), ctx, sessionID)
 }
 
+// GetSessionsWithoutOutcomes mocks base method.
+func (m *MockStorage) GetSessionsWithoutOutcomes(ctx context.Context, after *entities.BackfillCursor, limit int) ([]entities.BackfillCursor, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSessionsWithoutOutcomes", ctx, after, limit)
+	ret0, _ := ret[0].([]entities.BackfillCursor)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSessionsWithoutOutcomes indicates an expected call of GetSessionsWithoutOutcomes.
+func (mr *MockStorageMockRecorder) GetSessionsWithoutOutcomes(ctx, after, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSessionsWithoutOutcomes", reflect.TypeOf((*MockStorage)(nil).GetSessionsWithoutOutcomes), ctx, after, limit)
+}
+
+// GetSessionsWithoutSnapshot mocks base method.
+func (m *MockStorage) GetSessionsWithoutSnapshot(ctx context.Context, after *entities.BackfillCursor, limit int) ([]entities.BackfillCursor, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSessionsWithoutSnapshot", ctx, after, limit)
+	ret0, _ := ret[0].([]entities.BackfillCursor)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSessionsWithoutSnapshot indicates an expected call of GetSessionsWithoutSnapshot.
+func (mr *MockStorageMockRecorder) GetSessionsWithoutSnapshot(ctx, after, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSessionsWithoutSnapshot", reflect.TypeOf((*MockStorage)(nil).GetSessionsWithoutSnapshot), ctx, after, limit)
+}
+
+// GetTopicHistory mocks base method.
+func (m *MockStorage) GetTopicHistory(ctx context.Context, userID string, topics []string) (map[string]entities.TopicHistory, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTopicHistory", ctx, userID, topics)
+	ret0, _ := ret[0].(map[string]entities.TopicHistory)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTopicHistory indicates an expected call of GetTopicHistory.
+func (mr *MockStorageMockRecorder) GetTopicHistory(ctx, userID, topics interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTopicHistory", reflect.TypeOf((*MockStorage)(nil).GetTopicHistory), ctx, userID, topics)
+}
+
 // GetTopics mocks base method.
 func (m *MockStorage) GetTopics(ctx context.Context) ([]string, error) {
 	m.ctrl.T.Helper()
@@ -95,6 +291,48 @@ func (mr *MockStorageMockRecorder) GetTopics(ctx interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTopics", reflect.TypeOf((*MockStorage)(nil).GetTopics), ctx)
 }
 
+// StoreAnswerOutcomes mocks base method.
+func (m *MockStorage) StoreAnswerOutcomes(ctx context.Context, session *entities.Session) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StoreAnswerOutcomes", ctx, session)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// StoreAnswerOutcomes indicates an expected call of StoreAnswerOutcomes.
+func (mr *MockStorageMockRecorder) StoreAnswerOutcomes(ctx, session interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StoreAnswerOutcomes", reflect.TypeOf((*MockStorage)(nil).StoreAnswerOutcomes), ctx, session)
+}
+
+// StoreDraftAnswer mocks base method.
+func (m *MockStorage) StoreDraftAnswer(ctx context.Context, sessionID string, answer *entities.UserAnswer) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StoreDraftAnswer", ctx, sessionID, answer)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// StoreDraftAnswer indicates an expected call of StoreDraftAnswer.
+func (mr *MockStorageMockRecorder) StoreDraftAnswer(ctx, sessionID, answer interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StoreDraftAnswer", reflect.TypeOf((*MockStorage)(nil).StoreDraftAnswer), ctx, sessionID, answer)
+}
+
+// StoreResultSnapshot mocks base method.
+func (m *MockStorage) StoreResultSnapshot(ctx context.Context, snapshot *entities.ResultSnapshot) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StoreResultSnapshot", ctx, snapshot)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// StoreResultSnapshot indicates an expected call of StoreResultSnapshot.
+func (mr *MockStorageMockRecorder) StoreResultSnapshot(ctx, snapshot interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StoreResultSnapshot", reflect.TypeOf((*MockStorage)(nil).StoreResultSnapshot), ctx, snapshot)
+}
+
 // StoreSession mocks base method.
 func (m *MockStorage) StoreSession(ctx context.Context, session *entities.Session) error {
 	m.ctrl.T.Helper()
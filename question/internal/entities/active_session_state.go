@@ -1,7 +1,6 @@
 package entities
 
 import (
-	"context"
 	"time"
 
 	"github.com/pkg/errors"
@@ -16,6 +15,8 @@ type ActiveSessionState struct {
 	questions map[string]Question
 	startedAt time.Time
 	duration  time.Duration
+	clock     SessionClock
+	policy    SessionPolicy
 }
 
 func NewActiveSessionState(questions map[string]Question, holder StateHolder,
@@ -25,9 +26,15 @@ func NewActiveSessionState(questions map[string]Question, holder StateHolder,
 		questions: questions,
 		startedAt: time.Now().UTC(),
 		duration:  duration,
+		policy:    DefaultSessionPolicy(),
 	}
 
 	state.setOptions(opts...)
+	state.questions = state.policy.Shuffle.ShuffleVariants(state.questions)
+
+	if !state.clock.IsRunning() {
+		state.clock = state.clock.Start(state.startedAt)
+	}
 
 	return state
 }
@@ -40,6 +47,20 @@ func WithStartedAt(startedAt time.Time) ActiveSessionStateOption {
 	}
 }
 
+// WithActiveClock restores active time of the session resumed after pauses. Without it the
+// session runs since it was started.
+func WithActiveClock(clock SessionClock) ActiveSessionStateOption {
+	return func(state *ActiveSessionState) {
+		state.clock = clock
+	}
+}
+
+func WithActiveStatePolicy(policy SessionPolicy) ActiveSessionStateOption {
+	return func(state *ActiveSessionState) {
+		state.policy = policy
+	}
+}
+
 func (state *ActiveSessionState) setOptions(opts ...ActiveSessionStateOption) {
 	for _, opt := range opts {
 		opt(state)
@@ -56,10 +77,12 @@ func (state *ActiveSessionState) SetQuestions(_ map[string]Question,
 }
 
 func (state *ActiveSessionState) SetUserAnswer(answers []*UserAnswer) error {
-	isExpired := time.Now().UTC().After(state.startedAt.Add(state.duration))
+	isExpired := state.policy.IsTimed() &&
+		state.clock.ElapsedAt(time.Now().UTC()) > state.duration
 
 	completedState := NewCompletedSessionState(state.questions, state.holder, answers,
-		state.startedAt, isExpired)
+		state.startedAt, isExpired, WithCompletedStatePolicy(state.policy),
+		WithCompletedDurationLimit(state.duration))
 	state.holder.ChangeState(completedState)
 
 	return nil
@@ -84,7 +107,7 @@ func (state *ActiveSessionState) GetQuestions() ([]Question, error) {
 		questionsList = append(questionsList, question)
 	}
 
-	return questionsList, nil
+	return state.policy.Shuffle.OrderQuestions(questionsList), nil
 }
 
 func (state *ActiveSessionState) GetStartedAt() (time.Time, error) {
@@ -96,8 +119,27 @@ func (state *ActiveSessionState) GetUserAnswers() ([]*UserAnswer, error) {
 		ErrInvalidState, "%s not support `GetUserAnswers`", state.GetStatus())
 }
 
-func (state *ActiveSessionState) IsDailySessionLimitReached(ctx context.Context, userID string,
-	topics []string) (bool, error) {
-	return false, errors.Wrapf(
-		ErrInvalidState, "%s not support `IsDailySessionLimitReached`", state.GetStatus())
+// Pause stops the session clock if the session policy allows one more pause.
+func (state *ActiveSessionState) Pause(now time.Time) error {
+	if !state.policy.AllowsPause(state.clock.Pauses) {
+		return errors.Wrapf(ErrForbidden, "pause limit %d reached", state.policy.Pause.MaxPauses)
+	}
+
+	if state.policy.IsTimed() && state.clock.ElapsedAt(now) > state.duration {
+		return errors.Wrap(ErrInvalidState, "session expired")
+	}
+
+	pausedState := NewPausedSessionState(state.questions, state.holder, state.duration,
+		state.startedAt, state.clock.Stop(now), WithPausedStatePolicy(state.policy))
+	state.holder.ChangeState(pausedState)
+
+	return nil
+}
+
+func (state *ActiveSessionState) Resume(_ time.Time) error {
+	return errors.Wrapf(ErrInvalidState, "%s not support `Resume`", state.GetStatus())
+}
+
+func (state *ActiveSessionState) GetClock() (SessionClock, error) {
+	return state.clock, nil
 }
@@ -1,7 +1,6 @@
 package entities_test
 
 import (
-	"context"
 	"testing"
 	"time"
 
@@ -190,23 +189,3 @@ func TestActiveSessionState_GetUserAnswers_NotSupported(t *testing.T) {
 	require.Nil(t, result)
 	require.Contains(t, err.Error(), "not support `GetUserAnswers`")
 }
-
-func TestActiveSessionState_IsDailySessionLimitReached(t *testing.T) {
-	t.Parallel()
-
-	ctrl := gomock.NewController(t)
-	defer ctrl.Finish()
-
-	mockQuestion := testdata.NewMockQuestion(ctrl)
-	questions := map[string]entities.Question{"1": mockQuestion}
-	holder := testdata.NewMockStateHolder(ctrl)
-	duration := time.Minute * 5
-
-	state := entities.NewActiveSessionState(questions, holder, duration)
-
-	result, err := state.IsDailySessionLimitReached(context.TODO(), "1", []string{"topic"})
-
-	require.Error(t, err)
-	require.False(t, result)
-	require.Contains(t, err.Error(), "not support `IsDailySessionLimitReached`")
-}
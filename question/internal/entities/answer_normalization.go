@@ -0,0 +1,62 @@
+package entities
+
+import (
+	"strings"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+// lookalikes maps Cyrillic letters to the Latin letters they are visually indistinguishable
+// from, so "СРU" typed with a Russian keyboard layout matches "CPU".
+var lookalikes = map[rune]rune{
+	'А': 'A', 'В': 'B', 'Е': 'E', 'К': 'K', 'М': 'M', 'Н': 'H', 'О': 'O', 'Р': 'P', 'С': 'C',
+	'Т': 'T', 'У': 'Y', 'Х': 'X', 'а': 'a', 'в': 'b', 'е': 'e', 'к': 'k', 'м': 'm', 'н': 'h',
+	'о': 'o', 'р': 'p', 'с': 'c', 'т': 't', 'у': 'y', 'х': 'x',
+}
+
+// AnswerNormalization describes how free-text answers are brought to a comparable form.
+type AnswerNormalization struct {
+	FoldCase       bool
+	TrimSpace      bool
+	FoldLookalikes bool
+	Pattern        string
+}
+
+func DefaultAnswerNormalization() AnswerNormalization {
+	return AnswerNormalization{
+		FoldCase:  true,
+		TrimSpace: true,
+	}
+}
+
+// Normalize brings an answer or an accepted answer to the comparable form.
+func (n AnswerNormalization) Normalize(answer string) string {
+	answer = n.foldRunes(answer)
+
+	if n.TrimSpace {
+		answer = strings.Join(strings.Fields(answer), " ")
+	}
+
+	if n.FoldCase {
+		answer = strings.ToLower(answer)
+	}
+
+	return answer
+}
+
+// foldRunes applies the rune-level part of the normalization: NFKC always, so full-width and
+// composed forms compare equal, and look-alike folding when it is enabled. It is shared by answers
+// and the pattern, so both end up in the same alphabet.
+func (n AnswerNormalization) foldRunes(s string) string {
+	s = norm.NFKC.String(s)
+	if !n.FoldLookalikes {
+		return s
+	}
+
+	return strings.Map(func(r rune) rune {
+		if latin, ok := lookalikes[r]; ok {
+			return latin
+		}
+		return r
+	}, s)
+}
@@ -0,0 +1,167 @@
+package entities
+
+import (
+	"slices"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	DefaultMaxAttempts = 1
+	// DefaultTimezoneChangeInterval lets the user change own timezone once in 30 days.
+	DefaultTimezoneChangeInterval = 30 * 24 * time.Hour
+)
+
+// Attempt is the completed exam session counted by the attempt policy.
+type Attempt struct {
+	Topics      []string
+	CompletedAt time.Time
+	Outcome     Outcome
+}
+
+// AttemptGrant is extra attempts of the topic a mentor gives to the student. The attempts are
+// added to the limit of the window the grant was made in.
+type AttemptGrant struct {
+	Topic     string
+	Attempts  int
+	GrantedBy string
+	GrantedAt time.Time
+}
+
+func (g AttemptGrant) Validate() error {
+	if g.Topic == "" {
+		return errors.Wrap(ErrInvalidParam, "grant topic is empty")
+	}
+
+	if g.Attempts <= 0 {
+		return errors.Wrap(ErrInvalidParam, "granted attempts must be a positive number")
+	}
+
+	return nil
+}
+
+// AttemptPolicy limits how often the student may start exam sessions of a topic.
+type AttemptPolicy struct {
+	// MaxAttempts is the number of attempts of a topic per window, 0 means unlimited.
+	MaxAttempts int
+	// Window is the rolling window attempts are counted in. Zero window is the calendar day
+	// in the timezone of the user.
+	Window time.Duration
+	// Cooldown is the time the student waits after a failed or expired attempt of a topic.
+	Cooldown time.Duration
+	// UnlimitedAfterPass lifts the limits of a topic once the student passed it.
+	UnlimitedAfterPass bool
+	// TimezoneChangeInterval is the time the user waits between changes of own timezone:
+	// moving the timezone shifts the calendar day window and would open a new attempt. Zero
+	// means no limit. Mentors and admins change timezones of students without the limit.
+	TimezoneChangeInterval time.Duration
+}
+
+// DefaultAttemptPolicy allows one attempt of a topic per calendar day.
+func DefaultAttemptPolicy() AttemptPolicy {
+	return AttemptPolicy{
+		MaxAttempts:            DefaultMaxAttempts,
+		TimezoneChangeInterval: DefaultTimezoneChangeInterval,
+	}
+}
+
+func (p AttemptPolicy) Validate() error {
+	if p.MaxAttempts < 0 {
+		return errors.Wrap(ErrInvalidParam, "max attempts must not be negative")
+	}
+
+	if p.Window < 0 {
+		return errors.Wrap(ErrInvalidParam, "attempts window must not be negative")
+	}
+
+	if p.Cooldown < 0 {
+		return errors.Wrap(ErrInvalidParam, "attempts cooldown must not be negative")
+	}
+
+	if p.TimezoneChangeInterval < 0 {
+		return errors.Wrap(ErrInvalidParam, "timezone change interval must not be negative")
+	}
+
+	return nil
+}
+
+// WindowStart returns beginning of the window which ends at now.
+func (p AttemptPolicy) WindowStart(now time.Time, location *time.Location) time.Time {
+	if p.Window > 0 {
+		return now.Add(-p.Window)
+	}
+
+	if location == nil {
+		location = time.UTC
+	}
+
+	local := now.In(location)
+	return time.Date(local.Year(), local.Month(), local.Day(), 0, 0, 0, 0, location)
+}
+
+// Check returns ErrForbidden if the student who made the attempts and got the grants may not
+// start a new attempt of the topics at the moment.
+func (p AttemptPolicy) Check(topics []string, attempts []Attempt, grants []AttemptGrant,
+	now time.Time, location *time.Location) error {
+	windowStart := p.WindowStart(now, location)
+
+	for _, topic := range topics {
+		if err := p.checkTopic(topic, attempts, grants, windowStart, now); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (p AttemptPolicy) checkTopic(topic string, attempts []Attempt, grants []AttemptGrant,
+	windowStart time.Time, now time.Time) error {
+	var (
+		last     *Attempt
+		inWindow int
+	)
+
+	for i := range attempts {
+		attempt := &attempts[i]
+		if !slices.Contains(attempt.Topics, topic) {
+			continue
+		}
+
+		if p.UnlimitedAfterPass && attempt.Outcome == OutcomePassed {
+			return nil
+		}
+
+		if last == nil || attempt.CompletedAt.After(last.CompletedAt) {
+			last = attempt
+		}
+
+		if !attempt.CompletedAt.Before(windowStart) {
+			inWindow++
+		}
+	}
+
+	if last != nil && last.Outcome != OutcomePassed && p.Cooldown > 0 {
+		if availableAt := last.CompletedAt.Add(p.Cooldown); now.Before(availableAt) {
+			return errors.Wrapf(ErrForbidden, "topic %s is available after %s", topic,
+				availableAt.Format(time.RFC3339))
+		}
+	}
+
+	if p.MaxAttempts == 0 {
+		return nil
+	}
+
+	limit := p.MaxAttempts
+	for _, grant := range grants {
+		if grant.Topic == topic && !grant.GrantedAt.Before(windowStart) {
+			limit += grant.Attempts
+		}
+	}
+
+	if inWindow >= limit {
+		return errors.Wrapf(ErrForbidden, "attempts limit of topic %s reached", topic)
+	}
+
+	return nil
+}
@@ -0,0 +1,203 @@
+package entities_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/parta4ok/kvs/question/internal/entities"
+)
+
+func TestAttemptPolicy_Validate(t *testing.T) {
+	t.Parallel()
+
+	require.NoError(t, entities.DefaultAttemptPolicy().Validate())
+	require.NoError(t, entities.AttemptPolicy{}.Validate())
+
+	require.ErrorIs(t, entities.AttemptPolicy{MaxAttempts: -1}.Validate(),
+		entities.ErrInvalidParam)
+	require.ErrorIs(t, entities.AttemptPolicy{Window: -time.Hour}.Validate(),
+		entities.ErrInvalidParam)
+	require.ErrorIs(t, entities.AttemptPolicy{Cooldown: -time.Hour}.Validate(),
+		entities.ErrInvalidParam)
+	require.ErrorIs(t, entities.AttemptPolicy{TimezoneChangeInterval: -time.Hour}.Validate(),
+		entities.ErrInvalidParam)
+}
+
+func TestAttemptGrant_Validate(t *testing.T) {
+	t.Parallel()
+
+	require.NoError(t, entities.AttemptGrant{Topic: "Go", Attempts: 1}.Validate())
+	require.ErrorIs(t, entities.AttemptGrant{Attempts: 1}.Validate(), entities.ErrInvalidParam)
+	require.ErrorIs(t, entities.AttemptGrant{Topic: "Go"}.Validate(), entities.ErrInvalidParam)
+}
+
+func TestAttemptPolicy_WindowStart(t *testing.T) {
+	t.Parallel()
+
+	location, err := time.LoadLocation("Asia/Tokyo")
+	require.NoError(t, err)
+
+	// 2025-09-01 20:00 UTC is 2025-09-02 05:00 in Tokyo
+	now := time.Date(2025, 9, 1, 20, 0, 0, 0, time.UTC)
+
+	calendarDay := entities.DefaultAttemptPolicy()
+	require.True(t, time.Date(2025, 9, 1, 0, 0, 0, 0, time.UTC).Equal(
+		calendarDay.WindowStart(now, nil)))
+	require.True(t, time.Date(2025, 9, 1, 15, 0, 0, 0, time.UTC).Equal(
+		calendarDay.WindowStart(now, location)))
+
+	rolling := entities.AttemptPolicy{MaxAttempts: 1, Window: 6 * time.Hour}
+	require.True(t, now.Add(-6*time.Hour).Equal(rolling.WindowStart(now, location)))
+}
+
+func TestAttemptPolicy_Check(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2025, 9, 1, 20, 0, 0, 0, time.UTC)
+
+	attempt := func(ago time.Duration, outcome entities.Outcome) entities.Attempt {
+		return entities.Attempt{
+			Topics:      []string{"Go"},
+			CompletedAt: now.Add(-ago),
+			Outcome:     outcome,
+		}
+	}
+
+	testCases := []struct {
+		name          string
+		policy        entities.AttemptPolicy
+		attempts      []entities.Attempt
+		grants        []entities.AttemptGrant
+		location      string
+		expectedError error
+	}{
+		{
+			name:   "no_attempts",
+			policy: entities.DefaultAttemptPolicy(),
+		},
+		{
+			name:          "daily_limit_reached",
+			policy:        entities.DefaultAttemptPolicy(),
+			attempts:      []entities.Attempt{attempt(time.Hour, entities.OutcomeFailed)},
+			expectedError: entities.ErrForbidden,
+		},
+		{
+			name:     "attempt_of_yesterday",
+			policy:   entities.DefaultAttemptPolicy(),
+			attempts: []entities.Attempt{attempt(21*time.Hour, entities.OutcomeFailed)},
+		},
+		{
+			name:   "attempt_of_other_topic",
+			policy: entities.DefaultAttemptPolicy(),
+			attempts: []entities.Attempt{{
+				Topics:      []string{"SQL"},
+				CompletedAt: now.Add(-time.Hour),
+				Outcome:     entities.OutcomeFailed,
+			}},
+		},
+		{
+			// 2025-09-01 20:00 UTC is already the next day in Tokyo
+			name:     "next_day_in_user_timezone",
+			policy:   entities.DefaultAttemptPolicy(),
+			attempts: []entities.Attempt{attempt(6*time.Hour, entities.OutcomeFailed)},
+			location: "Asia/Tokyo",
+		},
+		{
+			name:   "attempts_left_in_rolling_window",
+			policy: entities.AttemptPolicy{MaxAttempts: 3, Window: 24 * time.Hour},
+			attempts: []entities.Attempt{
+				attempt(30*time.Hour, entities.OutcomeFailed),
+				attempt(10*time.Hour, entities.OutcomeFailed),
+				attempt(5*time.Hour, entities.OutcomeFailed),
+			},
+		},
+		{
+			name:   "rolling_window_limit_reached",
+			policy: entities.AttemptPolicy{MaxAttempts: 2, Window: 24 * time.Hour},
+			attempts: []entities.Attempt{
+				attempt(10*time.Hour, entities.OutcomeFailed),
+				attempt(5*time.Hour, entities.OutcomeFailed),
+			},
+			expectedError: entities.ErrForbidden,
+		},
+		{
+			name:     "unlimited",
+			policy:   entities.AttemptPolicy{},
+			attempts: []entities.Attempt{attempt(time.Hour, entities.OutcomeFailed)},
+		},
+		{
+			name:          "cooldown_not_passed",
+			policy:        entities.AttemptPolicy{Cooldown: 2 * time.Hour},
+			attempts:      []entities.Attempt{attempt(time.Hour, entities.OutcomeFailed)},
+			expectedError: entities.ErrForbidden,
+		},
+		{
+			name:     "cooldown_passed",
+			policy:   entities.AttemptPolicy{Cooldown: 2 * time.Hour},
+			attempts: []entities.Attempt{attempt(3*time.Hour, entities.OutcomeExpired)},
+		},
+		{
+			name:     "no_cooldown_after_pass",
+			policy:   entities.AttemptPolicy{Cooldown: 2 * time.Hour},
+			attempts: []entities.Attempt{attempt(time.Hour, entities.OutcomePassed)},
+		},
+		{
+			name: "unlimited_after_pass",
+			policy: entities.AttemptPolicy{MaxAttempts: 1, Cooldown: time.Hour,
+				UnlimitedAfterPass: true},
+			attempts: []entities.Attempt{
+				attempt(3*time.Hour, entities.OutcomePassed),
+				attempt(time.Minute, entities.OutcomeFailed),
+			},
+		},
+		{
+			name:     "granted_attempt",
+			policy:   entities.DefaultAttemptPolicy(),
+			attempts: []entities.Attempt{attempt(time.Hour, entities.OutcomeFailed)},
+			grants: []entities.AttemptGrant{
+				{Topic: "Go", Attempts: 1, GrantedBy: "2", GrantedAt: now.Add(-time.Minute)},
+			},
+		},
+		{
+			name:     "grant_of_previous_window",
+			policy:   entities.DefaultAttemptPolicy(),
+			attempts: []entities.Attempt{attempt(time.Hour, entities.OutcomeFailed)},
+			grants: []entities.AttemptGrant{
+				{Topic: "Go", Attempts: 1, GrantedBy: "2", GrantedAt: now.Add(-21 * time.Hour)},
+			},
+			expectedError: entities.ErrForbidden,
+		},
+		{
+			name:     "grant_of_other_topic",
+			policy:   entities.DefaultAttemptPolicy(),
+			attempts: []entities.Attempt{attempt(time.Hour, entities.OutcomeFailed)},
+			grants: []entities.AttemptGrant{
+				{Topic: "SQL", Attempts: 1, GrantedBy: "2", GrantedAt: now.Add(-time.Minute)},
+			},
+			expectedError: entities.ErrForbidden,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			location := time.UTC
+			if tc.location != "" {
+				var err error
+				location, err = time.LoadLocation(tc.location)
+				require.NoError(t, err)
+			}
+
+			err := tc.policy.Check([]string{"Go"}, tc.attempts, tc.grants, now, location)
+			if tc.expectedError != nil {
+				require.ErrorIs(t, err, tc.expectedError)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,11 @@
+package entities
+
+import "time"
+
+// BackfillCursor is the position of the completed session in the order backfills walk through
+// completed sessions. Backfills continue after the last returned position, so sessions failed
+// to backfill are not selected again in the same run.
+type BackfillCursor struct {
+	CompletedAt time.Time
+	SessionID   string
+}
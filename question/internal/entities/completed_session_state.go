@@ -1,8 +1,8 @@
 package entities
 
 import (
-	"context"
 	"fmt"
+	"maps"
 	"time"
 
 	"github.com/pkg/errors"
@@ -13,15 +13,27 @@ var (
 )
 
 const (
+	// DefaultBorderResult is the pass threshold of topics without own threshold.
 	DefaultBorderResult = 60.0
 )
 
+// Outcome is the final state of the completed session.
+type Outcome string
+
+const (
+	OutcomePassed  Outcome = "passed"
+	OutcomeFailed  Outcome = "failed"
+	OutcomeExpired Outcome = "expired"
+)
+
 type CompletedSessionState struct {
 	questions map[string]Question
 	answers   []*UserAnswer
 	holder    StateHolder
 	startedAt time.Time
 	isExpired bool
+	duration  time.Duration
+	policy    SessionPolicy
 }
 
 func NewCompletedSessionState(
@@ -30,13 +42,38 @@ func NewCompletedSessionState(
 	answers []*UserAnswer,
 	startedAt time.Time,
 	isExpired bool,
+	opts ...CompletedSessionStateOption,
 ) *CompletedSessionState {
-	return &CompletedSessionState{
+	state := &CompletedSessionState{
 		questions: questions,
 		holder:    holder,
 		answers:   answers,
 		startedAt: startedAt,
 		isExpired: isExpired,
+		policy:    DefaultSessionPolicy(),
+	}
+
+	for _, opt := range opts {
+		opt(state)
+	}
+	state.questions = state.policy.Shuffle.ShuffleVariants(state.questions)
+
+	return state
+}
+
+type CompletedSessionStateOption func(*CompletedSessionState)
+
+func WithCompletedStatePolicy(policy SessionPolicy) CompletedSessionStateOption {
+	return func(state *CompletedSessionState) {
+		state.policy = policy
+	}
+}
+
+// WithCompletedDurationLimit keeps duration limit the session was active with. Sessions
+// completed before the limit was stored have no limit.
+func WithCompletedDurationLimit(duration time.Duration) CompletedSessionStateOption {
+	return func(state *CompletedSessionState) {
+		state.duration = duration
 	}
 }
 
@@ -56,8 +93,13 @@ func (state *CompletedSessionState) SetUserAnswer(_ []*UserAnswer) error {
 func (state *CompletedSessionState) GetSessionResult() (*SessionResult, error) {
 	if state.isExpired {
 		return &SessionResult{
-			IsSuccess: false,
-			Grade:     "session expired",
+			IsExpire:      true,
+			IsSuccess:     false,
+			Grade:         "session expired",
+			TotalCount:    len(state.questions),
+			Outcome:       OutcomeExpired,
+			PassThreshold: state.policy.PassThreshold.Resolve(state.questions),
+			TopicScores:   scoreByTopics(state.questions, nil),
 		}, nil
 	}
 
@@ -65,7 +107,16 @@ func (state *CompletedSessionState) GetSessionResult() (*SessionResult, error) {
 }
 
 func (state *CompletedSessionState) processingResult() (*SessionResult, error) {
-	var countOfCorrectUserAnswers int
+	var (
+		earned, total float64
+		correct       int
+	)
+
+	credits := make(map[string]float64, len(state.answers))
+
+	for _, question := range state.questions {
+		total += weightOf(question)
+	}
 
 	for _, userAnswer := range state.answers {
 		question, ok := state.questions[userAnswer.questionID]
@@ -73,12 +124,19 @@ func (state *CompletedSessionState) processingResult() (*SessionResult, error) {
 			return nil, errors.Wrapf(ErrInvalidParam,
 				"user anwer has invalid question id: %s", userAnswer.questionID)
 		}
-		if state.isAnswerCorrect(question, userAnswer) {
-			countOfCorrectUserAnswers++
+		credit := state.policy.Scoring.Score(question, userAnswer)
+		credits[userAnswer.questionID] = credit
+		if credit >= 1 {
+			correct++
 		}
+		earned += weightOf(question) * credit
 	}
 
-	percent := float64(countOfCorrectUserAnswers) / float64(len(state.questions)) * 100
+	var percent float64
+	if total > 0 {
+		// negative marking can lead below zero, but grade is never negative
+		percent = max(0, earned/total*100)
+	}
 	usersCorrectAnswersPercent := fmt.Sprintf("%.2f percents", percent)
 
 	var answers = make(map[string][]string, len(state.answers))
@@ -92,20 +150,33 @@ func (state *CompletedSessionState) processingResult() (*SessionResult, error) {
 		questions[question.Subject()] = question.Variants()
 	}
 
+	threshold := state.policy.PassThreshold.Resolve(state.questions)
+
+	outcome := OutcomeFailed
+	if percent >= threshold {
+		outcome = OutcomePassed
+	}
+
 	return &SessionResult{
-		Questions:   questions,
-		UserAnswers: answers,
-		IsExpire:    state.isExpired,
-		IsSuccess:   percent >= DefaultBorderResult,
-		Grade:       usersCorrectAnswersPercent,
+		Questions:     questions,
+		UserAnswers:   answers,
+		IsExpire:      state.isExpired,
+		IsSuccess:     outcome == OutcomePassed,
+		Grade:         usersCorrectAnswersPercent,
+		Score:         percent,
+		CorrectCount:  correct,
+		TotalCount:    len(state.questions),
+		Outcome:       outcome,
+		PassThreshold: threshold,
+		TopicScores:   scoreByTopics(state.questions, credits),
 	}, nil
 }
 
-func (state *CompletedSessionState) isAnswerCorrect(qusetion Question, answer *UserAnswer) bool {
-	return qusetion.IsAnswerCorrect(answer)
-}
-
 func (state *CompletedSessionState) GetSessionDurationLimit() (time.Duration, error) {
+	if state.duration > 0 {
+		return state.duration, nil
+	}
+
 	return time.Duration(0), errors.Wrapf(
 		ErrInvalidState, "%s not support `GetSessionDurationLimit`", state.GetStatus())
 }
@@ -120,7 +191,18 @@ func (state *CompletedSessionState) GetQuestions() ([]Question, error) {
 		questionsList = append(questionsList, question)
 	}
 
-	return questionsList, nil
+	return state.policy.Shuffle.OrderQuestions(questionsList), nil
+}
+
+// withQuestion returns copy of the state with the question replaced by its other version.
+func (state *CompletedSessionState) withQuestion(question Question) *CompletedSessionState {
+	questions := maps.Clone(state.questions)
+	questions[question.ID()] = question
+
+	rescored := *state
+	rescored.questions = state.policy.Shuffle.ShuffleVariants(questions)
+
+	return &rescored
 }
 
 func (state *CompletedSessionState) GetStartedAt() (time.Time, error) {
@@ -131,8 +213,15 @@ func (state *CompletedSessionState) GetUserAnswers() ([]*UserAnswer, error) {
 	return state.answers, nil
 }
 
-func (state *CompletedSessionState) IsDailySessionLimitReached(ctx context.Context, userID string,
-	topics []string) (bool, error) {
-	return false, errors.Wrapf(
-		ErrInvalidState, "%s not support `IsDailySessionLimitReached`", state.GetStatus())
+func (state *CompletedSessionState) Pause(_ time.Time) error {
+	return errors.Wrapf(ErrInvalidState, "%s not support `Pause`", state.GetStatus())
+}
+
+func (state *CompletedSessionState) Resume(_ time.Time) error {
+	return errors.Wrapf(ErrInvalidState, "%s not support `Resume`", state.GetStatus())
+}
+
+func (state *CompletedSessionState) GetClock() (SessionClock, error) {
+	return SessionClock{}, errors.Wrapf(
+		ErrInvalidState, "%s not support `GetClock`", state.GetStatus())
 }
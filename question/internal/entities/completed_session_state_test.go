@@ -1,7 +1,6 @@
 package entities_test
 
 import (
-	"context"
 	"testing"
 	"time"
 
@@ -83,6 +82,7 @@ func TestCompletedSessionState_GetSessionResult_Expired(t *testing.T) {
 	defer ctrl.Finish()
 
 	mockQuestion := testdata.NewMockQuestion(ctrl)
+	mockQuestion.EXPECT().Topic().Return("Go").AnyTimes()
 	questions := map[string]entities.Question{"1": mockQuestion}
 	holder := testdata.NewMockStateHolder(ctrl)
 	answers := []*entities.UserAnswer{}
@@ -97,6 +97,9 @@ func TestCompletedSessionState_GetSessionResult_Expired(t *testing.T) {
 	require.NotNil(t, result)
 	require.False(t, result.IsSuccess)
 	require.Equal(t, "session expired", result.Grade)
+	require.Equal(t, entities.OutcomeExpired, result.Outcome)
+	require.Equal(t, 0, result.CorrectCount)
+	require.Equal(t, 1, result.TotalCount)
 }
 
 func TestCompletedSessionState_GetSessionResult_Success(t *testing.T) {
@@ -106,6 +109,7 @@ func TestCompletedSessionState_GetSessionResult_Success(t *testing.T) {
 	defer ctrl.Finish()
 
 	mockQuestion := testdata.NewMockQuestion(ctrl)
+	mockQuestion.EXPECT().Topic().Return("Go").AnyTimes()
 	mockQuestion.EXPECT().ID().Return("1").AnyTimes()
 	mockQuestion.EXPECT().IsAnswerCorrect(gomock.Any()).Return(true).Times(1)
 	mockQuestion.EXPECT().Subject().Return("Q1?").Times(2)
@@ -126,6 +130,10 @@ func TestCompletedSessionState_GetSessionResult_Success(t *testing.T) {
 	require.NotNil(t, result)
 	require.True(t, result.IsSuccess)
 	require.Equal(t, "100.00 percents", result.Grade)
+	require.Equal(t, entities.OutcomePassed, result.Outcome)
+	require.Equal(t, 100.0, result.Score)
+	require.Equal(t, 1, result.CorrectCount)
+	require.Equal(t, 1, result.TotalCount)
 }
 
 func TestCompletedSessionState_GetSessionResult_Failure(t *testing.T) {
@@ -135,6 +143,7 @@ func TestCompletedSessionState_GetSessionResult_Failure(t *testing.T) {
 	defer ctrl.Finish()
 
 	mockQuestion := testdata.NewMockQuestion(ctrl)
+	mockQuestion.EXPECT().Topic().Return("Go").AnyTimes()
 	mockQuestion.EXPECT().ID().Return("1").AnyTimes()
 	mockQuestion.EXPECT().IsAnswerCorrect(gomock.Any()).Return(false).Times(1)
 	mockQuestion.EXPECT().Subject().Return("q1").Times(2)
@@ -156,6 +165,9 @@ func TestCompletedSessionState_GetSessionResult_Failure(t *testing.T) {
 	require.NotNil(t, result)
 	require.False(t, result.IsSuccess)
 	require.Equal(t, "0.00 percents", result.Grade)
+	require.Equal(t, entities.OutcomeFailed, result.Outcome)
+	require.Equal(t, 0, result.CorrectCount)
+	require.Equal(t, 1, result.TotalCount)
 }
 
 func TestCompletedSessionState_GetSessionDurationLimit(t *testing.T) {
@@ -264,7 +276,7 @@ func TestCompletedSessionState_GetUserAnswers(t *testing.T) {
 	require.Equal(t, userAnswer, result[0])
 }
 
-func TestCompletedSessionState_IsDailySessionLimitReached(t *testing.T) {
+func TestCompletedSessionState_GetSessionResult_InvalidQuestionType(t *testing.T) {
 	t.Parallel()
 
 	ctrl := gomock.NewController(t)
@@ -273,36 +285,107 @@ func TestCompletedSessionState_IsDailySessionLimitReached(t *testing.T) {
 	mockQuestion := testdata.NewMockQuestion(ctrl)
 	questions := map[string]entities.Question{"1": mockQuestion}
 	holder := testdata.NewMockStateHolder(ctrl)
-	answers := []*entities.UserAnswer{}
+	answer, err := entities.NewUserAnswer("2", []string{"answer"})
+	require.NoError(t, err)
+
+	answers := []*entities.UserAnswer{answer}
 	startedAt := time.Now()
 
 	state := entities.NewCompletedSessionState(questions, holder, answers, startedAt, false)
 
-	result, err := state.IsDailySessionLimitReached(context.TODO(), "1", []string{"topic"})
-
-	require.Error(t, err)
-	require.False(t, result)
-	require.Contains(t, err.Error(), "not support `IsDailySessionLimitReached`")
+	result, err := state.GetSessionResult()
+	require.ErrorIs(t, err, entities.ErrInvalidParam)
+	require.Nil(t, result)
 }
 
-func TestCompletedSessionState_GetSessionResult_InvalidQuestionType(t *testing.T) {
+func TestCompletedSessionState_GetSessionResult_PartialCredit(t *testing.T) {
 	t.Parallel()
 
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 
-	mockQuestion := testdata.NewMockQuestion(ctrl)
-	questions := map[string]entities.Question{"1": mockQuestion}
+	ordering := entities.NewOrderingQuestion("1", "Go", "Порядок выполнения defer",
+		[]string{"defer 3", "defer 1", "defer 2"}, []string{"defer 3", "defer 2", "defer 1"},
+		true)
+	trueOrFalse := entities.NewTrueOrFalseSelectionQuestion("2", "Go",
+		"defer выполняется в порядке LIFO", true)
+
+	questions := map[string]entities.Question{"1": ordering, "2": trueOrFalse}
 	holder := testdata.NewMockStateHolder(ctrl)
-	answer, err := entities.NewUserAnswer("2", []string{"answer"})
-	require.NoError(t, err)
 
-	answers := []*entities.UserAnswer{answer}
-	startedAt := time.Now()
+	orderingAnswer, err := entities.NewUserAnswer("1", []string{"defer 2", "defer 3", "defer 1"})
+	require.NoError(t, err)
+	trueOrFalseAnswer, err := entities.NewUserAnswer("2", []string{"true"})
+	require.NoError(t, err)
 
-	state := entities.NewCompletedSessionState(questions, holder, answers, startedAt, false)
+	state := entities.NewCompletedSessionState(questions, holder,
+		[]*entities.UserAnswer{orderingAnswer, trueOrFalseAnswer}, time.Now(), false,
+		entities.WithCompletedStatePolicy(entities.SessionPolicy{
+			Scoring: &entities.PartialCreditStrategy{}}))
 
 	result, err := state.GetSessionResult()
-	require.ErrorIs(t, err, entities.ErrInvalidParam)
-	require.Nil(t, result)
+
+	require.NoError(t, err)
+	require.True(t, result.IsSuccess)
+	require.Equal(t, "83.33 percents", result.Grade)
+	require.Equal(t, 1, result.CorrectCount)
+	require.Equal(t, 2, result.TotalCount)
+}
+
+func TestCompletedSessionState_GetSessionResult_TopicScores(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	questions := map[string]entities.Question{
+		"1": entities.NewTrueOrFalseSelectionQuestion("1", "Go", "nil map можно читать", true),
+		"2": entities.NewTrueOrFalseSelectionQuestion("2", "Go", "nil map можно писать", false),
+		"3": entities.NewTrueOrFalseSelectionQuestion("3", "Базы данных",
+			"PRIMARY KEY допускает NULL", false),
+	}
+	holder := testdata.NewMockStateHolder(ctrl)
+
+	first, err := entities.NewUserAnswer("1", []string{"true"})
+	require.NoError(t, err)
+	second, err := entities.NewUserAnswer("2", []string{"true"})
+	require.NoError(t, err)
+	third, err := entities.NewUserAnswer("3", []string{"false"})
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name      string
+		isExpired bool
+		expected  []entities.TopicScore
+	}{
+		{
+			name: "completed",
+			expected: []entities.TopicScore{
+				{Topic: "Go", Score: 50, CorrectCount: 1, TotalCount: 2},
+				{Topic: "Базы данных", Score: 100, CorrectCount: 1, TotalCount: 1},
+			},
+		},
+		{
+			name:      "expired",
+			isExpired: true,
+			expected: []entities.TopicScore{
+				{Topic: "Go", Score: 0, CorrectCount: 0, TotalCount: 2},
+				{Topic: "Базы данных", Score: 0, CorrectCount: 0, TotalCount: 1},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			state := entities.NewCompletedSessionState(questions, holder,
+				[]*entities.UserAnswer{first, second, third}, time.Now(), tc.isExpired)
+
+			result, err := state.GetSessionResult()
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, result.TopicScores)
+		})
+	}
 }
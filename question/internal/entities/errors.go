@@ -11,4 +11,5 @@ var (
 	ErrInternal            = errors.New("internal error")
 	ErrForbidden           = errors.New("forbidden")
 	ErrNotFound            = errors.New("not found")
+	ErrConflict            = errors.New("conflict")
 )
@@ -1,7 +1,6 @@
 package entities
 
 import (
-	"context"
 	"time"
 
 	"github.com/pkg/errors"
@@ -16,15 +15,29 @@ var (
 )
 
 type InitSessionState struct {
-	stateHolder    StateHolder
-	sessionStorage SessionStorage
+	stateHolder StateHolder
+	policy      SessionPolicy
 }
 
-func NewInitSessionState(stateHolder StateHolder, sessionStorage SessionStorage) *InitSessionState {
+func NewInitSessionState(stateHolder StateHolder,
+	opts ...InitSessionStateOption) *InitSessionState {
+	state := &InitSessionState{
+		stateHolder: stateHolder,
+		policy:      DefaultSessionPolicy(),
+	}
+
+	for _, opt := range opts {
+		opt(state)
+	}
+
+	return state
+}
 
-	return &InitSessionState{
-		stateHolder:    stateHolder,
-		sessionStorage: sessionStorage,
+type InitSessionStateOption func(*InitSessionState)
+
+func WithInitStatePolicy(policy SessionPolicy) InitSessionStateOption {
+	return func(state *InitSessionState) {
+		state.policy = policy
 	}
 }
 
@@ -38,7 +51,8 @@ func (state *InitSessionState) SetQuestions(qestions map[string]Question,
 		return errors.Wrap(ErrInvalidParam, "questions for selected topics not changed")
 	}
 
-	activeState := NewActiveSessionState(qestions, state.stateHolder, duration)
+	activeState := NewActiveSessionState(qestions, state.stateHolder, duration,
+		WithActiveStatePolicy(state.policy))
 	state.stateHolder.ChangeState(activeState)
 
 	return nil
@@ -77,7 +91,15 @@ func (state *InitSessionState) GetUserAnswers() ([]*UserAnswer, error) {
 		ErrInvalidState, "%s not support `GetUserAnswers`", state.GetStatus())
 }
 
-func (state *InitSessionState) IsDailySessionLimitReached(ctx context.Context,
-	userID string, topics []string) (bool, error) {
-	return state.sessionStorage.IsDailySessionLimitReached(ctx, userID, topics)
+func (state *InitSessionState) Pause(_ time.Time) error {
+	return errors.Wrapf(ErrInvalidState, "%s not support `Pause`", state.GetStatus())
+}
+
+func (state *InitSessionState) Resume(_ time.Time) error {
+	return errors.Wrapf(ErrInvalidState, "%s not support `Resume`", state.GetStatus())
+}
+
+func (state *InitSessionState) GetClock() (SessionClock, error) {
+	return SessionClock{}, errors.Wrapf(
+		ErrInvalidState, "%s not support `GetClock`", state.GetStatus())
 }
@@ -1,7 +1,6 @@
 package entities_test
 
 import (
-	"context"
 	"testing"
 	"time"
 
@@ -19,9 +18,8 @@ func TestNewInitSessionState(t *testing.T) {
 	defer ctrl.Finish()
 
 	holder := testdata.NewMockStateHolder(ctrl)
-	storage := testdata.NewMockSessionStorage(ctrl)
 
-	state := entities.NewInitSessionState(holder, storage)
+	state := entities.NewInitSessionState(holder)
 
 	require.NotNil(t, state)
 	require.Equal(t, entities.InitState, state.GetStatus())
@@ -34,12 +32,11 @@ func TestInitSessionState_SetQuestions_Success(t *testing.T) {
 	defer ctrl.Finish()
 
 	holder := testdata.NewMockStateHolder(ctrl)
-	storage := testdata.NewMockSessionStorage(ctrl)
 	mockQuestion := testdata.NewMockQuestion(ctrl)
 	questions := map[string]entities.Question{"1": mockQuestion}
 	duration := time.Minute * 5
 
-	state := entities.NewInitSessionState(holder, storage)
+	state := entities.NewInitSessionState(holder)
 
 	holder.EXPECT().ChangeState(gomock.Any()).Times(1)
 
@@ -55,11 +52,10 @@ func TestInitSessionState_SetQuestions_EmptyQuestions(t *testing.T) {
 	defer ctrl.Finish()
 
 	holder := testdata.NewMockStateHolder(ctrl)
-	storage := testdata.NewMockSessionStorage(ctrl)
 	questions := map[string]entities.Question{}
 	duration := time.Minute * 5
 
-	state := entities.NewInitSessionState(holder, storage)
+	state := entities.NewInitSessionState(holder)
 
 	err := state.SetQuestions(questions, duration)
 
@@ -74,9 +70,8 @@ func TestInitSessionState_SetUserAnswer(t *testing.T) {
 	defer ctrl.Finish()
 
 	holder := testdata.NewMockStateHolder(ctrl)
-	storage := testdata.NewMockSessionStorage(ctrl)
 
-	state := entities.NewInitSessionState(holder, storage)
+	state := entities.NewInitSessionState(holder)
 
 	userAnswer, err := entities.NewUserAnswer("1", []string{"answer"})
 	require.NoError(t, err)
@@ -94,9 +89,8 @@ func TestInitSessionState_GetSessionResult(t *testing.T) {
 	defer ctrl.Finish()
 
 	holder := testdata.NewMockStateHolder(ctrl)
-	storage := testdata.NewMockSessionStorage(ctrl)
 
-	state := entities.NewInitSessionState(holder, storage)
+	state := entities.NewInitSessionState(holder)
 
 	result, err := state.GetSessionResult()
 
@@ -112,9 +106,8 @@ func TestInitSessionState_GetSessionDurationLimit(t *testing.T) {
 	defer ctrl.Finish()
 
 	holder := testdata.NewMockStateHolder(ctrl)
-	storage := testdata.NewMockSessionStorage(ctrl)
 
-	state := entities.NewInitSessionState(holder, storage)
+	state := entities.NewInitSessionState(holder)
 
 	result, err := state.GetSessionDurationLimit()
 
@@ -130,9 +123,8 @@ func TestInitSessionState_IsExpired(t *testing.T) {
 	defer ctrl.Finish()
 
 	holder := testdata.NewMockStateHolder(ctrl)
-	storage := testdata.NewMockSessionStorage(ctrl)
 
-	state := entities.NewInitSessionState(holder, storage)
+	state := entities.NewInitSessionState(holder)
 
 	isExpired, err := state.IsExpired()
 
@@ -148,9 +140,8 @@ func TestInitSessionState_GetQuestions(t *testing.T) {
 	defer ctrl.Finish()
 
 	holder := testdata.NewMockStateHolder(ctrl)
-	storage := testdata.NewMockSessionStorage(ctrl)
 
-	state := entities.NewInitSessionState(holder, storage)
+	state := entities.NewInitSessionState(holder)
 
 	result, err := state.GetQuestions()
 
@@ -166,9 +157,8 @@ func TestInitSessionState_GetStartedAt(t *testing.T) {
 	defer ctrl.Finish()
 
 	holder := testdata.NewMockStateHolder(ctrl)
-	storage := testdata.NewMockSessionStorage(ctrl)
 
-	state := entities.NewInitSessionState(holder, storage)
+	state := entities.NewInitSessionState(holder)
 
 	result, err := state.GetStartedAt()
 
@@ -184,9 +174,8 @@ func TestInitSessionState_GetUserAnswers(t *testing.T) {
 	defer ctrl.Finish()
 
 	holder := testdata.NewMockStateHolder(ctrl)
-	storage := testdata.NewMockSessionStorage(ctrl)
 
-	state := entities.NewInitSessionState(holder, storage)
+	state := entities.NewInitSessionState(holder)
 
 	result, err := state.GetUserAnswers()
 
@@ -194,47 +183,3 @@ func TestInitSessionState_GetUserAnswers(t *testing.T) {
 	require.Nil(t, result)
 	require.Contains(t, err.Error(), "not support `GetUserAnswers`")
 }
-
-func TestInitSessionState_IsDailySessionLimitReached_Success(t *testing.T) {
-	t.Parallel()
-
-	ctrl := gomock.NewController(t)
-	defer ctrl.Finish()
-
-	holder := testdata.NewMockStateHolder(ctrl)
-	storage := testdata.NewMockSessionStorage(ctrl)
-	ctx := context.Background()
-	userID := "1"
-	topics := []string{"topic1"}
-
-	state := entities.NewInitSessionState(holder, storage)
-
-	storage.EXPECT().IsDailySessionLimitReached(ctx, userID, topics).Return(false, nil).Times(1)
-
-	result, err := state.IsDailySessionLimitReached(ctx, userID, topics)
-
-	require.NoError(t, err)
-	require.False(t, result)
-}
-
-func TestInitSessionState_IsDailySessionLimitReached_LimitReached(t *testing.T) {
-	t.Parallel()
-
-	ctrl := gomock.NewController(t)
-	defer ctrl.Finish()
-
-	holder := testdata.NewMockStateHolder(ctrl)
-	storage := testdata.NewMockSessionStorage(ctrl)
-	ctx := context.Background()
-	userID := "1"
-	topics := []string{"topic1"}
-
-	state := entities.NewInitSessionState(holder, storage)
-
-	storage.EXPECT().IsDailySessionLimitReached(ctx, userID, topics).Return(true, nil).Times(1)
-
-	result, err := state.IsDailySessionLimitReached(ctx, userID, topics)
-
-	require.NoError(t, err)
-	require.True(t, result)
-}
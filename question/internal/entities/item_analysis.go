@@ -0,0 +1,249 @@
+package entities
+
+import (
+	"cmp"
+	"math"
+	"slices"
+	"strings"
+	"time"
+)
+
+const (
+	// ItemGroupShare is the share of responses with the highest and the lowest session scores
+	// compared by the discrimination index.
+	ItemGroupShare = 0.27
+	// ItemMinResponses is the number of responses from which difficulty and discrimination of
+	// the question are flagged.
+	ItemMinResponses = 10
+	// ItemTooEasyThreshold is the difficulty index in percents from which the question is too
+	// easy.
+	ItemTooEasyThreshold = 90.0
+	// ItemTooHardThreshold is the difficulty index in percents up to which the question is too
+	// hard.
+	ItemTooHardThreshold = 20.0
+	// ItemLowDiscriminationThreshold is the discrimination index below which the question does
+	// not separate strong students from weak ones.
+	ItemLowDiscriminationThreshold = 0.2
+)
+
+// ItemFlag marks a question a mentor should look at.
+type ItemFlag string
+
+const (
+	// ItemNeverCorrect marks questions nobody answered correctly, often their correct answers
+	// are wrong.
+	ItemNeverCorrect      ItemFlag = "never_correct"
+	ItemTooEasy           ItemFlag = "too_easy"
+	ItemTooHard           ItemFlag = "too_hard"
+	ItemLowDiscrimination ItemFlag = "low_discrimination"
+)
+
+// ExamResponses keeps answers of one completed exam session.
+type ExamResponses struct {
+	SessionID string
+	// Score is the session score in percents.
+	Score       float64
+	QuestionIDs []string
+	Answers     []*UserAnswer
+}
+
+// DistractorStats counts selections of one variant of the question.
+type DistractorStats struct {
+	Variant    string
+	IsCorrect  bool
+	Selections int
+	// Share is the share of responses which selected the variant in percents.
+	Share float64
+}
+
+// ItemStatistics keeps classic item analysis of the question.
+type ItemStatistics struct {
+	QuestionID string
+	Topic      string
+	Subject    string
+	Type       QuestionType
+	// Responses counts exam sessions with the question, Omitted counts the ones where it was
+	// not answered. Unanswered questions count as answered wrong.
+	Responses int
+	Correct   int
+	Omitted   int
+	// Difficulty is the difficulty index: share of correct responses in percents.
+	Difficulty float64
+	// Discrimination is the discrimination index from -1 to 1: share of correct responses in
+	// the group with the highest session scores minus the one in the group with the lowest.
+	Discrimination float64
+	// Distractors counts selections of variants, empty for questions whose answer is typed or
+	// arranged.
+	Distractors []DistractorStats
+	Flags       []ItemFlag
+	ComputedAt  time.Time
+}
+
+type itemResponse struct {
+	sessionID string
+	score     float64
+	isCorrect bool
+	answer    *UserAnswer
+}
+
+// AnalyzeItems computes item statistics of the questions from exam responses, responses to
+// other questions are skipped. Statistics follow the order of questions.
+func AnalyzeItems(questions []Question, responses []ExamResponses,
+	computedAt time.Time) []ItemStatistics {
+	byQuestion := make(map[string][]itemResponse, len(questions))
+	known := make(map[string]Question, len(questions))
+	for _, question := range questions {
+		known[question.ID()] = question
+	}
+
+	for _, exam := range responses {
+		answers := make(map[string]*UserAnswer, len(exam.Answers))
+		for _, answer := range exam.Answers {
+			answers[answer.GetQuestionID()] = answer
+		}
+
+		for _, questionID := range exam.QuestionIDs {
+			question, ok := known[questionID]
+			if !ok {
+				continue
+			}
+
+			response := itemResponse{sessionID: exam.SessionID, score: exam.Score}
+			if answer, ok := answers[questionID]; ok {
+				response.answer = answer
+				response.isCorrect = question.IsAnswerCorrect(answer)
+			}
+			byQuestion[questionID] = append(byQuestion[questionID], response)
+		}
+	}
+
+	statistics := make([]ItemStatistics, 0, len(questions))
+	for _, question := range questions {
+		statistics = append(statistics, analyzeItem(question, byQuestion[question.ID()],
+			computedAt))
+	}
+
+	return statistics
+}
+
+func analyzeItem(question Question, responses []itemResponse,
+	computedAt time.Time) ItemStatistics {
+	stats := ItemStatistics{
+		QuestionID: question.ID(),
+		Topic:      question.Topic(),
+		Subject:    question.Subject(),
+		Type:       question.Type(),
+		Responses:  len(responses),
+		ComputedAt: computedAt,
+	}
+
+	for _, response := range responses {
+		if response.isCorrect {
+			stats.Correct++
+		}
+		if response.answer == nil {
+			stats.Omitted++
+		}
+	}
+
+	stats.Difficulty = accuracy(stats.Correct, stats.Responses)
+	stats.Discrimination = discrimination(responses)
+	stats.Distractors = distractors(question, responses)
+	stats.Flags = itemFlags(stats)
+
+	return stats
+}
+
+// discrimination compares responses of sessions with the highest and the lowest scores, ties
+// are broken by session id so the index does not depend on the order of sessions.
+func discrimination(responses []itemResponse) float64 {
+	group := int(math.Round(float64(len(responses)) * ItemGroupShare))
+	if group == 0 || 2*group > len(responses) {
+		return 0
+	}
+
+	sorted := slices.Clone(responses)
+	slices.SortFunc(sorted, func(a, b itemResponse) int {
+		if a.score != b.score {
+			return cmp.Compare(b.score, a.score)
+		}
+		return strings.Compare(a.sessionID, b.sessionID)
+	})
+
+	var upper, lower int
+	for i := range group {
+		if sorted[i].isCorrect {
+			upper++
+		}
+		if sorted[len(sorted)-1-i].isCorrect {
+			lower++
+		}
+	}
+
+	return float64(upper-lower) / float64(group)
+}
+
+func distractors(question Question, responses []itemResponse) []DistractorStats {
+	qt := question.Type()
+	if qt.IsFreeInput() || qt.IsOrdered() || qt == Matching {
+		return nil
+	}
+
+	correct := make(map[string]bool)
+	for _, answer := range question.CorrectAnswers() {
+		correct[strings.ToLower(answer)] = true
+	}
+
+	variants := question.Variants()
+	stats := make([]DistractorStats, 0, len(variants))
+	for _, variant := range variants {
+		stats = append(stats, DistractorStats{
+			Variant:   variant,
+			IsCorrect: correct[strings.ToLower(variant)],
+		})
+	}
+
+	for _, response := range responses {
+		if response.answer == nil {
+			continue
+		}
+
+		for i := range stats {
+			if slices.ContainsFunc(response.answer.GetSelections(), func(selection string) bool {
+				return strings.EqualFold(selection, stats[i].Variant)
+			}) {
+				stats[i].Selections++
+			}
+		}
+	}
+
+	for i := range stats {
+		stats[i].Share = accuracy(stats[i].Selections, len(responses))
+	}
+
+	return stats
+}
+
+func itemFlags(stats ItemStatistics) []ItemFlag {
+	flags := make([]ItemFlag, 0)
+	if stats.Responses > 0 && stats.Correct == 0 {
+		flags = append(flags, ItemNeverCorrect)
+	}
+
+	if stats.Responses < ItemMinResponses {
+		return flags
+	}
+
+	switch {
+	case stats.Difficulty >= ItemTooEasyThreshold:
+		flags = append(flags, ItemTooEasy)
+	case stats.Difficulty <= ItemTooHardThreshold:
+		flags = append(flags, ItemTooHard)
+	}
+
+	if stats.Discrimination < ItemLowDiscriminationThreshold {
+		flags = append(flags, ItemLowDiscrimination)
+	}
+
+	return flags
+}
@@ -0,0 +1,114 @@
+package entities_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/parta4ok/kvs/question/internal/entities"
+)
+
+func TestAnalyzeItems(t *testing.T) {
+	t.Parallel()
+
+	shortAnswer, err := entities.NewShortAnswerQuestion("3", "Go", "Ключевое слово для горутины",
+		[]string{"go"}, entities.AnswerNormalization{FoldCase: true, TrimSpace: true})
+	require.NoError(t, err)
+
+	questions := []entities.Question{
+		entities.NewSingleSelectionQuestion("1", "Go", "Размер int на amd64",
+			[]string{"32", "64", "128"}, "64"),
+		entities.NewTrueOrFalseSelectionQuestion("2", "Go", "nil map можно читать", true),
+		shortAnswer,
+	}
+
+	// sessions are scored from 10 to 100, the stronger half answers the first question right,
+	// the fourth session skips it
+	firstAnswers := []string{"32", "32", "128", "", "32", "64", "64", "64", "64", "64"}
+	responses := make([]entities.ExamResponses, 0, len(firstAnswers))
+	for i, selection := range firstAnswers {
+		exam := entities.ExamResponses{
+			SessionID:   fmt.Sprintf("s%02d", i+1),
+			Score:       float64(10 * (i + 1)),
+			QuestionIDs: []string{"1", "2", "99"},
+			Answers: []*entities.UserAnswer{
+				mustUserAnswer(t, "2", "false"),
+				mustUserAnswer(t, "99", "unknown"),
+			},
+		}
+		if selection != "" {
+			exam.Answers = append(exam.Answers, mustUserAnswer(t, "1", selection))
+		}
+		responses = append(responses, exam)
+	}
+
+	// only the two strongest sessions have the third question
+	responses[8].QuestionIDs = append(responses[8].QuestionIDs, "3")
+	responses[8].Answers = append(responses[8].Answers, mustUserAnswer(t, "3", "goroutine"))
+	responses[9].QuestionIDs = append(responses[9].QuestionIDs, "3")
+	responses[9].Answers = append(responses[9].Answers, mustUserAnswer(t, "3", " Go "))
+
+	computedAt := time.Date(2025, 8, 28, 3, 0, 0, 0, time.UTC)
+	statistics := entities.AnalyzeItems(questions, responses, computedAt)
+	require.Len(t, statistics, 3)
+
+	require.Equal(t, entities.ItemStatistics{
+		QuestionID:     "1",
+		Topic:          "Go",
+		Subject:        "Размер int на amd64",
+		Type:           entities.SingleSelection,
+		Responses:      10,
+		Correct:        5,
+		Omitted:        1,
+		Difficulty:     50,
+		Discrimination: 1,
+		Distractors: []entities.DistractorStats{
+			{Variant: "32", Selections: 3, Share: 30},
+			{Variant: "64", IsCorrect: true, Selections: 5, Share: 50},
+			{Variant: "128", Selections: 1, Share: 10},
+		},
+		Flags:      []entities.ItemFlag{},
+		ComputedAt: computedAt,
+	}, statistics[0])
+
+	require.Equal(t, "2", statistics[1].QuestionID)
+	require.Zero(t, statistics[1].Difficulty)
+	require.Zero(t, statistics[1].Discrimination)
+	require.Equal(t, []entities.DistractorStats{
+		{Variant: "true", IsCorrect: true, Share: 0},
+		{Variant: "false", Selections: 10, Share: 100},
+	}, statistics[1].Distractors)
+	require.Equal(t, []entities.ItemFlag{entities.ItemNeverCorrect, entities.ItemTooHard,
+		entities.ItemLowDiscrimination}, statistics[1].Flags)
+
+	// too few responses to judge difficulty and discrimination, typed answers have no variants
+	require.Equal(t, "3", statistics[2].QuestionID)
+	require.Equal(t, 2, statistics[2].Responses)
+	require.Equal(t, 1, statistics[2].Correct)
+	require.InDelta(t, 1.0, statistics[2].Discrimination, 1e-9)
+	require.Nil(t, statistics[2].Distractors)
+	require.Empty(t, statistics[2].Flags)
+}
+
+func TestAnalyzeItems_NoResponses(t *testing.T) {
+	t.Parallel()
+
+	statistics := entities.AnalyzeItems([]entities.Question{
+		entities.NewTrueOrFalseSelectionQuestion("1", "Go", "nil map можно читать", true),
+	}, nil, time.Now())
+
+	require.Len(t, statistics, 1)
+	require.Zero(t, statistics[0].Responses)
+	require.Zero(t, statistics[0].Difficulty)
+	require.Empty(t, statistics[0].Flags)
+}
+
+func mustUserAnswer(t *testing.T, questionID string, selections ...string) *entities.UserAnswer {
+	t.Helper()
+
+	answer, err := entities.NewUserAnswer(questionID, selections)
+	require.NoError(t, err)
+	return answer
+}
@@ -0,0 +1,117 @@
+package entities
+
+import (
+	"time"
+)
+
+const (
+	// TrendMinSessions is the number of exam sessions from which the score trend is determined.
+	TrendMinSessions = 3
+	// TrendSlopeThreshold is the score change per session in percents from which the score
+	// counts as growing or falling.
+	TrendSlopeThreshold = 1.0
+)
+
+// Trend tells the direction of exam scores of the user.
+type Trend string
+
+const (
+	TrendImproving        Trend = "improving"
+	TrendDeclining        Trend = "declining"
+	TrendStable           Trend = "stable"
+	TrendInsufficientData Trend = "insufficient_data"
+)
+
+// ScoreTrend is the linear regression of scores of the recent exam sessions by their order.
+type ScoreTrend struct {
+	Sessions int
+	// Slope is the score change per session in percents.
+	Slope float64
+}
+
+func (t ScoreTrend) Direction() Trend {
+	switch {
+	case t.Sessions < TrendMinSessions:
+		return TrendInsufficientData
+	case t.Slope >= TrendSlopeThreshold:
+		return TrendImproving
+	case t.Slope <= -TrendSlopeThreshold:
+		return TrendDeclining
+	}
+
+	return TrendStable
+}
+
+// SessionStats summarizes completed sessions of the user. Durations are counted from the start
+// to the completion of sessions which have not expired, pauses included.
+type SessionStats struct {
+	Total           int
+	Exams           int
+	Passed          int
+	Failed          int
+	Expired         int
+	AverageDuration time.Duration
+	MedianDuration  time.Duration
+	MinDuration     time.Duration
+	MaxDuration     time.Duration
+}
+
+// AccuracyPeriod counts answers to questions of the topic given in sessions completed during
+// the period.
+type AccuracyPeriod struct {
+	Start   time.Time
+	Correct int
+	Total   int
+}
+
+// Accuracy returns share of correct answers in percents.
+func (p AccuracyPeriod) Accuracy() float64 {
+	return accuracy(p.Correct, p.Total)
+}
+
+// TopicAccuracy counts answers to questions of the topic, periods are sorted by start.
+type TopicAccuracy struct {
+	Topic   string
+	Correct int
+	Total   int
+	Periods []AccuracyPeriod
+}
+
+// AddPeriod appends the period and adds its answers to the totals of the topic.
+func (a *TopicAccuracy) AddPeriod(period AccuracyPeriod) {
+	a.Periods = append(a.Periods, period)
+	a.Correct += period.Correct
+	a.Total += period.Total
+}
+
+// Accuracy returns share of correct answers in percents.
+func (a TopicAccuracy) Accuracy() float64 {
+	return accuracy(a.Correct, a.Total)
+}
+
+// FailedQuestion counts wrong answers of the user to the question.
+type FailedQuestion struct {
+	QuestionID string
+	Topic      string
+	Subject    string
+	Failures   int
+	Answers    int
+}
+
+// KnowledgeReport aggregates completed sessions of the user.
+type KnowledgeReport struct {
+	UserID          string
+	GeneratedAt     time.Time
+	Sessions        SessionStats
+	Topics          []TopicAccuracy
+	FailedQuestions []FailedQuestion
+	Trend           ScoreTrend
+}
+
+func accuracy(correct int, total int) float64 {
+	if total == 0 {
+		return 0
+	}
+
+	return float64(correct) / float64(total) * 100
+}
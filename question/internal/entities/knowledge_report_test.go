@@ -0,0 +1,66 @@
+package entities_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/parta4ok/kvs/question/internal/entities"
+)
+
+func TestScoreTrend_Direction(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name     string
+		trend    entities.ScoreTrend
+		expected entities.Trend
+	}{
+		{
+			name:     "insufficient_data",
+			trend:    entities.ScoreTrend{Sessions: 2, Slope: 20},
+			expected: entities.TrendInsufficientData,
+		},
+		{
+			name:     "improving",
+			trend:    entities.ScoreTrend{Sessions: 5, Slope: 2.5},
+			expected: entities.TrendImproving,
+		},
+		{
+			name:     "declining",
+			trend:    entities.ScoreTrend{Sessions: 5, Slope: -1},
+			expected: entities.TrendDeclining,
+		},
+		{
+			name:     "stable",
+			trend:    entities.ScoreTrend{Sessions: 3, Slope: 0.5},
+			expected: entities.TrendStable,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			require.Equal(t, tc.expected, tc.trend.Direction())
+		})
+	}
+}
+
+func TestTopicAccuracy_AddPeriod(t *testing.T) {
+	t.Parallel()
+
+	week := time.Date(2025, 8, 25, 0, 0, 0, 0, time.UTC)
+	topic := entities.TopicAccuracy{Topic: "Go"}
+	require.Zero(t, topic.Accuracy())
+
+	topic.AddPeriod(entities.AccuracyPeriod{Start: week, Correct: 1, Total: 4})
+	topic.AddPeriod(entities.AccuracyPeriod{Start: week.AddDate(0, 0, 7), Correct: 5, Total: 6})
+
+	require.Equal(t, 6, topic.Correct)
+	require.Equal(t, 10, topic.Total)
+	require.InDelta(t, 60.0, topic.Accuracy(), 1e-9)
+	require.InDelta(t, 25.0, topic.Periods[0].Accuracy(), 1e-9)
+}
@@ -0,0 +1,170 @@
+package entities
+
+import (
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	// PairSeparator joins left and right items of a pair when the pair is kept as a plain
+	// string, e.g. in kvs.questions.correct_answers or in user answer selections.
+	PairSeparator = " => "
+)
+
+var (
+	_ Question     = (*MatchingQuestion)(nil)
+	_ CreditScorer = (*MatchingQuestion)(nil)
+)
+
+type Pair struct {
+	Left  string
+	Right string
+}
+
+func NewPair(left string, right string) (Pair, error) {
+	left, right = strings.TrimSpace(left), strings.TrimSpace(right)
+	if left == "" || right == "" {
+		return Pair{}, errors.Wrap(ErrInvalidParam, "pair items must not be empty")
+	}
+	if strings.Contains(left, strings.TrimSpace(PairSeparator)) {
+		return Pair{}, errors.Wrapf(ErrInvalidParam, "left item must not contain %q",
+			strings.TrimSpace(PairSeparator))
+	}
+
+	return Pair{Left: left, Right: right}, nil
+}
+
+func ParsePair(encoded string) (Pair, error) {
+	left, right, ok := strings.Cut(encoded, strings.TrimSpace(PairSeparator))
+	if !ok {
+		return Pair{}, errors.Wrapf(ErrInvalidParam, "invalid pair: %s", encoded)
+	}
+
+	return NewPair(left, right)
+}
+
+func (p Pair) String() string {
+	return p.Left + PairSeparator + p.Right
+}
+
+// MatchingQuestion asks the student to match every item of the left column with an item of
+// the right column. The right column may contain distractors which match nothing.
+type MatchingQuestion struct {
+	questionWeight
+	questionExplanation
+	questionDifficulty
+	questionVersion
+
+	id         string
+	topic      string
+	subject    string
+	rightItems []string
+	pairs      []Pair
+}
+
+func NewMatchingQuestion(id string, topic string, subject string, rightItems []string,
+	pairs []Pair) *MatchingQuestion {
+	return &MatchingQuestion{
+		id:         id,
+		topic:      topic,
+		subject:    subject,
+		rightItems: rightItems,
+		pairs:      pairs,
+	}
+}
+
+func (q *MatchingQuestion) ID() string {
+	return q.id
+}
+
+func (q *MatchingQuestion) Type() QuestionType {
+	return Matching
+}
+
+func (q *MatchingQuestion) Topic() string {
+	return q.topic
+}
+
+func (q *MatchingQuestion) Subject() string {
+	return q.subject
+}
+
+// Variants returns the right column, so clients unaware of matching still get the choices.
+func (q *MatchingQuestion) Variants() []string {
+	return q.rightItems
+}
+
+func (q *MatchingQuestion) withVariants(variants []string) Question {
+	clone := *q
+	clone.rightItems = variants
+	return &clone
+}
+
+func (q *MatchingQuestion) LeftItems() []string {
+	items := make([]string, 0, len(q.pairs))
+	for _, pair := range q.pairs {
+		items = append(items, pair.Left)
+	}
+
+	return items
+}
+
+func (q *MatchingQuestion) RightItems() []string {
+	return q.rightItems
+}
+
+func (q *MatchingQuestion) Pairs() []Pair {
+	return q.pairs
+}
+
+// CorrectAnswers returns correct pairs encoded with PairSeparator.
+func (q *MatchingQuestion) CorrectAnswers() []string {
+	answers := make([]string, 0, len(q.pairs))
+	for _, pair := range q.pairs {
+		answers = append(answers, pair.String())
+	}
+
+	return answers
+}
+
+func (q *MatchingQuestion) IsAnswerCorrect(ans *UserAnswer) bool {
+	return len(ans.answer) == len(q.pairs) && q.countCorrectPairs(ans) == len(q.pairs)
+}
+
+// Credit returns share of correctly matched left items. Matching is always scored partially:
+// every left item is an independent decision.
+func (q *MatchingQuestion) Credit(ans *UserAnswer) float64 {
+	if len(q.pairs) == 0 {
+		return 0
+	}
+
+	return float64(q.countCorrectPairs(ans)) / float64(len(q.pairs))
+}
+
+// countCorrectPairs counts left items matched correctly. A left item matched more than once
+// is considered wrong, malformed selections are ignored.
+func (q *MatchingQuestion) countCorrectPairs(ans *UserAnswer) int {
+	correct := make(map[string]string, len(q.pairs))
+	for _, pair := range q.pairs {
+		correct[pair.Left] = pair.Right
+	}
+
+	matched := make(map[string][]string, len(ans.answer))
+	for _, selection := range ans.answer {
+		pair, err := ParsePair(selection)
+		if err != nil {
+			continue
+		}
+		matched[pair.Left] = append(matched[pair.Left], pair.Right)
+	}
+
+	var count int
+	for left, rights := range matched {
+		if len(rights) == 1 && correct[left] == rights[0] {
+			count++
+		}
+	}
+
+	return count
+}
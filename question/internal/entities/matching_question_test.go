@@ -0,0 +1,154 @@
+package entities_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/parta4ok/kvs/question/internal/entities"
+)
+
+func TestParsePair(t *testing.T) {
+	t.Parallel()
+
+	pair, err := entities.ParsePair("map[string]int =>  nil ")
+	require.NoError(t, err)
+	require.Equal(t, entities.Pair{Left: "map[string]int", Right: "nil"}, pair)
+	require.Equal(t, "map[string]int => nil", pair.String())
+
+	for _, encoded := range []string{"map[string]int", " => nil", "int => ", ""} {
+		_, err := entities.ParsePair(encoded)
+		require.ErrorIs(t, err, entities.ErrInvalidParam, encoded)
+	}
+
+	_, err = entities.NewPair("a => b", "c")
+	require.ErrorIs(t, err, entities.ErrInvalidParam)
+}
+
+func TestNewMatchingQuestion(t *testing.T) {
+	t.Parallel()
+
+	pairs := []entities.Pair{
+		{Left: "int", Right: "0"},
+		{Left: "string", Right: `""`},
+		{Left: "*int", Right: "nil"},
+	}
+	rightItems := []string{"nil", "0", `""`, "false"}
+
+	question := entities.NewMatchingQuestion("1", "Go", "Нулевые значения типов", rightItems,
+		pairs)
+
+	require.Equal(t, "1", question.ID())
+	require.Equal(t, "Go", question.Topic())
+	require.Equal(t, entities.Matching, question.Type())
+	require.Equal(t, rightItems, question.Variants())
+	require.Equal(t, rightItems, question.RightItems())
+	require.Equal(t, []string{"int", "string", "*int"}, question.LeftItems())
+	require.Equal(t, pairs, question.Pairs())
+	require.Equal(t, []string{"int => 0", `string => ""`, "*int => nil"},
+		question.CorrectAnswers())
+}
+
+func TestMatchingQuestion_IsAnswerCorrectAndCredit(t *testing.T) {
+	t.Parallel()
+
+	question := entities.NewMatchingQuestion("1", "Go", "Нулевые значения типов",
+		[]string{"nil", "0", "false", `""`}, []entities.Pair{
+			{Left: "int", Right: "0"},
+			{Left: "bool", Right: "false"},
+			{Left: "*int", Right: "nil"},
+			{Left: "string", Right: `""`},
+		})
+
+	testCases := []struct {
+		name      string
+		answer    []string
+		isCorrect bool
+		credit    float64
+	}{
+		{
+			name:      "all_pairs_any_order",
+			answer:    []string{"bool => false", `string => ""`, "int => 0", "*int => nil"},
+			isCorrect: true,
+			credit:    1,
+		},
+		{
+			name:   "two_pairs_swapped",
+			answer: []string{"bool => false", `string => ""`, "int => nil", "*int => 0"},
+			credit: 0.5,
+		},
+		{
+			name:   "incomplete",
+			answer: []string{"bool => false", "int => 0", "*int => nil"},
+			credit: 0.75,
+		},
+		{
+			name: "left_item_matched_twice",
+			answer: []string{"bool => false", `string => ""`, "int => 0", "int => nil",
+				"*int => nil"},
+			credit: 0.75,
+		},
+		{
+			name:   "malformed_selection",
+			answer: []string{"bool false", `string => ""`, "int => 0", "*int => nil"},
+			credit: 0.75,
+		},
+		{
+			name:   "empty",
+			answer: []string{},
+			credit: 0,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			answer, err := entities.NewUserAnswer(question.ID(), tc.answer)
+			require.NoError(t, err)
+
+			require.Equal(t, tc.isCorrect, question.IsAnswerCorrect(answer))
+			require.InDelta(t, tc.credit, question.Credit(answer), 1e-9)
+		})
+	}
+}
+
+func TestQuestionFactory_NewQuestion_Matching(t *testing.T) {
+	t.Parallel()
+
+	factory := &entities.QuestionFactory{}
+	variants := []string{"0", "nil", "false"}
+
+	question, err := factory.NewQuestion("1", entities.Matching, "Go", "subject", variants,
+		[]string{"int => 0", "*int => nil"})
+	require.NoError(t, err)
+	require.Equal(t, []string{"int", "*int"}, question.(*entities.MatchingQuestion).LeftItems())
+
+	testCases := []struct {
+		name     string
+		variants []string
+		pairs    []string
+	}{
+		{name: "single_pair", variants: variants, pairs: []string{"int => 0"}},
+		{name: "malformed_pair", variants: variants, pairs: []string{"int => 0", "*int nil"}},
+		{name: "unknown_right", variants: variants, pairs: []string{"int => 0", "*int => 1"}},
+		{name: "left_twice", variants: variants, pairs: []string{"int => 0", "int => nil"}},
+		{
+			name:     "repeated_variant",
+			variants: []string{"0", "0", "nil"},
+			pairs:    []string{"int => 0", "*int => nil"},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := factory.NewQuestion("1", entities.Matching, "Go", "subject", tc.variants,
+				tc.pairs)
+			require.ErrorIs(t, err, entities.ErrInvalidParam)
+		})
+	}
+}
@@ -6,10 +6,17 @@ import (
 )
 
 var (
-	_ Question = (*MultiSelectionQuestion)(nil)
+	_ Question     = (*MultiSelectionQuestion)(nil)
+	_ CreditScorer = (*MultiSelectionQuestion)(nil)
+	_ PickCounter  = (*MultiSelectionQuestion)(nil)
 )
 
 type MultiSelectionQuestion struct {
+	questionWeight
+	questionExplanation
+	questionDifficulty
+	questionVersion
+
 	id             string
 	topic          string
 	subject        string
@@ -48,6 +55,16 @@ func (q *MultiSelectionQuestion) Variants() []string {
 	return q.variants
 }
 
+func (q *MultiSelectionQuestion) withVariants(variants []string) Question {
+	clone := *q
+	clone.variants = variants
+	return &clone
+}
+
+func (q *MultiSelectionQuestion) CorrectAnswers() []string {
+	return q.correctAnswers
+}
+
 func (q *MultiSelectionQuestion) IsAnswerCorrect(ans *UserAnswer) bool {
 	if len(q.correctAnswers) != len(ans.answer) {
 		return false
@@ -61,3 +78,28 @@ func (q *MultiSelectionQuestion) IsAnswerCorrect(ans *UserAnswer) bool {
 
 	return slices.Equal(correct, user)
 }
+
+// Credit returns share of picked correct variants reduced by share of wrong picks, so picking
+// every variant earns nothing.
+func (q *MultiSelectionQuestion) Credit(ans *UserAnswer) float64 {
+	if len(q.correctAnswers) == 0 {
+		return 0
+	}
+
+	hits, misses := q.Picks(ans)
+
+	return max(0, float64(hits-misses)/float64(len(q.correctAnswers)))
+}
+
+// Picks counts distinct picked correct and wrong variants.
+func (q *MultiSelectionQuestion) Picks(ans *UserAnswer) (hits, misses int) {
+	for selection := range uniqueStrings(ans.answer) {
+		if slices.Contains(q.correctAnswers, selection) {
+			hits++
+			continue
+		}
+		misses++
+	}
+
+	return hits, misses
+}
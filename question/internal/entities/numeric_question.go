@@ -0,0 +1,140 @@
+package entities
+
+import (
+	"math"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	numericEpsilon = 1e-9
+)
+
+var (
+	_ Question = (*NumericQuestion)(nil)
+)
+
+// Tolerance describes acceptable deviation of numeric answer. Absolute is measured in the
+// units of the answer, Relative is a share of the correct value (0.01 means 1%). An answer is
+// accepted when it fits into any of the set tolerances.
+type Tolerance struct {
+	Absolute float64
+	Relative float64
+}
+
+func (t Tolerance) validate() error {
+	if t.Absolute < 0 || t.Relative < 0 || math.IsNaN(t.Absolute) || math.IsNaN(t.Relative) ||
+		math.IsInf(t.Absolute, 0) || math.IsInf(t.Relative, 0) {
+		return errors.Wrap(ErrInvalidParam, "tolerance must be a non-negative finite number")
+	}
+
+	return nil
+}
+
+// ParseNumber parses number written either with dot or with comma as decimal separator.
+// Spaces used as thousands separators are ignored. When both dot and comma are present the
+// last one is the decimal separator: "1 000,5", "1.000,5" and "1,000.5" are all 1000.5.
+func ParseNumber(value string) (float64, error) {
+	value = strings.Map(func(r rune) rune {
+		if unicode.IsSpace(r) {
+			return -1
+		}
+		return r
+	}, value)
+
+	lastComma, lastDot := strings.LastIndex(value, ","), strings.LastIndex(value, ".")
+	switch {
+	case lastComma >= 0 && lastDot >= 0:
+		if lastComma > lastDot {
+			value = strings.ReplaceAll(value, ".", "")
+			value = strings.Replace(value, ",", ".", 1)
+		} else {
+			value = strings.ReplaceAll(value, ",", "")
+		}
+	case lastComma >= 0:
+		value = strings.Replace(value, ",", ".", 1)
+	}
+
+	number, err := strconv.ParseFloat(value, 64)
+	if err != nil || math.IsNaN(number) || math.IsInf(number, 0) {
+		return 0, errors.Wrapf(ErrInvalidParam, "invalid number: %q", value)
+	}
+
+	return number, nil
+}
+
+type NumericQuestion struct {
+	questionWeight
+	questionExplanation
+	questionDifficulty
+	questionVersion
+
+	id        string
+	topic     string
+	subject   string
+	value     float64
+	tolerance Tolerance
+}
+
+func NewNumericQuestion(id string, topic string, subject string, value float64,
+	tolerance Tolerance) *NumericQuestion {
+	return &NumericQuestion{
+		id:        id,
+		topic:     topic,
+		subject:   subject,
+		value:     value,
+		tolerance: tolerance,
+	}
+}
+
+func (q *NumericQuestion) ID() string {
+	return q.id
+}
+
+func (q *NumericQuestion) Type() QuestionType {
+	return Numeric
+}
+
+func (q *NumericQuestion) Topic() string {
+	return q.topic
+}
+
+func (q *NumericQuestion) Subject() string {
+	return q.subject
+}
+
+// Variants returns nothing: the student types the number instead of choosing it.
+func (q *NumericQuestion) Variants() []string {
+	return nil
+}
+
+func (q *NumericQuestion) CorrectAnswers() []string {
+	return []string{strconv.FormatFloat(q.value, 'f', -1, 64)}
+}
+
+func (q *NumericQuestion) Value() float64 {
+	return q.value
+}
+
+func (q *NumericQuestion) Tolerance() Tolerance {
+	return q.tolerance
+}
+
+func (q *NumericQuestion) IsAnswerCorrect(ans *UserAnswer) bool {
+	if len(ans.answer) != 1 {
+		return false
+	}
+
+	number, err := ParseNumber(ans.answer[0])
+	if err != nil {
+		return false
+	}
+
+	diff := math.Abs(number - q.value)
+
+	return diff <= q.tolerance.Absolute+numericEpsilon ||
+		diff <= q.tolerance.Relative*math.Abs(q.value)+numericEpsilon
+}
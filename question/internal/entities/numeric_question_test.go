@@ -0,0 +1,183 @@
+package entities_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/parta4ok/kvs/question/internal/entities"
+)
+
+func TestParseNumber(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		input    string
+		expected float64
+		isError  bool
+	}{
+		{input: "8", expected: 8},
+		{input: "-3", expected: -3},
+		{input: "0.5", expected: 0.5},
+		{input: "0,5", expected: 0.5},
+		{input: " 1 000,5 ", expected: 1000.5},
+		{input: "1 000", expected: 1000},
+		{input: "1.000,5", expected: 1000.5},
+		{input: "1,000.5", expected: 1000.5},
+		{input: "1e3", expected: 1000},
+		{input: "1,000,000", isError: true},
+		{input: "восемь", isError: true},
+		{input: "", isError: true},
+		{input: "NaN", isError: true},
+		{input: "Inf", isError: true},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.input, func(t *testing.T) {
+			t.Parallel()
+
+			number, err := entities.ParseNumber(tc.input)
+			if tc.isError {
+				require.ErrorIs(t, err, entities.ErrInvalidParam)
+				return
+			}
+
+			require.NoError(t, err)
+			require.InDelta(t, tc.expected, number, 1e-9)
+		})
+	}
+}
+
+func TestNewNumericQuestion(t *testing.T) {
+	t.Parallel()
+
+	tolerance := entities.Tolerance{Absolute: 0.5}
+	question := entities.NewNumericQuestion("1", "Go", "Сколько байт занимает int64 на amd64?",
+		8, tolerance)
+
+	require.Equal(t, "1", question.ID())
+	require.Equal(t, "Go", question.Topic())
+	require.Equal(t, entities.Numeric, question.Type())
+	require.True(t, question.Type().IsFreeInput())
+	require.Empty(t, question.Variants())
+	require.Equal(t, []string{"8"}, question.CorrectAnswers())
+	require.Equal(t, 8.0, question.Value())
+	require.Equal(t, tolerance, question.Tolerance())
+}
+
+func TestNumericQuestion_IsAnswerCorrect(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name      string
+		value     float64
+		tolerance entities.Tolerance
+		answer    []string
+		expected  bool
+	}{
+		{name: "exact", value: 8, answer: []string{"8"}, expected: true},
+		{name: "exact_comma", value: 0.1, answer: []string{"0,1"}, expected: true},
+		{name: "float_sum", value: 0.3, answer: []string{"0.30000000000000004"}, expected: true},
+		{name: "wrong_exact", value: 8, answer: []string{"8.01"}, expected: false},
+		{
+			name:      "within_absolute",
+			value:     3.14,
+			tolerance: entities.Tolerance{Absolute: 0.01},
+			answer:    []string{"3,15"},
+			expected:  true,
+		},
+		{
+			name:      "out_of_absolute",
+			value:     3.14,
+			tolerance: entities.Tolerance{Absolute: 0.01},
+			answer:    []string{"3.16"},
+			expected:  false,
+		},
+		{
+			name:      "within_relative",
+			value:     1000,
+			tolerance: entities.Tolerance{Relative: 0.05},
+			answer:    []string{"1 049"},
+			expected:  true,
+		},
+		{
+			name:      "within_relative_below",
+			value:     1000,
+			tolerance: entities.Tolerance{Relative: 0.05},
+			answer:    []string{"951"},
+			expected:  true,
+		},
+		{
+			name:      "out_of_relative",
+			value:     1000,
+			tolerance: entities.Tolerance{Relative: 0.05},
+			answer:    []string{"949"},
+			expected:  false,
+		},
+		{
+			name:      "out_of_both",
+			value:     1000,
+			tolerance: entities.Tolerance{Absolute: 10, Relative: 0.01},
+			answer:    []string{"1011"},
+			expected:  false,
+		},
+		{name: "not_a_number", value: 8, answer: []string{"восемь"}, expected: false},
+		{name: "several_answers", value: 8, answer: []string{"8", "8"}, expected: false},
+		{name: "empty", value: 8, answer: []string{}, expected: false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			question := entities.NewNumericQuestion("1", "topic", "subject", tc.value,
+				tc.tolerance)
+
+			answer, err := entities.NewUserAnswer(question.ID(), tc.answer)
+			require.NoError(t, err)
+
+			require.Equal(t, tc.expected, question.IsAnswerCorrect(answer))
+		})
+	}
+}
+
+func TestQuestionFactory_NewQuestion_Numeric(t *testing.T) {
+	t.Parallel()
+
+	factory := &entities.QuestionFactory{}
+
+	question, err := factory.NewQuestion("1", entities.Numeric, "Go", "subject", nil,
+		[]string{"2,5"}, entities.WithTolerance(entities.Tolerance{Relative: 0.1}))
+	require.NoError(t, err)
+	require.Equal(t, []string{"2.5"}, question.CorrectAnswers())
+
+	testCases := []struct {
+		name      string
+		variants  []string
+		answers   []string
+		tolerance entities.Tolerance
+	}{
+		{name: "variants", variants: []string{"8", "16"}, answers: []string{"8"}},
+		{name: "no_answer", answers: nil},
+		{name: "several_answers", answers: []string{"8", "16"}},
+		{name: "not_a_number", answers: []string{"восемь"}},
+		{
+			name:      "negative_tolerance",
+			answers:   []string{"8"},
+			tolerance: entities.Tolerance{Absolute: -1},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := factory.NewQuestion("1", entities.Numeric, "Go", "subject", tc.variants,
+				tc.answers, entities.WithTolerance(tc.tolerance))
+			require.ErrorIs(t, err, entities.ErrInvalidParam)
+		})
+	}
+}
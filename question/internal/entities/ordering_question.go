@@ -0,0 +1,124 @@
+package entities
+
+import (
+	"slices"
+	"sort"
+)
+
+var (
+	_ Question     = (*OrderingQuestion)(nil)
+	_ CreditScorer = (*OrderingQuestion)(nil)
+)
+
+// OrderingQuestion asks the student to arrange all variants in the correct order. The answer
+// is the full sequence of variants, so, unlike MultiSelectionQuestion, order matters.
+type OrderingQuestion struct {
+	questionWeight
+	questionExplanation
+	questionDifficulty
+	questionVersion
+
+	id            string
+	topic         string
+	subject       string
+	variants      []string
+	correctOrder  []string
+	partialCredit bool
+}
+
+func NewOrderingQuestion(id string, topic string, subject string, variants []string,
+	correctOrder []string, partialCredit bool) *OrderingQuestion {
+	return &OrderingQuestion{
+		id:            id,
+		topic:         topic,
+		subject:       subject,
+		variants:      variants,
+		correctOrder:  correctOrder,
+		partialCredit: partialCredit,
+	}
+}
+
+func (q *OrderingQuestion) ID() string {
+	return q.id
+}
+
+func (q *OrderingQuestion) Type() QuestionType {
+	return Ordering
+}
+
+func (q *OrderingQuestion) Topic() string {
+	return q.topic
+}
+
+func (q *OrderingQuestion) Subject() string {
+	return q.subject
+}
+
+func (q *OrderingQuestion) Variants() []string {
+	return q.variants
+}
+
+func (q *OrderingQuestion) withVariants(variants []string) Question {
+	clone := *q
+	clone.variants = variants
+	return &clone
+}
+
+func (q *OrderingQuestion) CorrectAnswers() []string {
+	return q.correctOrder
+}
+
+func (q *OrderingQuestion) PartialCredit() bool {
+	return q.partialCredit
+}
+
+func (q *OrderingQuestion) IsAnswerCorrect(ans *UserAnswer) bool {
+	return slices.Equal(q.correctOrder, ans.answer)
+}
+
+// Credit returns 1 for the exactly matching sequence. In partial credit mode an imperfect
+// sequence gets the share of items forming the longest subsequence placed in the correct
+// relative order, so swapping two neighbours costs only one item.
+func (q *OrderingQuestion) Credit(ans *UserAnswer) float64 {
+	if q.IsAnswerCorrect(ans) {
+		return 1
+	}
+	if !q.partialCredit || len(q.correctOrder) == 0 {
+		return 0
+	}
+
+	return float64(q.longestOrderedSubsequence(ans.answer)) / float64(len(q.correctOrder))
+}
+
+// longestOrderedSubsequence maps the user sequence to positions in the correct order and
+// finds the longest increasing subsequence of those positions. Unknown and repeated items
+// are skipped.
+func (q *OrderingQuestion) longestOrderedSubsequence(answer []string) int {
+	positions := make(map[string]int, len(q.correctOrder))
+	for idx, item := range q.correctOrder {
+		positions[item] = idx
+	}
+
+	seen := make(map[string]struct{}, len(answer))
+	tails := make([]int, 0, len(answer))
+
+	for _, item := range answer {
+		pos, ok := positions[item]
+		if !ok {
+			continue
+		}
+		if _, ok := seen[item]; ok {
+			continue
+		}
+		seen[item] = struct{}{}
+
+		idx := sort.SearchInts(tails, pos)
+		if idx == len(tails) {
+			tails = append(tails, pos)
+			continue
+		}
+		tails[idx] = pos
+	}
+
+	return len(tails)
+}
@@ -0,0 +1,141 @@
+package entities_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/parta4ok/kvs/question/internal/entities"
+)
+
+func TestNewOrderingQuestion(t *testing.T) {
+	t.Parallel()
+
+	variants := []string{"tx.Commit", "pool.Begin", "tx.Exec"}
+	correctOrder := []string{"pool.Begin", "tx.Exec", "tx.Commit"}
+
+	question := entities.NewOrderingQuestion("1", "Go", "Шаги транзакции pgx", variants,
+		correctOrder, false)
+
+	require.Equal(t, "1", question.ID())
+	require.Equal(t, "Go", question.Topic())
+	require.Equal(t, "Шаги транзакции pgx", question.Subject())
+	require.Equal(t, variants, question.Variants())
+	require.Equal(t, correctOrder, question.CorrectAnswers())
+	require.Equal(t, entities.Ordering, question.Type())
+	require.True(t, question.Type().IsOrdered())
+	require.False(t, question.PartialCredit())
+}
+
+func TestOrderingQuestion_IsAnswerCorrectAndCredit(t *testing.T) {
+	t.Parallel()
+
+	correctOrder := []string{"A", "B", "C", "D"}
+
+	testCases := []struct {
+		name          string
+		partialCredit bool
+		answer        []string
+		isCorrect     bool
+		credit        float64
+	}{
+		{
+			name:      "exact_order",
+			answer:    []string{"A", "B", "C", "D"},
+			isCorrect: true,
+			credit:    1,
+		},
+		{
+			name:      "same_items_other_order",
+			answer:    []string{"B", "A", "C", "D"},
+			isCorrect: false,
+			credit:    0,
+		},
+		{
+			name:          "swapped_neighbours_partial",
+			partialCredit: true,
+			answer:        []string{"B", "A", "C", "D"},
+			credit:        0.75,
+		},
+		{
+			name:          "reversed_partial",
+			partialCredit: true,
+			answer:        []string{"D", "C", "B", "A"},
+			credit:        0.25,
+		},
+		{
+			name:          "missing_and_unknown_items_partial",
+			partialCredit: true,
+			answer:        []string{"A", "X", "C"},
+			credit:        0.5,
+		},
+		{
+			name:          "repeated_items_partial",
+			partialCredit: true,
+			answer:        []string{"A", "A", "B", "B"},
+			credit:        0.5,
+		},
+		{
+			name:          "empty_answer_partial",
+			partialCredit: true,
+			answer:        []string{},
+			credit:        0,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			question := entities.NewOrderingQuestion("1", "topic", "subject",
+				[]string{"D", "B", "A", "C"}, correctOrder, tc.partialCredit)
+
+			answer, err := entities.NewUserAnswer(question.ID(), tc.answer)
+			require.NoError(t, err)
+
+			require.Equal(t, tc.isCorrect, question.IsAnswerCorrect(answer))
+			require.InDelta(t, tc.credit, question.Credit(answer), 1e-9)
+		})
+	}
+}
+
+func TestQuestionFactory_NewQuestion_Ordering(t *testing.T) {
+	t.Parallel()
+
+	factory := &entities.QuestionFactory{}
+	variants := []string{"C", "A", "B"}
+
+	question, err := factory.NewQuestion("1", entities.Ordering, "topic", "subject", variants,
+		[]string{"A", "B", "C"}, entities.WithPartialCredit(true))
+	require.NoError(t, err)
+	require.Equal(t, entities.Ordering, question.Type())
+	require.True(t, question.(*entities.OrderingQuestion).PartialCredit())
+
+	testCases := []struct {
+		name         string
+		variants     []string
+		correctOrder []string
+	}{
+		{name: "single_variant", variants: []string{"A"}, correctOrder: []string{"A"}},
+		{name: "incomplete_order", variants: variants, correctOrder: []string{"A", "B"}},
+		{name: "unknown_item", variants: variants, correctOrder: []string{"A", "B", "D"}},
+		{name: "repeated_item", variants: variants, correctOrder: []string{"A", "B", "B"}},
+		{
+			name:         "repeated_variant",
+			variants:     []string{"A", "A", "B"},
+			correctOrder: []string{"A", "B", "A"},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := factory.NewQuestion("1", entities.Ordering, "topic", "subject", tc.variants,
+				tc.correctOrder)
+			require.ErrorIs(t, err, entities.ErrInvalidParam)
+		})
+	}
+}
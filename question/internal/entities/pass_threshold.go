@@ -0,0 +1,77 @@
+package entities
+
+import (
+	"math"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	// StrictestThreshold applies the highest threshold among the session topics.
+	StrictestThreshold = "strictest"
+	// WeightedThreshold averages topic thresholds weighted by the session questions of the
+	// topic.
+	WeightedThreshold = "weighted"
+)
+
+// PassThresholdPolicy keeps pass thresholds of the session topics as they were when the
+// session was created. Topics without threshold use DefaultBorderResult.
+type PassThresholdPolicy struct {
+	Resolution string
+	Topics     map[string]float64
+}
+
+func DefaultPassThresholdPolicy() PassThresholdPolicy {
+	return PassThresholdPolicy{
+		Resolution: StrictestThreshold,
+	}
+}
+
+func ValidatePassThreshold(threshold float64) error {
+	if math.IsNaN(threshold) || threshold < 0 || threshold > 100 {
+		return errors.Wrap(ErrInvalidParam, "pass threshold must be in [0, 100] percents")
+	}
+
+	return nil
+}
+
+func ValidateThresholdResolution(resolution string) error {
+	if resolution != StrictestThreshold && resolution != WeightedThreshold {
+		return errors.Wrapf(ErrInvalidParam, "unknown pass threshold resolution: %s", resolution)
+	}
+
+	return nil
+}
+
+func (p PassThresholdPolicy) topicThreshold(topic string) float64 {
+	if threshold, ok := p.Topics[topic]; ok {
+		return threshold
+	}
+
+	return DefaultBorderResult
+}
+
+// Resolve calculates threshold for the session with the given questions.
+func (p PassThresholdPolicy) Resolve(questions map[string]Question) float64 {
+	if len(questions) == 0 || len(p.Topics) == 0 {
+		return DefaultBorderResult
+	}
+
+	if p.Resolution == WeightedThreshold {
+		var weighted, total float64
+		for _, question := range questions {
+			weight := weightOf(question)
+			weighted += weight * p.topicThreshold(question.Topic())
+			total += weight
+		}
+
+		return weighted / total
+	}
+
+	var strictest float64
+	for _, question := range questions {
+		strictest = max(strictest, p.topicThreshold(question.Topic()))
+	}
+
+	return strictest
+}
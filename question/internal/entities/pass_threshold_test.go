@@ -0,0 +1,134 @@
+package entities_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/parta4ok/kvs/question/internal/entities"
+	"github.com/parta4ok/kvs/question/internal/entities/testdata"
+)
+
+func TestPassThresholdPolicy_Resolve(t *testing.T) {
+	t.Parallel()
+
+	factory := &entities.QuestionFactory{}
+	newQuestion := func(id string, topic string, weight float64) entities.Question {
+		question, err := factory.NewQuestion(id, entities.TrueOrFalse, topic, "subject", nil,
+			[]string{"true"}, entities.WithWeight(weight))
+		require.NoError(t, err)
+		return question
+	}
+
+	questions := map[string]entities.Question{
+		"1": newQuestion("1", "Базы данных", 1),
+		"2": newQuestion("2", "Базы данных", 1),
+		"3": newQuestion("3", "Go", 2),
+	}
+	topics := map[string]float64{"Базы данных": 90, "Go": 50}
+
+	testCases := []struct {
+		name      string
+		policy    entities.PassThresholdPolicy
+		questions map[string]entities.Question
+		expected  float64
+	}{
+		{
+			name:      "no_topic_thresholds",
+			policy:    entities.DefaultPassThresholdPolicy(),
+			questions: questions,
+			expected:  entities.DefaultBorderResult,
+		},
+		{
+			name: "no_questions",
+			policy: entities.PassThresholdPolicy{
+				Resolution: entities.StrictestThreshold,
+				Topics:     topics,
+			},
+			expected: entities.DefaultBorderResult,
+		},
+		{
+			name: "strictest",
+			policy: entities.PassThresholdPolicy{
+				Resolution: entities.StrictestThreshold,
+				Topics:     topics,
+			},
+			questions: questions,
+			expected:  90,
+		},
+		{
+			name: "weighted",
+			policy: entities.PassThresholdPolicy{
+				Resolution: entities.WeightedThreshold,
+				Topics:     topics,
+			},
+			questions: questions,
+			expected:  70,
+		},
+		{
+			name: "topic_without_threshold",
+			policy: entities.PassThresholdPolicy{
+				Resolution: entities.StrictestThreshold,
+				Topics:     map[string]float64{"Go": 50},
+			},
+			questions: questions,
+			expected:  entities.DefaultBorderResult,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			require.InDelta(t, tc.expected, tc.policy.Resolve(tc.questions), 1e-9)
+		})
+	}
+}
+
+func TestValidatePassThreshold(t *testing.T) {
+	t.Parallel()
+
+	require.NoError(t, entities.ValidatePassThreshold(0))
+	require.NoError(t, entities.ValidatePassThreshold(100))
+	require.ErrorIs(t, entities.ValidatePassThreshold(-1), entities.ErrInvalidParam)
+	require.ErrorIs(t, entities.ValidatePassThreshold(100.5), entities.ErrInvalidParam)
+
+	require.NoError(t, entities.ValidateThresholdResolution(entities.WeightedThreshold))
+	require.ErrorIs(t, entities.ValidateThresholdResolution("average"), entities.ErrInvalidParam)
+}
+
+func TestCompletedSessionState_GetSessionResult_TopicThreshold(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	questions := map[string]entities.Question{
+		"1": entities.NewTrueOrFalseSelectionQuestion("1", "Go", "q1", true),
+		"2": entities.NewTrueOrFalseSelectionQuestion("2", "Go", "q2", true),
+		"3": entities.NewTrueOrFalseSelectionQuestion("3", "Go", "q3", true),
+		"4": entities.NewTrueOrFalseSelectionQuestion("4", "Go", "q4", true),
+	}
+
+	answers := make([]*entities.UserAnswer, 0, 3)
+	for _, id := range []string{"1", "2", "3"} {
+		answer, err := entities.NewUserAnswer(id, []string{"true"})
+		require.NoError(t, err)
+		answers = append(answers, answer)
+	}
+
+	policy := entities.DefaultSessionPolicy()
+	policy.PassThreshold.Topics = map[string]float64{"Go": 80}
+
+	state := entities.NewCompletedSessionState(questions, testdata.NewMockStateHolder(ctrl),
+		answers, time.Now(), false, entities.WithCompletedStatePolicy(policy))
+
+	result, err := state.GetSessionResult()
+	require.NoError(t, err)
+	require.Equal(t, 75.0, result.Score)
+	require.Equal(t, 80.0, result.PassThreshold)
+	require.False(t, result.IsSuccess)
+}
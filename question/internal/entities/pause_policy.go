@@ -0,0 +1,38 @@
+package entities
+
+import (
+	"github.com/pkg/errors"
+)
+
+// PausePolicy limits pauses of the session. Sessions with zero MaxPauses can not be paused.
+type PausePolicy struct {
+	MaxPauses int
+}
+
+func ValidateMaxPauses(maxPauses int) error {
+	if maxPauses < 0 {
+		return errors.Wrap(ErrInvalidParam, "max pauses must not be negative")
+	}
+
+	return nil
+}
+
+// ResolvePausePolicy combines pause limits of the session topics. The smallest limit wins, so
+// a topic without limit or with zero limit forbids pausing of the whole session.
+func ResolvePausePolicy(topics []string, limits map[string]int) PausePolicy {
+	if len(topics) == 0 {
+		return PausePolicy{}
+	}
+
+	policy := PausePolicy{MaxPauses: limits[topics[0]]}
+	for _, topic := range topics[1:] {
+		policy.MaxPauses = min(policy.MaxPauses, limits[topic])
+	}
+
+	return policy
+}
+
+// Allows tells whether the session paused the given number of times may be paused again.
+func (p PausePolicy) Allows(pauses int) bool {
+	return pauses < p.MaxPauses
+}
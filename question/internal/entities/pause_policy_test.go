@@ -0,0 +1,63 @@
+package entities_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/parta4ok/kvs/question/internal/entities"
+)
+
+func TestResolvePausePolicy(t *testing.T) {
+	t.Parallel()
+
+	limits := map[string]int{"Базы данных": 3, "Go": 1}
+
+	testCases := []struct {
+		name     string
+		topics   []string
+		expected int
+	}{
+		{
+			name:     "single_topic",
+			topics:   []string{"Базы данных"},
+			expected: 3,
+		},
+		{
+			name:     "smallest_limit_wins",
+			topics:   []string{"Базы данных", "Go"},
+			expected: 1,
+		},
+		{
+			name:     "topic_without_limit",
+			topics:   []string{"Базы данных", "Алгоритмы"},
+			expected: 0,
+		},
+		{
+			name:     "no_topics",
+			expected: 0,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			policy := entities.ResolvePausePolicy(tc.topics, limits)
+			require.Equal(t, tc.expected, policy.MaxPauses)
+		})
+	}
+}
+
+func TestPausePolicy_Allows(t *testing.T) {
+	t.Parallel()
+
+	policy := entities.PausePolicy{MaxPauses: 2}
+	require.True(t, policy.Allows(1))
+	require.False(t, policy.Allows(2))
+	require.False(t, entities.PausePolicy{}.Allows(0))
+
+	require.ErrorIs(t, entities.ValidateMaxPauses(-1), entities.ErrInvalidParam)
+	require.NoError(t, entities.ValidateMaxPauses(0))
+}
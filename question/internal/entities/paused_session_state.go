@@ -0,0 +1,123 @@
+package entities
+
+import (
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+var (
+	_ SessionState = (*PausedSessionState)(nil)
+)
+
+// PausedSessionState is the active session which clock is stopped. The session keeps its
+// questions and can be resumed or completed.
+type PausedSessionState struct {
+	holder    StateHolder
+	questions map[string]Question
+	startedAt time.Time
+	duration  time.Duration
+	clock     SessionClock
+	policy    SessionPolicy
+}
+
+func NewPausedSessionState(questions map[string]Question, holder StateHolder,
+	duration time.Duration, startedAt time.Time, clock SessionClock,
+	opts ...PausedSessionStateOption) *PausedSessionState {
+	state := &PausedSessionState{
+		holder:    holder,
+		questions: questions,
+		startedAt: startedAt,
+		duration:  duration,
+		clock:     SessionClock{Elapsed: clock.Elapsed, Pauses: clock.Pauses},
+		policy:    DefaultSessionPolicy(),
+	}
+
+	for _, opt := range opts {
+		opt(state)
+	}
+	state.questions = state.policy.Shuffle.ShuffleVariants(state.questions)
+
+	return state
+}
+
+type PausedSessionStateOption func(*PausedSessionState)
+
+func WithPausedStatePolicy(policy SessionPolicy) PausedSessionStateOption {
+	return func(state *PausedSessionState) {
+		state.policy = policy
+	}
+}
+
+func (state *PausedSessionState) GetStatus() string {
+	return PausedState
+}
+
+func (state *PausedSessionState) SetQuestions(_ map[string]Question,
+	_ time.Duration) error {
+	return errors.Wrapf(ErrInvalidState, "%s not support `SetQuestions`", state.GetStatus())
+}
+
+// SetUserAnswer completes the paused session. Time of the pause is not counted, so the session
+// is expired only if its active time exceeded the limit before the pause.
+func (state *PausedSessionState) SetUserAnswer(answers []*UserAnswer) error {
+	isExpired := state.policy.IsTimed() && state.clock.Elapsed > state.duration
+
+	completedState := NewCompletedSessionState(state.questions, state.holder, answers,
+		state.startedAt, isExpired, WithCompletedStatePolicy(state.policy),
+		WithCompletedDurationLimit(state.duration))
+	state.holder.ChangeState(completedState)
+
+	return nil
+}
+
+func (state *PausedSessionState) GetSessionResult() (*SessionResult, error) {
+	return nil, errors.Wrapf(
+		ErrInvalidState, "%s not support `GetSessionResult`", state.GetStatus())
+}
+
+func (state *PausedSessionState) GetSessionDurationLimit() (time.Duration, error) {
+	return state.duration, nil
+}
+
+func (state *PausedSessionState) IsExpired() (bool, error) {
+	return false, errors.Wrapf(
+		ErrInvalidState, "%s not support `IsExpired`", state.GetStatus())
+}
+
+func (state *PausedSessionState) GetQuestions() ([]Question, error) {
+	questionsList := make([]Question, 0, len(state.questions))
+	for _, question := range state.questions {
+		questionsList = append(questionsList, question)
+	}
+
+	return state.policy.Shuffle.OrderQuestions(questionsList), nil
+}
+
+func (state *PausedSessionState) GetStartedAt() (time.Time, error) {
+	return state.startedAt, nil
+}
+
+func (state *PausedSessionState) GetUserAnswers() ([]*UserAnswer, error) {
+	return nil, errors.Wrapf(
+		ErrInvalidState, "%s not support `GetUserAnswers`", state.GetStatus())
+}
+
+func (state *PausedSessionState) Pause(_ time.Time) error {
+	return errors.Wrapf(ErrInvalidState, "%s not support `Pause`", state.GetStatus())
+}
+
+// Resume starts the session clock again. The session keeps the active time left before the
+// pause.
+func (state *PausedSessionState) Resume(now time.Time) error {
+	activeState := NewActiveSessionState(state.questions, state.holder, state.duration,
+		WithStartedAt(state.startedAt), WithActiveClock(state.clock.Start(now)),
+		WithActiveStatePolicy(state.policy))
+	state.holder.ChangeState(activeState)
+
+	return nil
+}
+
+func (state *PausedSessionState) GetClock() (SessionClock, error) {
+	return state.clock, nil
+}
@@ -0,0 +1,170 @@
+package entities_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/parta4ok/kvs/question/internal/entities"
+	"github.com/parta4ok/kvs/question/internal/entities/testdata"
+)
+
+func TestPausedSessionState_Resume(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	startedAt := time.Date(2025, 9, 1, 10, 0, 0, 0, time.UTC)
+	resumedAt := startedAt.Add(time.Hour)
+	questions := map[string]entities.Question{"1": testdata.NewMockQuestion(ctrl)}
+	holder := testdata.NewMockStateHolder(ctrl)
+
+	state := entities.NewPausedSessionState(questions, holder, time.Minute*10, startedAt,
+		entities.SessionClock{Elapsed: time.Minute * 4, Pauses: 1})
+
+	holder.EXPECT().ChangeState(gomock.Any()).Do(func(next entities.SessionState) {
+		require.Equal(t, entities.ActiveState, next.GetStatus())
+
+		clock, err := next.GetClock()
+		require.NoError(t, err)
+		require.Equal(t, entities.SessionClock{
+			Elapsed:      time.Minute * 4,
+			RunningSince: resumedAt,
+			Pauses:       1,
+		}, clock)
+
+		nextStartedAt, err := next.GetStartedAt()
+		require.NoError(t, err)
+		require.Equal(t, startedAt, nextStartedAt)
+	})
+
+	require.NoError(t, state.Resume(resumedAt))
+}
+
+func TestPausedSessionState_SetUserAnswer(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	questions := map[string]entities.Question{"1": testdata.NewMockQuestion(ctrl)}
+	holder := testdata.NewMockStateHolder(ctrl)
+
+	// the pause lasted much longer than the limit, but only active time is counted
+	state := entities.NewPausedSessionState(questions, holder, time.Minute*10,
+		time.Now().UTC().Add(-time.Hour), entities.SessionClock{Elapsed: time.Minute * 4})
+
+	holder.EXPECT().ChangeState(gomock.Any()).Do(func(next entities.SessionState) {
+		require.Equal(t, entities.CompletedState, next.GetStatus())
+
+		isExpired, err := next.IsExpired()
+		require.NoError(t, err)
+		require.False(t, isExpired)
+	})
+
+	require.NoError(t, state.SetUserAnswer(nil))
+}
+
+func TestPausedSessionState_UnsupportedMethods(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	state := entities.NewPausedSessionState(nil, testdata.NewMockStateHolder(ctrl),
+		time.Minute, time.Now().UTC(), entities.SessionClock{})
+
+	require.Equal(t, entities.PausedState, state.GetStatus())
+
+	err := state.Pause(time.Now().UTC())
+	require.ErrorIs(t, err, entities.ErrInvalidState)
+
+	err = state.SetQuestions(nil, time.Minute)
+	require.ErrorIs(t, err, entities.ErrInvalidState)
+
+	_, err = state.GetSessionResult()
+	require.ErrorIs(t, err, entities.ErrInvalidState)
+
+	_, err = state.IsExpired()
+	require.ErrorIs(t, err, entities.ErrInvalidState)
+
+	_, err = state.GetUserAnswers()
+	require.ErrorIs(t, err, entities.ErrInvalidState)
+}
+
+func TestActiveSessionState_Pause(t *testing.T) {
+	t.Parallel()
+
+	startedAt := time.Date(2025, 9, 1, 10, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		name          string
+		maxPauses     int
+		clock         entities.SessionClock
+		now           time.Time
+		expectedError error
+	}{
+		{
+			name:      "success",
+			maxPauses: 2,
+			clock:     entities.SessionClock{RunningSince: startedAt},
+			now:       startedAt.Add(time.Minute * 3),
+		},
+		{
+			name:          "pauses_not_allowed",
+			clock:         entities.SessionClock{RunningSince: startedAt},
+			now:           startedAt.Add(time.Minute * 3),
+			expectedError: entities.ErrForbidden,
+		},
+		{
+			name:          "pause_limit_reached",
+			maxPauses:     2,
+			clock:         entities.SessionClock{RunningSince: startedAt, Pauses: 2},
+			now:           startedAt.Add(time.Minute * 3),
+			expectedError: entities.ErrForbidden,
+		},
+		{
+			name:          "session_expired",
+			maxPauses:     2,
+			clock:         entities.SessionClock{Elapsed: time.Minute * 8, RunningSince: startedAt},
+			now:           startedAt.Add(time.Minute * 3),
+			expectedError: entities.ErrInvalidState,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			policy := entities.DefaultSessionPolicy()
+			policy.Pause.MaxPauses = tc.maxPauses
+
+			holder := testdata.NewMockStateHolder(ctrl)
+			state := entities.NewActiveSessionState(nil, holder, time.Minute*10,
+				entities.WithStartedAt(startedAt), entities.WithActiveClock(tc.clock),
+				entities.WithActiveStatePolicy(policy))
+
+			if tc.expectedError != nil {
+				require.ErrorIs(t, state.Pause(tc.now), tc.expectedError)
+				return
+			}
+
+			holder.EXPECT().ChangeState(gomock.Any()).Do(func(next entities.SessionState) {
+				require.Equal(t, entities.PausedState, next.GetStatus())
+
+				clock, err := next.GetClock()
+				require.NoError(t, err)
+				require.Equal(t, entities.SessionClock{Elapsed: time.Minute * 3, Pauses: 1}, clock)
+			})
+
+			require.NoError(t, state.Pause(tc.now))
+		})
+	}
+}
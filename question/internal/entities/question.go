@@ -1,6 +1,8 @@
 package entities
 
 import (
+	"math"
+	"slices"
 	"strings"
 
 	"github.com/pkg/errors"
@@ -10,6 +12,10 @@ const (
 	SingleSelection QuestionType = iota + 1
 	MultiSelection
 	TrueOrFalse
+	ShortAnswer
+	Ordering
+	Matching
+	Numeric
 )
 
 type QuestionType int
@@ -22,10 +28,40 @@ func (q QuestionType) String() string {
 		return "multi selection"
 	case TrueOrFalse:
 		return "true or false"
+	case ShortAnswer:
+		return "short answer"
+	case Ordering:
+		return "ordering"
+	case Matching:
+		return "matching"
+	case Numeric:
+		return "numeric"
 	}
 	return ""
 }
 
+// IsFreeInput reports whether the student types the answer instead of choosing it from
+// the variants.
+func (q QuestionType) IsFreeInput() bool {
+	return q == ShortAnswer || q == Numeric
+}
+
+// IsOrdered reports whether the student must arrange all variants in a sequence.
+func (q QuestionType) IsOrdered() bool {
+	return q == Ordering
+}
+
+func ParseQuestionType(name string) (QuestionType, error) {
+	for _, qt := range []QuestionType{SingleSelection, MultiSelection, TrueOrFalse,
+		ShortAnswer, Ordering, Matching, Numeric} {
+		if qt.String() == name {
+			return qt, nil
+		}
+	}
+
+	return 0, errors.Wrapf(ErrInvalidParam, "unknown question type: %s", name)
+}
+
 //go:generate mockgen -source=question.go -destination=./testdata/question.go -package=testdata
 type Question interface {
 	ID() string
@@ -33,13 +69,135 @@ type Question interface {
 	Topic() string
 	Subject() string
 	Variants() []string
+	CorrectAnswers() []string
 	IsAnswerCorrect(ans *UserAnswer) bool
 }
 
+// CreditScorer is implemented by questions which can be answered partially correct. Credit
+// returns share of the question score from 0 to 1.
+type CreditScorer interface {
+	Credit(ans *UserAnswer) float64
+}
+
+type questionConfig struct {
+	normalization AnswerNormalization
+	partialCredit bool
+	tolerance     Tolerance
+	weight        float64
+	explanation   string
+	difficulty    Difficulty
+	version       int
+}
+
+type QuestionOption func(*questionConfig)
+
+// WithAnswerNormalization sets how free-text answers are compared with the accepted ones.
+func WithAnswerNormalization(normalization AnswerNormalization) QuestionOption {
+	return func(cfg *questionConfig) {
+		cfg.normalization = normalization
+	}
+}
+
+// WithPartialCredit enables partial credit for the question types supporting it.
+func WithPartialCredit(enabled bool) QuestionOption {
+	return func(cfg *questionConfig) {
+		cfg.partialCredit = enabled
+	}
+}
+
+// WithTolerance sets acceptable deviation of the numeric answer.
+func WithTolerance(tolerance Tolerance) QuestionOption {
+	return func(cfg *questionConfig) {
+		cfg.tolerance = tolerance
+	}
+}
+
+// WithWeight sets how much the question contributes to the session score compared to the
+// other questions.
+func WithWeight(weight float64) QuestionOption {
+	return func(cfg *questionConfig) {
+		cfg.weight = weight
+	}
+}
+
+// WithExplanation sets text shown to the student in the review of the completed session.
+func WithExplanation(explanation string) QuestionOption {
+	return func(cfg *questionConfig) {
+		cfg.explanation = explanation
+	}
+}
+
+// WithDifficulty sets how hard the question is.
+func WithDifficulty(difficulty Difficulty) QuestionOption {
+	return func(cfg *questionConfig) {
+		cfg.difficulty = difficulty
+	}
+}
+
+// WithVersion sets version of the question in the question bank.
+func WithVersion(version int) QuestionOption {
+	return func(cfg *questionConfig) {
+		cfg.version = version
+	}
+}
+
 type QuestionFactory struct{}
 
+//nolint:funlen,gocognit //ok
 func (factory *QuestionFactory) NewQuestion(id string, questionType QuestionType,
-	topic string, subject string, variants []string, correctAnswer []string) (Question, error) {
+	topic string, subject string, variants []string, correctAnswer []string,
+	opts ...QuestionOption) (Question, error) {
+	cfg := &questionConfig{
+		normalization: DefaultAnswerNormalization(),
+		weight:        DefaultQuestionWeight,
+		difficulty:    DefaultDifficulty,
+		version:       FirstQuestionVersion,
+	}
+	for _, opt := range opts {
+		opt(cfg)
+	}
+
+	if cfg.weight <= 0 || math.IsInf(cfg.weight, 0) || math.IsNaN(cfg.weight) {
+		return nil, errors.Wrap(ErrInvalidParam, "question weight must be a positive number")
+	}
+
+	if err := cfg.difficulty.Validate(); err != nil {
+		return nil, err
+	}
+
+	if cfg.version < FirstQuestionVersion {
+		return nil, errors.Wrapf(ErrInvalidParam, "invalid question version: %d", cfg.version)
+	}
+
+	question, err := factory.newQuestion(id, questionType, topic, subject, variants,
+		correctAnswer, cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	if weighted, ok := question.(interface{ setWeight(weight float64) }); ok {
+		weighted.setWeight(cfg.weight)
+	}
+
+	if explained, ok := question.(interface{ setExplanation(explanation string) }); ok {
+		explained.setExplanation(cfg.explanation)
+	}
+
+	if rated, ok := question.(interface{ setDifficulty(difficulty Difficulty) }); ok {
+		rated.setDifficulty(cfg.difficulty)
+	}
+
+	if versioned, ok := question.(interface{ setVersion(version int) }); ok {
+		versioned.setVersion(cfg.version)
+	}
+
+	return question, nil
+}
+
+//nolint:funlen,gocognit,gocyclo //ok
+func (factory *QuestionFactory) newQuestion(id string, questionType QuestionType,
+	topic string, subject string, variants []string, correctAnswer []string,
+	cfg *questionConfig) (Question, error) {
 	if id == "" {
 		return nil, errors.Wrap(ErrInvalidParam, "invalid id")
 	}
@@ -60,6 +218,9 @@ func (factory *QuestionFactory) NewQuestion(id string, questionType QuestionType
 			return nil, errors.Wrap(ErrInvalidParam,
 				"only one correct answer for this question type")
 		}
+		if !slices.Contains(variants, correctAnswer[0]) {
+			return nil, errors.Wrap(ErrInvalidParam, "correct answer is not one of the variants")
+		}
 		return NewSingleSelectionQuestion(id, topic, subject, variants, correctAnswer[0]), nil
 
 	case MultiSelection:
@@ -71,6 +232,12 @@ func (factory *QuestionFactory) NewQuestion(id string, questionType QuestionType
 			return nil, errors.Wrap(ErrInvalidParam,
 				"minimum one correct answer for multi selection question question")
 		}
+		for _, answer := range correctAnswer {
+			if !slices.Contains(variants, answer) {
+				return nil, errors.Wrapf(ErrInvalidParam,
+					"correct answer %q is not one of the variants", answer)
+			}
+		}
 		return NewMultiSelectionQuestion(id, topic, subject, variants, correctAnswer), nil
 
 	case TrueOrFalse:
@@ -84,10 +251,104 @@ func (factory *QuestionFactory) NewQuestion(id string, questionType QuestionType
 			ca = true
 		case "false":
 			ca = false
+		default:
+			return nil, errors.Wrap(ErrInvalidParam, "correct answer must be `true` or `false`")
 		}
 		return NewTrueOrFalseSelectionQuestion(id, topic, subject, ca), nil
 
+	case ShortAnswer:
+		if len(variants) != 0 {
+			return nil, errors.Wrap(ErrInvalidParam,
+				"variants are not offered for short answer question")
+		}
+		if len(correctAnswer) == 0 && cfg.normalization.Pattern == "" {
+			return nil, errors.Wrap(ErrInvalidParam,
+				"accepted answers or answer pattern must be set for short answer question")
+		}
+		question, err := NewShortAnswerQuestion(id, topic, subject, correctAnswer,
+			cfg.normalization)
+		if err != nil {
+			return nil, err
+		}
+		return question, nil
+
+	case Ordering:
+		if len(variants) < 2 {
+			return nil, errors.Wrap(ErrInvalidParam,
+				"minimum two variants for ordering question")
+		}
+		if len(correctAnswer) != len(variants) {
+			return nil, errors.Wrap(ErrInvalidParam,
+				"correct order must contain every variant exactly once")
+		}
+		for _, answer := range correctAnswer {
+			if !slices.Contains(variants, answer) {
+				return nil, errors.Wrapf(ErrInvalidParam,
+					"correct answer %q is not one of the variants", answer)
+			}
+		}
+		if len(uniqueStrings(variants)) != len(variants) ||
+			len(uniqueStrings(correctAnswer)) != len(correctAnswer) {
+			return nil, errors.Wrap(ErrInvalidParam,
+				"ordering question variants must be unique")
+		}
+		return NewOrderingQuestion(id, topic, subject, variants, correctAnswer,
+			cfg.partialCredit), nil
+
+	case Matching:
+		if len(correctAnswer) < 2 {
+			return nil, errors.Wrap(ErrInvalidParam, "minimum two pairs for matching question")
+		}
+		if len(uniqueStrings(variants)) != len(variants) {
+			return nil, errors.Wrap(ErrInvalidParam, "matching question variants must be unique")
+		}
+		pairs := make([]Pair, 0, len(correctAnswer))
+		lefts := make(map[string]struct{}, len(correctAnswer))
+		for _, answer := range correctAnswer {
+			pair, err := ParsePair(answer)
+			if err != nil {
+				return nil, err
+			}
+			if !slices.Contains(variants, pair.Right) {
+				return nil, errors.Wrapf(ErrInvalidParam,
+					"right item %q is not one of the variants", pair.Right)
+			}
+			if _, ok := lefts[pair.Left]; ok {
+				return nil, errors.Wrapf(ErrInvalidParam, "left item %q is matched twice",
+					pair.Left)
+			}
+			lefts[pair.Left] = struct{}{}
+			pairs = append(pairs, pair)
+		}
+		return NewMatchingQuestion(id, topic, subject, variants, pairs), nil
+
+	case Numeric:
+		if len(variants) != 0 {
+			return nil, errors.Wrap(ErrInvalidParam,
+				"variants are not offered for numeric question")
+		}
+		if len(correctAnswer) != 1 {
+			return nil, errors.Wrap(ErrInvalidParam,
+				"only one correct answer for this question type")
+		}
+		value, err := ParseNumber(correctAnswer[0])
+		if err != nil {
+			return nil, err
+		}
+		if err := cfg.tolerance.validate(); err != nil {
+			return nil, err
+		}
+		return NewNumericQuestion(id, topic, subject, value, cfg.tolerance), nil
 	}
 
 	return nil, errors.Wrapf(ErrInvalidParam, "unknown question type: %d", questionType)
 }
+
+func uniqueStrings(items []string) map[string]struct{} {
+	unique := make(map[string]struct{}, len(items))
+	for _, item := range items {
+		unique[item] = struct{}{}
+	}
+
+	return unique
+}
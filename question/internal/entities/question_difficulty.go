@@ -0,0 +1,154 @@
+package entities
+
+import (
+	"github.com/pkg/errors"
+)
+
+// Difficulty tells how hard the question is. Questions selection strategies use it to compose
+// sessions.
+type Difficulty string
+
+const (
+	DifficultyEasy   Difficulty = "easy"
+	DifficultyMedium Difficulty = "medium"
+	DifficultyHard   Difficulty = "hard"
+
+	DefaultDifficulty = DifficultyMedium
+)
+
+// Difficulties lists difficulty levels from the easiest one.
+func Difficulties() []Difficulty {
+	return []Difficulty{DifficultyEasy, DifficultyMedium, DifficultyHard}
+}
+
+// NewDifficulty parses difficulty name, empty name means DefaultDifficulty.
+func NewDifficulty(name string) (Difficulty, error) {
+	if name == "" {
+		return DefaultDifficulty, nil
+	}
+
+	difficulty := Difficulty(name)
+	if err := difficulty.Validate(); err != nil {
+		return "", err
+	}
+
+	return difficulty, nil
+}
+
+func (d Difficulty) Validate() error {
+	switch d {
+	case DifficultyEasy, DifficultyMedium, DifficultyHard:
+		return nil
+	}
+
+	return errors.Wrapf(ErrInvalidParam, "unknown question difficulty: %s", d)
+}
+
+// Rated is implemented by questions created with a difficulty.
+type Rated interface {
+	Difficulty() Difficulty
+}
+
+// questionDifficulty is embedded into every question type, so the factory can assign
+// difficulty without changing question constructors. Zero value means DefaultDifficulty.
+type questionDifficulty struct {
+	difficulty Difficulty
+}
+
+func (d *questionDifficulty) Difficulty() Difficulty {
+	if d.difficulty == "" {
+		return DefaultDifficulty
+	}
+
+	return d.difficulty
+}
+
+func (d *questionDifficulty) setDifficulty(difficulty Difficulty) {
+	d.difficulty = difficulty
+}
+
+// DifficultyOf returns difficulty of the question or DefaultDifficulty for questions which are
+// not rated.
+func DifficultyOf(question Question) Difficulty {
+	if rated, ok := question.(Rated); ok {
+		return rated.Difficulty()
+	}
+
+	return DefaultDifficulty
+}
+
+// DifficultyMix is the share of questions of every difficulty in a topic of the session in
+// percents.
+type DifficultyMix struct {
+	Easy   int
+	Medium int
+	Hard   int
+}
+
+// DefaultDifficultyMix takes 30% easy, 50% medium and 20% hard questions.
+func DefaultDifficultyMix() DifficultyMix {
+	return DifficultyMix{Easy: 30, Medium: 50, Hard: 20}
+}
+
+func (m DifficultyMix) Validate() error {
+	if m.Easy < 0 || m.Medium < 0 || m.Hard < 0 {
+		return errors.Wrap(ErrInvalidParam, "difficulty mix shares must not be negative")
+	}
+
+	if m.Easy+m.Medium+m.Hard != 100 {
+		return errors.Wrap(ErrInvalidParam, "difficulty mix shares must sum up to 100")
+	}
+
+	return nil
+}
+
+// Share returns percent of the questions of the difficulty.
+func (m DifficultyMix) Share(difficulty Difficulty) int {
+	switch difficulty {
+	case DifficultyEasy:
+		return m.Easy
+	case DifficultyMedium:
+		return m.Medium
+	case DifficultyHard:
+		return m.Hard
+	}
+
+	return 0
+}
+
+// SelectionStrategy names how questions of the topic are selected for new sessions.
+type SelectionStrategy string
+
+const (
+	// UniformSelection picks random questions regardless of their difficulty.
+	UniformSelection SelectionStrategy = "uniform"
+	// MixedSelection keeps the configured difficulty mix.
+	MixedSelection SelectionStrategy = "mixed"
+	// AdaptiveSelection picks harder questions for users with a strong history of the topic and
+	// easier ones for users with a weak history.
+	AdaptiveSelection SelectionStrategy = "adaptive"
+
+	DefaultSelectionStrategy = UniformSelection
+)
+
+// NewSelectionStrategy parses strategy name, empty name means DefaultSelectionStrategy.
+func NewSelectionStrategy(name string) (SelectionStrategy, error) {
+	if name == "" {
+		return DefaultSelectionStrategy, nil
+	}
+
+	strategy := SelectionStrategy(name)
+	switch strategy {
+	case UniformSelection, MixedSelection, AdaptiveSelection:
+		return strategy, nil
+	}
+
+	return "", errors.Wrapf(ErrInvalidParam, "unknown selection strategy: %s", name)
+}
+
+// TopicHistory summarizes completed exam sessions of the user on the topic.
+type TopicHistory struct {
+	Sessions int
+	// AverageScore is the mean score of the sessions in percents.
+	AverageScore float64
+}
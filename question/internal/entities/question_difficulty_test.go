@@ -0,0 +1,113 @@
+package entities_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/parta4ok/kvs/question/internal/entities"
+)
+
+func TestNewDifficulty(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name          string
+		difficulty    string
+		expected      entities.Difficulty
+		expectedError error
+	}{
+		{
+			name:     "default_medium",
+			expected: entities.DifficultyMedium,
+		},
+		{
+			name:       "easy",
+			difficulty: "easy",
+			expected:   entities.DifficultyEasy,
+		},
+		{
+			name:       "hard",
+			difficulty: "hard",
+			expected:   entities.DifficultyHard,
+		},
+		{
+			name:          "unknown",
+			difficulty:    "extreme",
+			expectedError: entities.ErrInvalidParam,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			difficulty, err := entities.NewDifficulty(tc.difficulty)
+			if tc.expectedError != nil {
+				require.ErrorIs(t, err, tc.expectedError)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, difficulty)
+		})
+	}
+}
+
+func TestQuestionFactory_WithDifficulty(t *testing.T) {
+	t.Parallel()
+
+	factory := &entities.QuestionFactory{}
+
+	question, err := factory.NewQuestion("1", entities.TrueOrFalse, "Go", "subject", nil,
+		[]string{"true"}, entities.WithDifficulty(entities.DifficultyHard))
+	require.NoError(t, err)
+	require.Equal(t, entities.DifficultyHard, entities.DifficultyOf(question))
+
+	question, err = factory.NewQuestion("1", entities.TrueOrFalse, "Go", "subject", nil,
+		[]string{"true"})
+	require.NoError(t, err)
+	require.Equal(t, entities.DefaultDifficulty, entities.DifficultyOf(question))
+
+	_, err = factory.NewQuestion("1", entities.TrueOrFalse, "Go", "subject", nil,
+		[]string{"true"}, entities.WithDifficulty("extreme"))
+	require.ErrorIs(t, err, entities.ErrInvalidParam)
+
+	require.Equal(t, entities.DefaultDifficulty, entities.DifficultyOf(
+		entities.NewTrueOrFalseSelectionQuestion("1", "Go", "subject", true)))
+
+	for _, question := range newQuestionsOfEveryType(t,
+		entities.WithDifficulty(entities.DifficultyHard)) {
+		_, ok := question.(entities.Rated)
+		require.True(t, ok, question.Type().String())
+		require.Equal(t, entities.DifficultyHard, entities.DifficultyOf(question),
+			question.Type().String())
+	}
+}
+
+func TestDifficultyMix_Validate(t *testing.T) {
+	t.Parallel()
+
+	require.NoError(t, entities.DefaultDifficultyMix().Validate())
+	require.NoError(t, entities.DifficultyMix{Hard: 100}.Validate())
+
+	require.ErrorIs(t, entities.DifficultyMix{Easy: 30, Medium: 30, Hard: 30}.Validate(),
+		entities.ErrInvalidParam)
+	require.ErrorIs(t, entities.DifficultyMix{Easy: -10, Medium: 60, Hard: 50}.Validate(),
+		entities.ErrInvalidParam)
+}
+
+func TestNewSelectionStrategy(t *testing.T) {
+	t.Parallel()
+
+	strategy, err := entities.NewSelectionStrategy("")
+	require.NoError(t, err)
+	require.Equal(t, entities.UniformSelection, strategy)
+
+	strategy, err = entities.NewSelectionStrategy("adaptive")
+	require.NoError(t, err)
+	require.Equal(t, entities.AdaptiveSelection, strategy)
+
+	_, err = entities.NewSelectionStrategy("hardest")
+	require.ErrorIs(t, err, entities.ErrInvalidParam)
+}
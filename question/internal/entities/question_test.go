@@ -1,6 +1,7 @@
 package entities_test
 
 import (
+	"strconv"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -31,6 +32,26 @@ func TestQuestionType_String(t *testing.T) {
 			questionType: entities.TrueOrFalse,
 			expected:     "true or false",
 		},
+		{
+			name:         "short_answer",
+			questionType: entities.ShortAnswer,
+			expected:     "short answer",
+		},
+		{
+			name:         "ordering",
+			questionType: entities.Ordering,
+			expected:     "ordering",
+		},
+		{
+			name:         "matching",
+			questionType: entities.Matching,
+			expected:     "matching",
+		},
+		{
+			name:         "numeric",
+			questionType: entities.Numeric,
+			expected:     "numeric",
+		},
 		{
 			name:         "unknown_type",
 			questionType: entities.QuestionType(999),
@@ -280,3 +301,145 @@ func TestQuestionFactory_NewQuestion_InvalidVariants(t *testing.T) {
 	require.ErrorIs(t, err, entities.ErrInvalidParam)
 	require.Nil(t, question)
 }
+
+func TestQuestionFactory_NewQuestion_CorrectAnswerNotInVariants(t *testing.T) {
+	t.Parallel()
+
+	factory := &entities.QuestionFactory{}
+
+	testCases := []struct {
+		name          string
+		questionType  entities.QuestionType
+		variants      []string
+		correctAnswer []string
+	}{
+		{
+			name:          "single_selection",
+			questionType:  entities.SingleSelection,
+			variants:      []string{"A", "B", "C", "D"},
+			correctAnswer: []string{"E"},
+		},
+		{
+			name:          "multi_selection",
+			questionType:  entities.MultiSelection,
+			variants:      []string{"A", "B", "C", "D"},
+			correctAnswer: []string{"A", "E"},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			question, err := factory.NewQuestion("1", tc.questionType, "Go", "What is Go?",
+				tc.variants, tc.correctAnswer)
+
+			require.ErrorIs(t, err, entities.ErrInvalidParam)
+			require.Nil(t, question)
+			require.Contains(t, err.Error(), "not one of the variants")
+		})
+	}
+}
+
+func TestQuestionFactory_NewQuestion_TrueOrFalse_InvalidCorrectAnswer(t *testing.T) {
+	t.Parallel()
+
+	factory := &entities.QuestionFactory{}
+
+	question, err := factory.NewQuestion("1", entities.TrueOrFalse, "Go", "Go is compiled language",
+		nil, []string{"maybe"})
+
+	require.ErrorIs(t, err, entities.ErrInvalidParam)
+	require.Nil(t, question)
+}
+
+func TestQuestion_CorrectAnswers(t *testing.T) {
+	t.Parallel()
+
+	factory := &entities.QuestionFactory{}
+
+	testCases := []struct {
+		name          string
+		questionType  entities.QuestionType
+		variants      []string
+		correctAnswer []string
+	}{
+		{
+			name:          "single_selection",
+			questionType:  entities.SingleSelection,
+			variants:      []string{"A", "B", "C", "D"},
+			correctAnswer: []string{"B"},
+		},
+		{
+			name:          "multi_selection",
+			questionType:  entities.MultiSelection,
+			variants:      []string{"A", "B", "C", "D"},
+			correctAnswer: []string{"A", "C"},
+		},
+		{
+			name:          "true_or_false",
+			questionType:  entities.TrueOrFalse,
+			correctAnswer: []string{"false"},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			question, err := factory.NewQuestion("1", tc.questionType, "Go", "subject",
+				tc.variants, tc.correctAnswer)
+			require.NoError(t, err)
+
+			require.Equal(t, tc.correctAnswer, question.CorrectAnswers())
+		})
+	}
+}
+
+func TestParseQuestionType(t *testing.T) {
+	t.Parallel()
+
+	for _, questionType := range []entities.QuestionType{
+		entities.SingleSelection, entities.MultiSelection, entities.TrueOrFalse,
+		entities.ShortAnswer, entities.Ordering, entities.Matching, entities.Numeric} {
+		parsed, err := entities.ParseQuestionType(questionType.String())
+		require.NoError(t, err)
+		require.Equal(t, questionType, parsed)
+	}
+
+	_, err := entities.ParseQuestionType("essay")
+	require.ErrorIs(t, err, entities.ErrInvalidParam)
+}
+
+// newQuestionsOfEveryType creates a question of every type with the options by the factory,
+// ids of the questions go from 1 in the order of the types.
+func newQuestionsOfEveryType(t *testing.T, opts ...entities.QuestionOption) []entities.Question {
+	t.Helper()
+
+	factory := &entities.QuestionFactory{}
+	testCases := []struct {
+		questionType entities.QuestionType
+		variants     []string
+		answers      []string
+	}{
+		{entities.SingleSelection, []string{"a", "b"}, []string{"a"}},
+		{entities.MultiSelection, []string{"a", "b", "c"}, []string{"a", "b"}},
+		{entities.TrueOrFalse, nil, []string{"true"}},
+		{entities.ShortAnswer, nil, []string{"nil"}},
+		{entities.Ordering, []string{"b", "a"}, []string{"a", "b"}},
+		{entities.Matching, []string{"0", "nil"}, []string{"int => 0", "*int => nil"}},
+		{entities.Numeric, nil, []string{"2.5"}},
+	}
+
+	questions := make([]entities.Question, 0, len(testCases))
+	for i, tc := range testCases {
+		question, err := factory.NewQuestion(strconv.Itoa(i+1), tc.questionType, "Go", "subject", tc.variants,
+			tc.answers, opts...)
+		require.NoError(t, err, tc.questionType.String())
+		questions = append(questions, question)
+	}
+
+	return questions
+}
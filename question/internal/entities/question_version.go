@@ -0,0 +1,40 @@
+package entities
+
+const (
+	// FirstQuestionVersion is the version of the created question, every edit adds one.
+	FirstQuestionVersion = 1
+)
+
+// Versioned is implemented by questions loaded from the question bank. Sessions keep versions
+// of their questions, so editing a question does not change completed sessions.
+type Versioned interface {
+	Version() int
+}
+
+// questionVersion is embedded into every question type, so the factory can assign version
+// without changing question constructors. Zero value means FirstQuestionVersion.
+type questionVersion struct {
+	version int
+}
+
+func (v *questionVersion) Version() int {
+	if v.version == 0 {
+		return FirstQuestionVersion
+	}
+
+	return v.version
+}
+
+func (v *questionVersion) setVersion(version int) {
+	v.version = version
+}
+
+// VersionOf returns version of the question or FirstQuestionVersion for questions which are not
+// versioned.
+func VersionOf(question Question) int {
+	if versioned, ok := question.(Versioned); ok {
+		return versioned.Version()
+	}
+
+	return FirstQuestionVersion
+}
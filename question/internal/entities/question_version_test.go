@@ -0,0 +1,117 @@
+package entities_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/parta4ok/kvs/question/internal/entities"
+)
+
+func TestQuestionFactory_WithVersion(t *testing.T) {
+	t.Parallel()
+
+	factory := &entities.QuestionFactory{}
+
+	question, err := factory.NewQuestion("1", entities.TrueOrFalse, "Go", "subject", nil,
+		[]string{"true"}, entities.WithVersion(3))
+	require.NoError(t, err)
+	require.Equal(t, 3, entities.VersionOf(question))
+
+	question, err = factory.NewQuestion("1", entities.TrueOrFalse, "Go", "subject", nil,
+		[]string{"true"})
+	require.NoError(t, err)
+	require.Equal(t, entities.FirstQuestionVersion, entities.VersionOf(question))
+
+	_, err = factory.NewQuestion("1", entities.TrueOrFalse, "Go", "subject", nil,
+		[]string{"true"}, entities.WithVersion(0))
+	require.ErrorIs(t, err, entities.ErrInvalidParam)
+
+	require.Equal(t, entities.FirstQuestionVersion, entities.VersionOf(
+		entities.NewTrueOrFalseSelectionQuestion("1", "Go", "subject", true)))
+}
+
+func TestQuestionFactory_OptionsRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	questions := newQuestionsOfEveryType(t, entities.WithVersion(3), entities.WithWeight(2),
+		entities.WithExplanation("explanation"), entities.WithDifficulty(entities.DifficultyEasy))
+	for _, question := range questions {
+		name := question.Type().String()
+
+		versioned, ok := question.(entities.Versioned)
+		require.True(t, ok, name)
+		require.Equal(t, 3, versioned.Version(), name)
+
+		weighted, ok := question.(entities.Weighted)
+		require.True(t, ok, name)
+		require.Equal(t, 2.0, weighted.Weight(), name)
+
+		explained, ok := question.(entities.Explained)
+		require.True(t, ok, name)
+		require.Equal(t, "explanation", explained.Explanation(), name)
+
+		rated, ok := question.(entities.Rated)
+		require.True(t, ok, name)
+		require.Equal(t, entities.DifficultyEasy, rated.Difficulty(), name)
+	}
+}
+
+func TestSession_Rescore(t *testing.T) {
+	t.Parallel()
+
+	factory := &entities.QuestionFactory{}
+	variants := []string{"a", "b", "c"}
+
+	wrongKey, err := factory.NewQuestion("1", entities.SingleSelection, "Go", "subject",
+		variants, []string{"b"})
+	require.NoError(t, err)
+	other, err := factory.NewQuestion("2", entities.SingleSelection, "Go", "other",
+		variants, []string{"c"})
+	require.NoError(t, err)
+	fixedKey, err := factory.NewQuestion("1", entities.SingleSelection, "Go", "subject",
+		variants, []string{"a"}, entities.WithVersion(2))
+	require.NoError(t, err)
+
+	policy := entities.DefaultSessionPolicy()
+	policy.Shuffle = entities.ShufflePolicy{Variants: true, Seed: 42}
+
+	session := entities.NewSessionWithCustomState("1", "1", []string{"Go"}, nil)
+	session.ChangeState(entities.NewInitSessionState(session))
+	require.ErrorIs(t, session.Rescore(fixedKey), entities.ErrInvalidState)
+
+	answers := []*entities.UserAnswer{
+		mustUserAnswer(t, "1", "a"),
+		mustUserAnswer(t, "2", "c"),
+	}
+	session.ChangeState(entities.NewCompletedSessionState(
+		map[string]entities.Question{"1": wrongKey, "2": other}, session, answers,
+		time.Now(), false, entities.WithCompletedStatePolicy(policy)))
+
+	result, err := session.GetSessionResult()
+	require.NoError(t, err)
+	require.Equal(t, 1, result.CorrectCount)
+	require.Equal(t, entities.OutcomeFailed, result.Outcome)
+
+	require.NoError(t, session.Rescore(fixedKey))
+
+	result, err = session.GetSessionResult()
+	require.NoError(t, err)
+	require.Equal(t, 2, result.CorrectCount)
+	require.Equal(t, entities.OutcomePassed, result.Outcome)
+
+	questions, err := session.GetQuestions()
+	require.NoError(t, err)
+	require.Equal(t, 2, entities.VersionOf(questions[0]))
+	require.Equal(t, entities.FirstQuestionVersion, entities.VersionOf(questions[1]))
+	// the new version is shown in the order of the session
+	shuffled := policy.Shuffle.ShuffleVariants(map[string]entities.Question{"1": fixedKey})
+	require.Equal(t, shuffled["1"].Variants(), questions[0].Variants())
+
+	unknown, err := factory.NewQuestion("3", entities.SingleSelection, "Go", "subject",
+		variants, []string{"a"})
+	require.NoError(t, err)
+	require.ErrorIs(t, session.Rescore(unknown), entities.ErrInvalidParam)
+	require.ErrorIs(t, session.Rescore(nil), entities.ErrInvalidParam)
+}
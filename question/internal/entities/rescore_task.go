@@ -0,0 +1,68 @@
+package entities
+
+import (
+	"fmt"
+	"time"
+)
+
+type RescoreStatus string
+
+const (
+	// RescorePending tasks wait for the rescore job.
+	RescorePending RescoreStatus = "pending"
+	// RescoreRunning tasks are processed by the rescore job. A running task which progress has
+	// not been saved for a while is taken again, its job is considered lost.
+	RescoreRunning RescoreStatus = "running"
+	// RescoreCompleted tasks rescored every session.
+	RescoreCompleted RescoreStatus = "completed"
+	// RescoreFailed tasks have sessions which were not rescored, starting the task again
+	// retries them.
+	RescoreFailed RescoreStatus = "failed"
+)
+
+// RescoreTask is the progress of grading completed sessions again with the version of the
+// question. Sessions are processed in the order of their ids, LastSessionID is the last
+// processed one.
+type RescoreTask struct {
+	QuestionID    string
+	Version       int
+	Status        RescoreStatus
+	LastSessionID string
+	Rescored      int
+	Failed        int
+	// Attempts counts how many times the task was taken by the rescore job.
+	Attempts  int
+	Error     string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// IsFinished reports whether the rescore job is done with the task.
+func (t *RescoreTask) IsFinished() bool {
+	return t.Status == RescoreCompleted || t.Status == RescoreFailed
+}
+
+// Finish completes the task once every session was processed. The task fails when some
+// sessions were not rescored.
+func (t *RescoreTask) Finish() {
+	if t.Failed > 0 {
+		t.Status = RescoreFailed
+		t.Error = fmt.Sprintf("%d sessions were not rescored", t.Failed)
+		return
+	}
+
+	t.Status = RescoreCompleted
+	t.Error = ""
+}
+
+// Interrupt returns the task to the queue after the error, so the rescore job retries it from
+// the last processed session. The task fails after maxAttempts attempts.
+func (t *RescoreTask) Interrupt(err error, maxAttempts int) {
+	t.Error = err.Error()
+	if t.Attempts >= maxAttempts {
+		t.Status = RescoreFailed
+		return
+	}
+
+	t.Status = RescorePending
+}
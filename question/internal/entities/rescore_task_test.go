@@ -0,0 +1,42 @@
+package entities_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/parta4ok/kvs/question/internal/entities"
+)
+
+func TestRescoreTask_Finish(t *testing.T) {
+	t.Parallel()
+
+	task := &entities.RescoreTask{Status: entities.RescoreRunning, Rescored: 3,
+		Error: "database error"}
+	require.False(t, task.IsFinished())
+
+	task.Finish()
+	require.Equal(t, entities.RescoreCompleted, task.Status)
+	require.Empty(t, task.Error)
+	require.True(t, task.IsFinished())
+
+	task = &entities.RescoreTask{Status: entities.RescoreRunning, Rescored: 3, Failed: 2}
+	task.Finish()
+	require.Equal(t, entities.RescoreFailed, task.Status)
+	require.Equal(t, "2 sessions were not rescored", task.Error)
+}
+
+func TestRescoreTask_Interrupt(t *testing.T) {
+	t.Parallel()
+
+	task := &entities.RescoreTask{Status: entities.RescoreRunning, Attempts: 1}
+	task.Interrupt(errors.New("database error"), 3)
+	require.Equal(t, entities.RescorePending, task.Status)
+	require.Equal(t, "database error", task.Error)
+
+	task.Attempts = 3
+	task.Interrupt(errors.New("database error"), 3)
+	require.Equal(t, entities.RescoreFailed, task.Status)
+	require.True(t, task.IsFinished())
+}
@@ -0,0 +1,113 @@
+package entities
+
+import (
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// ResultSnapshot is the result of the completed session as it was graded: answers, credit of
+// every question and the threshold the session had to reach. History is served from snapshots,
+// so it does not change when questions, scoring or thresholds of topics change later. Only
+// rescoring of the session rewrites its snapshot.
+type ResultSnapshot struct {
+	SessionID string
+	UserID    string
+	Mode      SessionMode
+	Topics    []string
+	StartedAt time.Time
+	// CompletedAt is set by the storage when the snapshot is stored.
+	CompletedAt time.Time
+	IsExpired   bool
+	// Result keeps the score and the outcome, questions and answers are kept by Items.
+	Result *SessionResult
+	Items  []ResultItem
+}
+
+// ResultItem is the graded answer to one question of the session. Items of the expired
+// session are graded too, although the session earns no score.
+type ResultItem struct {
+	QuestionID string
+	Version    int
+	Type       QuestionType
+	Topic      string
+	Subject    string
+	Weight     float64
+	Answered   bool
+	Selections []string
+	IsCorrect  bool
+	// Credit is share of the question weight the answer earns.
+	Credit float64
+}
+
+// Snapshot grades every question of the completed session with the policy of the session.
+// Items follow the order the questions were shown in.
+func (s *Session) Snapshot() (*ResultSnapshot, error) {
+	if s.GetStatus() != CompletedState {
+		return nil, errors.Wrapf(ErrInvalidState, "%s has no result snapshot", s.GetStatus())
+	}
+
+	result, err := s.GetSessionResult()
+	if err != nil {
+		return nil, errors.Wrap(err, "GetSessionResult")
+	}
+
+	questions, err := s.GetQuestions()
+	if err != nil {
+		return nil, errors.Wrap(err, "GetQuestions")
+	}
+
+	answers, err := s.GetUserAnswers()
+	if err != nil {
+		return nil, errors.Wrap(err, "GetUserAnswers")
+	}
+
+	startedAt, err := s.GetStartedAt()
+	if err != nil {
+		return nil, errors.Wrap(err, "GetStartedAt")
+	}
+
+	answersByQuestion := make(map[string]*UserAnswer, len(answers))
+	for _, answer := range answers {
+		answersByQuestion[answer.GetQuestionID()] = answer
+	}
+
+	items := make([]ResultItem, 0, len(questions))
+	for _, question := range questions {
+		item := ResultItem{
+			QuestionID: question.ID(),
+			Version:    VersionOf(question),
+			Type:       question.Type(),
+			Topic:      question.Topic(),
+			Subject:    question.Subject(),
+			Weight:     weightOf(question),
+			Selections: []string{},
+		}
+
+		if answer, ok := answersByQuestion[question.ID()]; ok {
+			item.Answered = true
+			item.Selections = answer.GetSelections()
+			item.IsCorrect = question.IsAnswerCorrect(answer)
+			item.Credit = s.policy.Scoring.Score(question, answer)
+		}
+
+		items = append(items, item)
+	}
+
+	result.Questions = nil
+	result.UserAnswers = nil
+	result.UserID = s.userID
+	result.Topics = s.topics
+	result.Mode = s.policy.Mode
+
+	return &ResultSnapshot{
+		SessionID: s.sessionID,
+		UserID:    s.userID,
+		Mode:      s.policy.Mode,
+		Topics:    s.topics,
+		StartedAt: startedAt,
+		IsExpired: result.IsExpire,
+		Result:    result,
+		Items:     items,
+	}, nil
+}
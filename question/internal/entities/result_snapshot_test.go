@@ -0,0 +1,87 @@
+package entities_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/parta4ok/kvs/question/internal/entities"
+)
+
+func TestSession_Snapshot(t *testing.T) {
+	t.Parallel()
+
+	factory := &entities.QuestionFactory{}
+	variants := []string{"a", "b", "c"}
+	startedAt := time.Date(2025, 9, 1, 10, 0, 0, 0, time.UTC)
+
+	first, err := factory.NewQuestion("1", entities.SingleSelection, "Go", "first",
+		variants, []string{"a"}, entities.WithWeight(2), entities.WithVersion(3))
+	require.NoError(t, err)
+	second, err := factory.NewQuestion("2", entities.SingleSelection, "Go", "second",
+		variants, []string{"b"})
+	require.NoError(t, err)
+	third, err := factory.NewQuestion("3", entities.SingleSelection, "SQL", "third",
+		variants, []string{"c"})
+	require.NoError(t, err)
+
+	session := entities.NewSessionWithCustomState("1", "1", []string{"Go", "SQL"}, nil)
+	session.ChangeState(entities.NewInitSessionState(session))
+	_, err = session.Snapshot()
+	require.ErrorIs(t, err, entities.ErrInvalidState)
+
+	session.ChangeState(entities.NewCompletedSessionState(
+		map[string]entities.Question{"1": first, "2": second, "3": third}, session,
+		[]*entities.UserAnswer{mustUserAnswer(t, "1", "a"), mustUserAnswer(t, "2", "c")},
+		startedAt, false))
+
+	snapshot, err := session.Snapshot()
+	require.NoError(t, err)
+	require.Equal(t, "1", snapshot.SessionID)
+	require.Equal(t, "1", snapshot.UserID)
+	require.Equal(t, entities.ExamMode, snapshot.Mode)
+	require.Equal(t, []string{"Go", "SQL"}, snapshot.Topics)
+	require.Equal(t, startedAt, snapshot.StartedAt)
+	require.False(t, snapshot.IsExpired)
+
+	require.Equal(t, 1, snapshot.Result.CorrectCount)
+	require.Equal(t, 3, snapshot.Result.TotalCount)
+	require.InDelta(t, 50.0, snapshot.Result.Score, 0.001)
+	require.InDelta(t, entities.DefaultBorderResult, snapshot.Result.PassThreshold, 0.001)
+	require.Equal(t, entities.OutcomeFailed, snapshot.Result.Outcome)
+	require.Nil(t, snapshot.Result.Questions)
+	require.Nil(t, snapshot.Result.UserAnswers)
+
+	require.Equal(t, []entities.ResultItem{
+		{QuestionID: "1", Version: 3, Type: entities.SingleSelection, Topic: "Go",
+			Subject: "first", Weight: 2, Answered: true, Selections: []string{"a"},
+			IsCorrect: true, Credit: 1},
+		{QuestionID: "2", Version: entities.FirstQuestionVersion,
+			Type: entities.SingleSelection, Topic: "Go", Subject: "second", Weight: 1,
+			Answered: true, Selections: []string{"c"}},
+		{QuestionID: "3", Version: entities.FirstQuestionVersion,
+			Type: entities.SingleSelection, Topic: "SQL", Subject: "third", Weight: 1,
+			Selections: []string{}},
+	}, snapshot.Items)
+}
+
+func TestSession_Snapshot_Expired(t *testing.T) {
+	t.Parallel()
+
+	question := entities.NewSingleSelectionQuestion("1", "Go", "subject",
+		[]string{"a", "b"}, "a")
+
+	session := entities.NewSessionWithCustomState("1", "1", []string{"Go"}, nil)
+	session.ChangeState(entities.NewCompletedSessionState(
+		map[string]entities.Question{"1": question}, session,
+		[]*entities.UserAnswer{mustUserAnswer(t, "1", "a")}, time.Now(), true))
+
+	snapshot, err := session.Snapshot()
+	require.NoError(t, err)
+	require.True(t, snapshot.IsExpired)
+	require.Equal(t, entities.OutcomeExpired, snapshot.Result.Outcome)
+	require.Zero(t, snapshot.Result.CorrectCount)
+	require.Len(t, snapshot.Items, 1)
+	require.True(t, snapshot.Items[0].IsCorrect)
+}
@@ -0,0 +1,160 @@
+package entities
+
+import (
+	"github.com/pkg/errors"
+)
+
+const (
+	AllOrNothingScoring    = "all or nothing"
+	PartialCreditScoring   = "partial credit"
+	NegativeMarkingScoring = "negative marking"
+
+	DefaultNegativeMarkingPenalty = 0.25
+	DefaultQuestionWeight         = 1.0
+
+	// minScore limits negative marking, so a question never costs more than its weight.
+	minScore = -1.0
+)
+
+var (
+	_ ScoringStrategy = (*AllOrNothingStrategy)(nil)
+	_ ScoringStrategy = (*PartialCreditStrategy)(nil)
+	_ ScoringStrategy = (*NegativeMarkingStrategy)(nil)
+)
+
+// ScoringStrategy evaluates a single answer. Score returns share of the question weight the
+// answer earns: 1 for the correct answer, a value below 0 when the strategy penalizes wrong
+// answers.
+type ScoringStrategy interface {
+	Name() string
+	Score(question Question, answer *UserAnswer) float64
+}
+
+// PickCounter is implemented by questions where the student picks any number of variants.
+// Picks returns numbers of picked correct and picked wrong variants.
+type PickCounter interface {
+	Picks(answer *UserAnswer) (hits, misses int)
+}
+
+// Weighted is implemented by questions created with a custom weight.
+type Weighted interface {
+	Weight() float64
+}
+
+// DefaultScoringStrategy grades the way sessions were graded before strategies were introduced,
+// partial credit and negative marking are opt-in.
+func DefaultScoringStrategy() ScoringStrategy {
+	return &AllOrNothingStrategy{}
+}
+
+// NewScoringStrategy builds strategy by its name. Penalty is used by negative marking only,
+// nil penalty means DefaultNegativeMarkingPenalty.
+func NewScoringStrategy(name string, penalty *float64) (ScoringStrategy, error) {
+	switch name {
+	case AllOrNothingScoring:
+		return &AllOrNothingStrategy{}, nil
+	case PartialCreditScoring:
+		return &PartialCreditStrategy{}, nil
+	case NegativeMarkingScoring:
+		if penalty == nil {
+			return &NegativeMarkingStrategy{penalty: DefaultNegativeMarkingPenalty}, nil
+		}
+		if *penalty < 0 || *penalty > 1 {
+			return nil, errors.Wrap(ErrInvalidParam, "negative marking penalty must be in [0, 1]")
+		}
+		return &NegativeMarkingStrategy{penalty: *penalty}, nil
+	}
+
+	return nil, errors.Wrapf(ErrInvalidParam, "unknown scoring strategy: %s", name)
+}
+
+// AllOrNothingStrategy gives full score for the exactly correct answer only.
+type AllOrNothingStrategy struct{}
+
+func (s *AllOrNothingStrategy) Name() string {
+	return AllOrNothingScoring
+}
+
+func (s *AllOrNothingStrategy) Score(question Question, answer *UserAnswer) float64 {
+	if question.IsAnswerCorrect(answer) {
+		return 1
+	}
+
+	return 0
+}
+
+// PartialCreditStrategy gives proportional credit to questions implementing CreditScorer,
+// other questions are scored all or nothing.
+type PartialCreditStrategy struct{}
+
+func (s *PartialCreditStrategy) Name() string {
+	return PartialCreditScoring
+}
+
+func (s *PartialCreditStrategy) Score(question Question, answer *UserAnswer) float64 {
+	if scorer, ok := question.(CreditScorer); ok {
+		return scorer.Credit(answer)
+	}
+
+	return (&AllOrNothingStrategy{}).Score(question, answer)
+}
+
+// NegativeMarkingStrategy subtracts penalty for every wrong pick. Questions implementing
+// PickCounter earn share of picked correct variants minus penalty for every picked wrong
+// variant, other questions are scored like PartialCreditStrategy minus one penalty for a not
+// completely correct answer. Skipped questions are not penalized, score is not below -1.
+type NegativeMarkingStrategy struct {
+	penalty float64
+}
+
+func (s *NegativeMarkingStrategy) Name() string {
+	return NegativeMarkingScoring
+}
+
+func (s *NegativeMarkingStrategy) Penalty() float64 {
+	return s.penalty
+}
+
+func (s *NegativeMarkingStrategy) Score(question Question, answer *UserAnswer) float64 {
+	if len(answer.answer) == 0 {
+		return 0
+	}
+	if question.IsAnswerCorrect(answer) {
+		return 1
+	}
+
+	if counter, ok := question.(PickCounter); ok && len(question.CorrectAnswers()) > 0 {
+		hits, misses := counter.Picks(answer)
+		score := float64(hits)/float64(len(question.CorrectAnswers())) -
+			s.penalty*float64(misses)
+		return max(minScore, score)
+	}
+
+	return max(minScore, (&PartialCreditStrategy{}).Score(question, answer)-s.penalty)
+}
+
+// questionWeight is embedded into every question type, so the factory can assign weight
+// without changing question constructors. Zero value means DefaultQuestionWeight.
+type questionWeight struct {
+	weight float64
+}
+
+func (w *questionWeight) Weight() float64 {
+	if w.weight == 0 {
+		return DefaultQuestionWeight
+	}
+
+	return w.weight
+}
+
+func (w *questionWeight) setWeight(weight float64) {
+	w.weight = weight
+}
+
+func weightOf(question Question) float64 {
+	if weighted, ok := question.(Weighted); ok {
+		return weighted.Weight()
+	}
+
+	return DefaultQuestionWeight
+}
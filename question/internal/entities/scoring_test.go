@@ -0,0 +1,282 @@
+package entities_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/parta4ok/kvs/question/internal/entities"
+	"github.com/parta4ok/kvs/question/internal/entities/testdata"
+)
+
+func TestNewScoringStrategy(t *testing.T) {
+	t.Parallel()
+
+	for _, name := range []string{entities.AllOrNothingScoring, entities.PartialCreditScoring,
+		entities.NegativeMarkingScoring} {
+		strategy, err := entities.NewScoringStrategy(name, nil)
+		require.NoError(t, err)
+		require.Equal(t, name, strategy.Name())
+	}
+
+	require.Equal(t, entities.AllOrNothingScoring, entities.DefaultScoringStrategy().Name())
+
+	strategy, err := entities.NewScoringStrategy(entities.NegativeMarkingScoring, nil)
+	require.NoError(t, err)
+	require.Equal(t, entities.DefaultNegativeMarkingPenalty,
+		strategy.(*entities.NegativeMarkingStrategy).Penalty())
+
+	zero := 0.0
+	strategy, err = entities.NewScoringStrategy(entities.NegativeMarkingScoring, &zero)
+	require.NoError(t, err)
+	require.Zero(t, strategy.(*entities.NegativeMarkingStrategy).Penalty())
+
+	invalid := 1.5
+	_, err = entities.NewScoringStrategy(entities.NegativeMarkingScoring, &invalid)
+	require.ErrorIs(t, err, entities.ErrInvalidParam)
+
+	_, err = entities.NewScoringStrategy("best of three", nil)
+	require.ErrorIs(t, err, entities.ErrInvalidParam)
+}
+
+func TestScoringStrategy_Score(t *testing.T) {
+	t.Parallel()
+
+	multi := entities.NewMultiSelectionQuestion("1", "Go", "Какие типы ссылочные?",
+		[]string{"slice", "map", "chan", "array"}, []string{"slice", "map", "chan"})
+	single := entities.NewSingleSelectionQuestion("2", "Go", "Нулевое значение указателя",
+		[]string{"nil", "0"}, "nil")
+
+	multiWide := entities.NewMultiSelectionQuestion("3", "Go", "Какие типы ссылочные?",
+		[]string{"slice", "map", "chan", "array", "struct", "int"}, []string{"slice", "map", "chan"})
+
+	allOrNothing, err := entities.NewScoringStrategy(entities.AllOrNothingScoring, nil)
+	require.NoError(t, err)
+	partial, err := entities.NewScoringStrategy(entities.PartialCreditScoring, nil)
+	require.NoError(t, err)
+	penalty := 0.5
+	negative, err := entities.NewScoringStrategy(entities.NegativeMarkingScoring, &penalty)
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name     string
+		question entities.Question
+		answer   []string
+		expected map[entities.ScoringStrategy]float64
+	}{
+		{
+			name:     "multi_correct",
+			question: multi,
+			answer:   []string{"chan", "slice", "map"},
+			expected: map[entities.ScoringStrategy]float64{allOrNothing: 1, partial: 1, negative: 1},
+		},
+		{
+			name:     "multi_two_of_three",
+			question: multi,
+			answer:   []string{"slice", "map"},
+			expected: map[entities.ScoringStrategy]float64{
+				allOrNothing: 0, partial: 2.0 / 3, negative: 2.0 / 3},
+		},
+		{
+			name:     "multi_every_variant",
+			question: multi,
+			answer:   []string{"slice", "map", "chan", "array"},
+			expected: map[entities.ScoringStrategy]float64{
+				allOrNothing: 0, partial: 2.0 / 3, negative: 1 - 0.5},
+		},
+		{
+			name:     "multi_penalty_per_wrong_pick",
+			question: multiWide,
+			answer:   []string{"slice", "map", "array", "struct"},
+			expected: map[entities.ScoringStrategy]float64{
+				allOrNothing: 0, partial: 0, negative: 2.0/3 - 2*0.5},
+		},
+		{
+			name:     "multi_penalty_is_bounded",
+			question: multiWide,
+			answer:   []string{"array", "struct", "int"},
+			expected: map[entities.ScoringStrategy]float64{allOrNothing: 0, partial: 0, negative: -1},
+		},
+		{
+			name:     "multi_wrong_only",
+			question: multi,
+			answer:   []string{"array"},
+			expected: map[entities.ScoringStrategy]float64{allOrNothing: 0, partial: 0, negative: -0.5},
+		},
+		{
+			name:     "single_wrong",
+			question: single,
+			answer:   []string{"0"},
+			expected: map[entities.ScoringStrategy]float64{allOrNothing: 0, partial: 0, negative: -0.5},
+		},
+		{
+			name:     "skipped",
+			question: single,
+			answer:   []string{},
+			expected: map[entities.ScoringStrategy]float64{allOrNothing: 0, partial: 0, negative: 0},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			answer, err := entities.NewUserAnswer(tc.question.ID(), tc.answer)
+			require.NoError(t, err)
+
+			for strategy, expected := range tc.expected {
+				require.InDelta(t, expected, strategy.Score(tc.question, answer), 1e-9,
+					strategy.Name())
+			}
+		})
+	}
+}
+
+func TestQuestionFactory_NewQuestion_Weight(t *testing.T) {
+	t.Parallel()
+
+	factory := &entities.QuestionFactory{}
+
+	question, err := factory.NewQuestion("1", entities.TrueOrFalse, "Go", "subject", nil,
+		[]string{"true"})
+	require.NoError(t, err)
+	require.Equal(t, entities.DefaultQuestionWeight, question.(entities.Weighted).Weight())
+
+	question, err = factory.NewQuestion("1", entities.TrueOrFalse, "Go", "subject", nil,
+		[]string{"true"}, entities.WithWeight(2.5))
+	require.NoError(t, err)
+	require.Equal(t, 2.5, question.(entities.Weighted).Weight())
+
+	_, err = factory.NewQuestion("1", entities.TrueOrFalse, "Go", "subject", nil,
+		[]string{"true"}, entities.WithWeight(0))
+	require.ErrorIs(t, err, entities.ErrInvalidParam)
+
+	for _, question := range newQuestionsOfEveryType(t, entities.WithWeight(2.5)) {
+		weighted, ok := question.(entities.Weighted)
+		require.True(t, ok, question.Type().String())
+		require.Equal(t, 2.5, weighted.Weight(), question.Type().String())
+	}
+}
+
+func TestCompletedSessionState_GetSessionResult_WeightedTypes(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	factory := &entities.QuestionFactory{}
+	matching, err := factory.NewQuestion("1", entities.Matching, "Go", "Нулевые значения",
+		[]string{"0", "nil"}, []string{"int => 0", "*int => nil"}, entities.WithWeight(3))
+	require.NoError(t, err)
+	numeric, err := factory.NewQuestion("2", entities.Numeric, "Go", "Размер int32 в байтах",
+		nil, []string{"4"}, entities.WithWeight(1))
+	require.NoError(t, err)
+
+	matchingAnswer, err := entities.NewUserAnswer("1", []string{"int => 0", "*int => nil"})
+	require.NoError(t, err)
+	numericAnswer, err := entities.NewUserAnswer("2", []string{"8"})
+	require.NoError(t, err)
+
+	state := entities.NewCompletedSessionState(
+		map[string]entities.Question{"1": matching, "2": numeric},
+		testdata.NewMockStateHolder(ctrl), []*entities.UserAnswer{matchingAnswer, numericAnswer},
+		time.Now(), false)
+
+	// the correct matching question brings 3 of 4 points
+	result, err := state.GetSessionResult()
+	require.NoError(t, err)
+	require.InDelta(t, 75, result.Score, 1e-9)
+}
+
+func TestCompletedSessionState_GetSessionResult_ScoringPolicy(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	factory := &entities.QuestionFactory{}
+	heavy, err := factory.NewQuestion("1", entities.MultiSelection, "Go", "Ссылочные типы",
+		[]string{"slice", "map", "chan", "array"}, []string{"slice", "map", "chan", "array"},
+		entities.WithWeight(3))
+	require.NoError(t, err)
+	light, err := factory.NewQuestion("2", entities.TrueOrFalse, "Go", "string изменяем",
+		nil, []string{"false"})
+	require.NoError(t, err)
+
+	questions := map[string]entities.Question{"1": heavy, "2": light}
+
+	heavyAnswer, err := entities.NewUserAnswer("1", []string{"slice", "map", "chan"})
+	require.NoError(t, err)
+	lightAnswer, err := entities.NewUserAnswer("2", []string{"true"})
+	require.NoError(t, err)
+	answers := []*entities.UserAnswer{heavyAnswer, lightAnswer}
+
+	testCases := []struct {
+		name     string
+		strategy string
+		score    float64
+		success  bool
+	}{
+		{name: "all_or_nothing", strategy: entities.AllOrNothingScoring, score: 0},
+		{name: "partial_credit", strategy: entities.PartialCreditScoring, score: 56.25},
+		{name: "negative_marking", strategy: entities.NegativeMarkingScoring, score: 31.25},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			penalty := 1.0
+			strategy, err := entities.NewScoringStrategy(tc.strategy, &penalty)
+			require.NoError(t, err)
+
+			state := entities.NewCompletedSessionState(questions,
+				testdata.NewMockStateHolder(ctrl), answers, time.Now(), false,
+				entities.WithCompletedStatePolicy(entities.SessionPolicy{Scoring: strategy}))
+
+			result, err := state.GetSessionResult()
+			require.NoError(t, err)
+			require.InDelta(t, tc.score, result.Score, 1e-9)
+			require.Equal(t, tc.success, result.IsSuccess)
+		})
+	}
+}
+
+func TestSession_WithPolicy(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	generator := testdata.NewMockIDGenerator(ctrl)
+	generator.EXPECT().GenerateID().Return("1").Times(2)
+
+	session, err := entities.NewSession("1", []string{"Go"}, generator)
+	require.NoError(t, err)
+	require.Equal(t, entities.AllOrNothingScoring, session.GetPolicy().Scoring.Name())
+
+	strategy, err := entities.NewScoringStrategy(entities.PartialCreditScoring, nil)
+	require.NoError(t, err)
+
+	session, err = entities.NewSession("1", []string{"Go"}, generator,
+		entities.WithPolicy(entities.SessionPolicy{Scoring: strategy}))
+	require.NoError(t, err)
+	require.Equal(t, entities.PartialCreditScoring, session.GetPolicy().Scoring.Name())
+
+	question := entities.NewMultiSelectionQuestion("3", "Go", "subject",
+		[]string{"A", "B"}, []string{"A", "B"})
+	require.NoError(t, session.SetQuestions(map[string]entities.Question{"3": question},
+		time.Minute))
+
+	answer, err := entities.NewUserAnswer("3", []string{"A"})
+	require.NoError(t, err)
+	require.NoError(t, session.SetUserAnswer([]*entities.UserAnswer{answer}))
+
+	result, err := session.GetSessionResult()
+	require.NoError(t, err)
+	require.InDelta(t, 50, result.Score, 1e-9)
+}
@@ -1,7 +1,6 @@
 package entities
 
 import (
-	"context"
 	"time"
 
 	"github.com/pkg/errors"
@@ -11,8 +10,42 @@ type Session struct {
 	userID    string
 	sessionID string
 	topics    []string
+	policy    SessionPolicy
 
-	state SessionState
+	state        SessionState
+	withoutState bool
+}
+
+// SessionPolicy holds the rules the session is graded with. The policy is fixed when the
+// session is created and stored with it, so the result does not change after the service
+// configuration changes.
+type SessionPolicy struct {
+	Mode          SessionMode
+	Scoring       ScoringStrategy
+	PassThreshold PassThresholdPolicy
+	Pause         PausePolicy
+	Review        ReviewPolicy
+	Shuffle       ShufflePolicy
+}
+
+func DefaultSessionPolicy() SessionPolicy {
+	return SessionPolicy{
+		Mode:          ExamMode,
+		Scoring:       DefaultScoringStrategy(),
+		PassThreshold: DefaultPassThresholdPolicy(),
+		Review:        DefaultReviewPolicy(),
+	}
+}
+
+// IsTimed tells whether the session duration limit is enforced. Practice sessions are untimed.
+func (p SessionPolicy) IsTimed() bool {
+	return p.Mode != PracticeMode
+}
+
+// AllowsPause tells whether the session paused the given number of times may be paused again.
+// Practice sessions may be paused without limit.
+func (p SessionPolicy) AllowsPause(pauses int) bool {
+	return !p.IsTimed() || p.Pause.Allows(pauses)
 }
 
 type SessionOption func(*Session)
@@ -23,9 +56,28 @@ func WithSessionID(sessionID string) SessionOption {
 	}
 }
 
+// WithNilState leaves session without state, so the caller sets it with ChangeState.
 func WithNilState() SessionOption {
 	return func(s *Session) {
-		s.state = nil
+		s.withoutState = true
+	}
+}
+
+func WithPolicy(policy SessionPolicy) SessionOption {
+	return func(s *Session) {
+		if policy.Scoring == nil {
+			policy.Scoring = DefaultScoringStrategy()
+		}
+		if policy.PassThreshold.Resolution == "" {
+			policy.PassThreshold.Resolution = StrictestThreshold
+		}
+		if policy.Mode == "" {
+			policy.Mode = ExamMode
+		}
+		if policy.Review.Visibility == "" {
+			policy.Review.Visibility = ReviewImmediately
+		}
+		s.policy = policy
 	}
 }
 
@@ -36,7 +88,7 @@ func (s *Session) setOptions(opts ...SessionOption) {
 }
 
 func NewSession(userID string, topics []string, generator IDGenerator,
-	sessionStorage SessionStorage, opts ...SessionOption) (*Session, error) {
+	opts ...SessionOption) (*Session, error) {
 	if userID == "" {
 		return nil, errors.Wrap(ErrInvalidParam, "invalid userID")
 	}
@@ -45,10 +97,6 @@ func NewSession(userID string, topics []string, generator IDGenerator,
 		return nil, errors.Wrap(ErrInvalidParam, "id generator not set")
 	}
 
-	if sessionStorage == nil {
-		return nil, errors.Wrap(ErrInvalidParam, "session storage not set")
-	}
-
 	if len(topics) == 0 {
 		return nil, errors.Wrap(ErrInvalidParam, "topics was not selected")
 	}
@@ -59,13 +107,16 @@ func NewSession(userID string, topics []string, generator IDGenerator,
 		userID:    userID,
 		sessionID: sessionID,
 		topics:    topics,
+		policy:    DefaultSessionPolicy(),
 	}
 
-	state := NewInitSessionState(session, sessionStorage)
-	session.ChangeState(state)
-
 	session.setOptions(opts...)
 
+	if !session.withoutState {
+		state := NewInitSessionState(session, WithInitStatePolicy(session.policy))
+		session.ChangeState(state)
+	}
+
 	return session, nil
 }
 
@@ -75,6 +126,7 @@ func NewSessionWithCustomState(sessionID string, userID string, topics []string,
 		userID:    userID,
 		sessionID: sessionID,
 		topics:    topics,
+		policy:    DefaultSessionPolicy(),
 		state:     state,
 	}
 }
@@ -87,6 +139,18 @@ type SessionResult struct {
 	IsExpire    bool
 	IsSuccess   bool
 	Grade       string
+	// Score is the weighted share of earned points in percents.
+	Score float64
+	// CorrectCount is the number of questions answered with full credit.
+	CorrectCount int
+	// TotalCount is the number of questions of the session.
+	TotalCount int
+	Outcome    Outcome
+	Mode       SessionMode
+	// TopicScores is the breakdown of the result by topics sorted by topic name.
+	TopicScores []TopicScore
+	// PassThreshold is the score in percents the session had to reach to be passed.
+	PassThreshold float64
 }
 
 func (s *Session) GetSesionID() string {
@@ -100,6 +164,10 @@ func (s *Session) GetTopics() []string {
 	return s.topics
 }
 
+func (s *Session) GetPolicy() SessionPolicy {
+	return s.policy
+}
+
 func (s *Session) ChangeState(state SessionState) {
 	s.state = nil
 	s.state = state
@@ -141,7 +209,142 @@ func (s *Session) GetUserAnswers() ([]*UserAnswer, error) {
 	return s.state.GetUserAnswers()
 }
 
-func (s *Session) IsDailySessionLimitReached(ctx context.Context, userID string,
-	topics []string) (bool, error) {
-	return s.state.IsDailySessionLimitReached(ctx, userID, topics)
+func (s *Session) Pause(now time.Time) error {
+	return s.state.Pause(now)
+}
+
+func (s *Session) Resume(now time.Time) error {
+	return s.state.Resume(now)
+}
+
+func (s *Session) GetClock() (SessionClock, error) {
+	return s.state.GetClock()
+}
+
+// GetDeadline returns time the active session expires at if it is not paused again.
+func (s *Session) GetDeadline() (time.Time, error) {
+	clock, err := s.GetClock()
+	if err != nil {
+		return time.Time{}, errors.Wrap(err, "GetClock")
+	}
+
+	if !clock.IsRunning() {
+		return time.Time{}, errors.Wrapf(ErrInvalidState, "%s has no deadline", s.GetStatus())
+	}
+
+	duration, err := s.GetSessionDurationLimit()
+	if err != nil {
+		return time.Time{}, errors.Wrap(err, "GetSessionDurationLimit")
+	}
+
+	return clock.RunningSince.Add(duration - clock.Elapsed), nil
+}
+
+// GetRemainingTime returns active time left before the session expires, never negative. The
+// remaining time of the paused session does not decrease.
+func (s *Session) GetRemainingTime(now time.Time) (time.Duration, error) {
+	clock, err := s.GetClock()
+	if err != nil {
+		return 0, errors.Wrap(err, "GetClock")
+	}
+
+	duration, err := s.GetSessionDurationLimit()
+	if err != nil {
+		return 0, errors.Wrap(err, "GetSessionDurationLimit")
+	}
+
+	return max(0, duration-clock.ElapsedAt(now)), nil
+}
+
+// CheckDraftAnswer checks that the answer can be saved before the session is completed: the
+// session is active, its deadline has not passed and the answer belongs to its question.
+func (s *Session) CheckDraftAnswer(answer *UserAnswer, now time.Time) error {
+	if answer == nil {
+		return errors.Wrap(ErrInvalidParam, "answer not set")
+	}
+
+	if s.GetStatus() != ActiveState {
+		return errors.Wrapf(ErrInvalidState, "%s not support saving answers", s.GetStatus())
+	}
+
+	if s.policy.IsTimed() {
+		deadline, err := s.GetDeadline()
+		if err != nil {
+			return errors.Wrap(err, "GetDeadline")
+		}
+
+		if now.After(deadline) {
+			return errors.Wrap(ErrInvalidState, "session expired")
+		}
+	}
+
+	if _, err := s.findQuestion(answer.GetQuestionID()); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// CheckAnswer evaluates the answer of the active practice session without completing it.
+func (s *Session) CheckAnswer(answer *UserAnswer) (*AnswerFeedback, error) {
+	if answer == nil {
+		return nil, errors.Wrap(ErrInvalidParam, "answer not set")
+	}
+
+	if s.policy.Mode != PracticeMode {
+		return nil, errors.Wrapf(ErrForbidden, "%s session not support checking answers",
+			s.policy.Mode)
+	}
+
+	if s.GetStatus() != ActiveState {
+		return nil, errors.Wrapf(ErrInvalidState, "%s not support checking answers", s.GetStatus())
+	}
+
+	question, err := s.findQuestion(answer.GetQuestionID())
+	if err != nil {
+		return nil, err
+	}
+
+	return &AnswerFeedback{
+		QuestionID:     question.ID(),
+		IsCorrect:      question.IsAnswerCorrect(answer),
+		Credit:         s.policy.Scoring.Score(question, answer),
+		CorrectAnswers: question.CorrectAnswers(),
+	}, nil
+}
+
+// Rescore grades answers of the completed session against another version of one of its
+// questions, the other questions keep their versions.
+func (s *Session) Rescore(question Question) error {
+	if question == nil {
+		return errors.Wrap(ErrInvalidParam, "question not set")
+	}
+
+	completed, ok := s.state.(*CompletedSessionState)
+	if !ok {
+		return errors.Wrapf(ErrInvalidState, "%s not support rescoring", s.GetStatus())
+	}
+
+	if _, ok := completed.questions[question.ID()]; !ok {
+		return errors.Wrapf(ErrInvalidParam, "question %s not included in session",
+			question.ID())
+	}
+
+	s.ChangeState(completed.withQuestion(question))
+	return nil
+}
+
+func (s *Session) findQuestion(questionID string) (Question, error) {
+	questions, err := s.GetQuestions()
+	if err != nil {
+		return nil, errors.Wrap(err, "GetQuestions")
+	}
+
+	for _, question := range questions {
+		if question.ID() == questionID {
+			return question, nil
+		}
+	}
+
+	return nil, errors.Wrapf(ErrInvalidParam, "question %s not included in session", questionID)
 }
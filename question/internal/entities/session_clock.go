@@ -0,0 +1,44 @@
+package entities
+
+import "time"
+
+// SessionClock tracks active time of the session across pauses, so the session duration limit
+// is compared with the time the session was active rather than with wall-clock time.
+type SessionClock struct {
+	// Elapsed is active time accumulated before the current run.
+	Elapsed time.Duration
+	// RunningSince is the start of the current run. It is zero while the session is paused.
+	RunningSince time.Time
+	// Pauses is the number of pauses taken.
+	Pauses int
+}
+
+func (c SessionClock) IsRunning() bool {
+	return !c.RunningSince.IsZero()
+}
+
+// ElapsedAt returns active time of the session at the given moment.
+func (c SessionClock) ElapsedAt(now time.Time) time.Duration {
+	if !c.IsRunning() || now.Before(c.RunningSince) {
+		return c.Elapsed
+	}
+
+	return c.Elapsed + now.Sub(c.RunningSince)
+}
+
+// Stop ends the current run and counts the pause.
+func (c SessionClock) Stop(now time.Time) SessionClock {
+	return SessionClock{
+		Elapsed: c.ElapsedAt(now),
+		Pauses:  c.Pauses + 1,
+	}
+}
+
+// Start begins a new run.
+func (c SessionClock) Start(now time.Time) SessionClock {
+	return SessionClock{
+		Elapsed:      c.Elapsed,
+		RunningSince: now,
+		Pauses:       c.Pauses,
+	}
+}
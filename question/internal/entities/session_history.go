@@ -0,0 +1,228 @@
+package entities
+
+import (
+	"encoding/base64"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	// DefaultHistoryLimit is the page size of the history requested without a limit.
+	DefaultHistoryLimit = 20
+	// MaxHistoryLimit is the largest page size the history may be requested with.
+	MaxHistoryLimit = 100
+
+	cursorSeparator = "|"
+	cursorParts     = 5
+)
+
+// HistorySort tells which value completed sessions of the history are ordered by. Sessions with
+// the same value are ordered by completion time and id, so the order is stable.
+type HistorySort string
+
+const (
+	SortByDate  HistorySort = "date"
+	SortByScore HistorySort = "score"
+)
+
+// NewHistorySort parses sort of the history, empty sort means SortByDate.
+func NewHistorySort(sort string) (HistorySort, error) {
+	switch HistorySort(sort) {
+	case "", SortByDate:
+		return SortByDate, nil
+	case SortByScore:
+		return SortByScore, nil
+	}
+
+	return "", errors.Wrapf(ErrInvalidParam, "unknown history sort: %s", sort)
+}
+
+type SortOrder string
+
+const (
+	Descending SortOrder = "desc"
+	Ascending  SortOrder = "asc"
+)
+
+// NewSortOrder parses order of the history, empty order means Descending.
+func NewSortOrder(order string) (SortOrder, error) {
+	switch SortOrder(order) {
+	case "", Descending:
+		return Descending, nil
+	case Ascending:
+		return Ascending, nil
+	}
+
+	return "", errors.Wrapf(ErrInvalidParam, "unknown sort order: %s", order)
+}
+
+// NewOutcome parses outcome of the completed session.
+func NewOutcome(outcome string) (Outcome, error) {
+	switch Outcome(outcome) {
+	case OutcomePassed, OutcomeFailed, OutcomeExpired:
+		return Outcome(outcome), nil
+	}
+
+	return "", errors.Wrapf(ErrInvalidParam, "unknown outcome: %s", outcome)
+}
+
+// HistoryFilter selects completed sessions of the history. Zero fields do not filter: sessions
+// on the topic, completed from From inclusive to To exclusive, with one of Outcomes.
+type HistoryFilter struct {
+	Topic    string
+	From     time.Time
+	To       time.Time
+	Outcomes []Outcome
+}
+
+// HistoryCursor points to the last session of the previous page. It is bound to the sort and
+// the order of the history it was returned with.
+type HistoryCursor struct {
+	Sort        HistorySort
+	Order       SortOrder
+	CompletedAt time.Time
+	Score       float64
+	SessionID   string
+}
+
+// String encodes the cursor for clients, who pass it back unchanged.
+func (c HistoryCursor) String() string {
+	raw := strings.Join([]string{
+		string(c.Sort),
+		string(c.Order),
+		strconv.FormatInt(c.CompletedAt.UnixNano(), 10),
+		strconv.FormatFloat(c.Score, 'g', -1, 64),
+		c.SessionID,
+	}, cursorSeparator)
+
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+// ParseHistoryCursor decodes the cursor returned with the previous page.
+func ParseHistoryCursor(cursor string) (*HistoryCursor, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return nil, errors.Wrap(ErrInvalidParam, "invalid cursor")
+	}
+
+	parts := strings.Split(string(raw), cursorSeparator)
+	if len(parts) != cursorParts || parts[4] == "" {
+		return nil, errors.Wrap(ErrInvalidParam, "invalid cursor")
+	}
+
+	completedAt, err := strconv.ParseInt(parts[2], 10, 64)
+	if err != nil {
+		return nil, errors.Wrap(ErrInvalidParam, "invalid cursor")
+	}
+
+	score, err := strconv.ParseFloat(parts[3], 64)
+	if err != nil {
+		return nil, errors.Wrap(ErrInvalidParam, "invalid cursor")
+	}
+
+	return &HistoryCursor{
+		Sort:        HistorySort(parts[0]),
+		Order:       SortOrder(parts[1]),
+		CompletedAt: time.Unix(0, completedAt).UTC(),
+		Score:       score,
+		SessionID:   parts[4],
+	}, nil
+}
+
+// HistoryQuery requests one page of the history: Limit sessions matching Filter which follow
+// After in the requested order, the first page has no cursor.
+type HistoryQuery struct {
+	Filter HistoryFilter
+	Sort   HistorySort
+	Order  SortOrder
+	Limit  int
+	After  *HistoryCursor
+}
+
+// NewHistoryQuery checks the requested page, zero limit means DefaultHistoryLimit and empty
+// sort and order mean the latest completed sessions first.
+func NewHistoryQuery(filter HistoryFilter, sort HistorySort, order SortOrder, limit int,
+	after *HistoryCursor) (HistoryQuery, error) {
+	sort, err := NewHistorySort(string(sort))
+	if err != nil {
+		return HistoryQuery{}, err
+	}
+
+	order, err = NewSortOrder(string(order))
+	if err != nil {
+		return HistoryQuery{}, err
+	}
+
+	for _, outcome := range filter.Outcomes {
+		if _, err := NewOutcome(string(outcome)); err != nil {
+			return HistoryQuery{}, err
+		}
+	}
+
+	if limit == 0 {
+		limit = DefaultHistoryLimit
+	}
+
+	if limit < 0 || limit > MaxHistoryLimit {
+		return HistoryQuery{}, errors.Wrapf(ErrInvalidParam, "limit must be from 1 to %d",
+			MaxHistoryLimit)
+	}
+
+	if !filter.From.IsZero() && !filter.To.IsZero() && !filter.From.Before(filter.To) {
+		return HistoryQuery{}, errors.Wrap(ErrInvalidParam, "from must be before to")
+	}
+
+	if after != nil && (after.Sort != sort || after.Order != order) {
+		return HistoryQuery{}, errors.Wrap(ErrInvalidParam,
+			"cursor was returned with another sort or order")
+	}
+
+	return HistoryQuery{
+		Filter: filter,
+		Sort:   sort,
+		Order:  order,
+		Limit:  limit,
+		After:  after,
+	}, nil
+}
+
+// HistoryTotals counts all sessions matching the filter of the history regardless of the page.
+type HistoryTotals struct {
+	Total   int
+	Passed  int
+	Failed  int
+	Expired int
+}
+
+// HistoryPage is one page of the history. Next is nil on the last page.
+type HistoryPage struct {
+	Sessions []*ResultSnapshot
+	Totals   HistoryTotals
+	Next     *HistoryCursor
+}
+
+// NewPage makes the page of sessions selected for the query. Storage selects one session more
+// than the limit, the extra session tells that the next page exists and is not returned.
+func (q HistoryQuery) NewPage(sessions []*ResultSnapshot, totals HistoryTotals) *HistoryPage {
+	page := &HistoryPage{
+		Sessions: sessions,
+		Totals:   totals,
+	}
+
+	if len(sessions) > q.Limit {
+		page.Sessions = sessions[:q.Limit]
+		last := page.Sessions[q.Limit-1]
+		page.Next = &HistoryCursor{
+			Sort:        q.Sort,
+			Order:       q.Order,
+			CompletedAt: last.CompletedAt,
+			Score:       last.Result.Score,
+			SessionID:   last.SessionID,
+		}
+	}
+
+	return page
+}
@@ -0,0 +1,127 @@
+package entities_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/parta4ok/kvs/question/internal/entities"
+)
+
+func TestNewHistoryQuery(t *testing.T) {
+	t.Parallel()
+
+	from := time.Date(2025, 9, 1, 0, 0, 0, 0, time.UTC)
+	to := from.Add(24 * time.Hour)
+	byScore := &entities.HistoryCursor{Sort: entities.SortByScore, Order: entities.Ascending,
+		SessionID: "1"}
+
+	tests := []struct {
+		name   string
+		filter entities.HistoryFilter
+		sort   entities.HistorySort
+		order  entities.SortOrder
+		limit  int
+		after  *entities.HistoryCursor
+		want   entities.HistoryQuery
+		err    error
+	}{
+		{
+			name: "defaults",
+			want: entities.HistoryQuery{Sort: entities.SortByDate, Order: entities.Descending,
+				Limit: entities.DefaultHistoryLimit},
+		},
+		{
+			name: "filtered page after cursor",
+			filter: entities.HistoryFilter{Topic: "Go", From: from, To: to,
+				Outcomes: []entities.Outcome{entities.OutcomePassed}},
+			sort:  entities.SortByScore,
+			order: entities.Ascending,
+			limit: 5,
+			after: byScore,
+			want: entities.HistoryQuery{
+				Filter: entities.HistoryFilter{Topic: "Go", From: from, To: to,
+					Outcomes: []entities.Outcome{entities.OutcomePassed}},
+				Sort: entities.SortByScore, Order: entities.Ascending, Limit: 5, After: byScore,
+			},
+		},
+		{name: "too large limit", limit: entities.MaxHistoryLimit + 1,
+			err: entities.ErrInvalidParam},
+		{name: "negative limit", limit: -1, err: entities.ErrInvalidParam},
+		{name: "unknown sort", sort: "name", err: entities.ErrInvalidParam},
+		{name: "unknown order", order: "random", err: entities.ErrInvalidParam},
+		{name: "unknown outcome", filter: entities.HistoryFilter{
+			Outcomes: []entities.Outcome{"skipped"}}, err: entities.ErrInvalidParam},
+		{name: "empty date range", filter: entities.HistoryFilter{From: to, To: from},
+			err: entities.ErrInvalidParam},
+		{name: "cursor of another sort", after: byScore, err: entities.ErrInvalidParam},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			query, err := entities.NewHistoryQuery(tt.filter, tt.sort, tt.order, tt.limit, tt.after)
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.want, query)
+		})
+	}
+}
+
+func TestHistoryCursor(t *testing.T) {
+	t.Parallel()
+
+	cursor := entities.HistoryCursor{
+		Sort:        entities.SortByScore,
+		Order:       entities.Descending,
+		CompletedAt: time.Date(2025, 9, 1, 10, 8, 30, 123456000, time.UTC),
+		Score:       66.66666666666667,
+		SessionID:   "5577006791947779410",
+	}
+
+	parsed, err := entities.ParseHistoryCursor(cursor.String())
+	require.NoError(t, err)
+	require.Equal(t, cursor, *parsed)
+
+	for _, raw := range []string{"", "not base64!", "ZGF0ZXxkZXNj"} {
+		_, err := entities.ParseHistoryCursor(raw)
+		require.ErrorIs(t, err, entities.ErrInvalidParam)
+	}
+}
+
+func TestHistoryQuery_NewPage(t *testing.T) {
+	t.Parallel()
+
+	completedAt := time.Date(2025, 9, 1, 10, 0, 0, 0, time.UTC)
+	sessions := make([]*entities.ResultSnapshot, 0, 3)
+	for _, id := range []string{"3", "2", "1"} {
+		sessions = append(sessions, &entities.ResultSnapshot{
+			SessionID:   id,
+			CompletedAt: completedAt,
+			Result:      &entities.SessionResult{Score: 50},
+		})
+	}
+	totals := entities.HistoryTotals{Total: 3, Passed: 1, Failed: 2}
+
+	query, err := entities.NewHistoryQuery(entities.HistoryFilter{}, entities.SortByScore,
+		entities.Descending, 2, nil)
+	require.NoError(t, err)
+
+	page := query.NewPage(sessions, totals)
+	require.Equal(t, sessions[:2], page.Sessions)
+	require.Equal(t, totals, page.Totals)
+	require.Equal(t, &entities.HistoryCursor{Sort: entities.SortByScore,
+		Order: entities.Descending, CompletedAt: completedAt, Score: 50, SessionID: "2"},
+		page.Next)
+
+	// the last page has no cursor
+	page = query.NewPage(sessions[2:], totals)
+	require.Equal(t, sessions[2:], page.Sessions)
+	require.Nil(t, page.Next)
+}
@@ -0,0 +1,36 @@
+package entities
+
+import (
+	"github.com/pkg/errors"
+)
+
+// SessionMode tells how the session is graded. Exam sessions are timed and limited by the
+// attempt policy, practice sessions are untimed, unlimited and let the user check answers before
+// completion.
+type SessionMode string
+
+const (
+	ExamMode     SessionMode = "exam"
+	PracticeMode SessionMode = "practice"
+)
+
+// NewSessionMode parses mode of the new session, empty mode means exam.
+func NewSessionMode(mode string) (SessionMode, error) {
+	switch SessionMode(mode) {
+	case "", ExamMode:
+		return ExamMode, nil
+	case PracticeMode:
+		return PracticeMode, nil
+	}
+
+	return "", errors.Wrapf(ErrInvalidParam, "unknown session mode: %s", mode)
+}
+
+// AnswerFeedback is the result of checking one answer of the practice session.
+type AnswerFeedback struct {
+	QuestionID string
+	IsCorrect  bool
+	// Credit is share of the question weight the answer earns.
+	Credit         float64
+	CorrectAnswers []string
+}
@@ -0,0 +1,83 @@
+package entities_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/parta4ok/kvs/question/internal/entities"
+)
+
+func TestNewSessionMode(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name          string
+		mode          string
+		expected      entities.SessionMode
+		expectedError error
+	}{
+		{
+			name:     "default_exam",
+			expected: entities.ExamMode,
+		},
+		{
+			name:     "exam",
+			mode:     "exam",
+			expected: entities.ExamMode,
+		},
+		{
+			name:     "practice",
+			mode:     "practice",
+			expected: entities.PracticeMode,
+		},
+		{
+			name:          "unknown",
+			mode:          "quiz",
+			expectedError: entities.ErrInvalidParam,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			mode, err := entities.NewSessionMode(tc.mode)
+			if tc.expectedError != nil {
+				require.ErrorIs(t, err, tc.expectedError)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, mode)
+		})
+	}
+}
+
+func TestPracticeSession_Untimed(t *testing.T) {
+	t.Parallel()
+
+	startedAt := time.Now().UTC().Add(-time.Hour)
+	question := entities.NewTrueOrFalseSelectionQuestion("1", "Go", "subject", true)
+
+	policy := entities.DefaultSessionPolicy()
+	policy.Mode = entities.PracticeMode
+
+	session := entities.NewSessionWithCustomState("1", "1", []string{"Go"}, nil)
+	session.ChangeState(entities.NewActiveSessionState(map[string]entities.Question{"1": question},
+		session, time.Minute, entities.WithStartedAt(startedAt),
+		entities.WithActiveStatePolicy(policy)))
+
+	answer, err := entities.NewUserAnswer("1", []string{"true"})
+	require.NoError(t, err)
+
+	// practice sessions may be paused without limit and never expire
+	require.NoError(t, session.Pause(time.Now().UTC()))
+	require.NoError(t, session.Resume(time.Now().UTC()))
+	require.NoError(t, session.SetUserAnswer([]*entities.UserAnswer{answer}))
+
+	isExpired, err := session.IsExpired()
+	require.NoError(t, err)
+	require.False(t, isExpired)
+}
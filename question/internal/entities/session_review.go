@@ -0,0 +1,198 @@
+package entities
+
+import (
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// ReviewVisibility tells when the student may see the review of the completed session.
+type ReviewVisibility string
+
+const (
+	// ReviewImmediately opens the review as soon as the session is completed.
+	ReviewImmediately ReviewVisibility = "immediately"
+	// ReviewAfterDeadline opens the review when the session duration limit counted from its
+	// start has passed, so correct answers are not shared while the time is still running.
+	ReviewAfterDeadline ReviewVisibility = "after_deadline"
+	// ReviewMentorOnly shows the review to mentors and admins only.
+	ReviewMentorOnly ReviewVisibility = "mentor_only"
+)
+
+// NewReviewVisibility parses review visibility, empty visibility means ReviewImmediately.
+func NewReviewVisibility(visibility string) (ReviewVisibility, error) {
+	switch ReviewVisibility(visibility) {
+	case "", ReviewImmediately:
+		return ReviewImmediately, nil
+	case ReviewAfterDeadline:
+		return ReviewAfterDeadline, nil
+	case ReviewMentorOnly:
+		return ReviewMentorOnly, nil
+	}
+
+	return "", errors.Wrapf(ErrInvalidParam, "unknown review visibility: %s", visibility)
+}
+
+// Reviewer tells who requests the review of the session.
+type Reviewer string
+
+const (
+	// StudentReviewer is the owner of the session.
+	StudentReviewer Reviewer = "student"
+	// MentorReviewer is mentor of the session owner or admin.
+	MentorReviewer Reviewer = "mentor"
+)
+
+type ReviewPolicy struct {
+	Visibility ReviewVisibility
+}
+
+func DefaultReviewPolicy() ReviewPolicy {
+	return ReviewPolicy{Visibility: ReviewImmediately}
+}
+
+// Check returns ErrForbidden if the reviewer may not see the review yet. Mentors see reviews
+// regardless of the visibility. Zero deadline means the session has no deadline.
+func (p ReviewPolicy) Check(reviewer Reviewer, deadline time.Time, now time.Time) error {
+	if reviewer == MentorReviewer {
+		return nil
+	}
+
+	switch p.Visibility {
+	case ReviewMentorOnly:
+		return errors.Wrap(ErrForbidden, "review is available to mentors only")
+	case ReviewAfterDeadline:
+		if !deadline.IsZero() && now.Before(deadline) {
+			return errors.Wrapf(ErrForbidden, "review is available after %s",
+				deadline.Format(time.RFC3339))
+		}
+	}
+
+	return nil
+}
+
+// Explained is implemented by questions created with an explanation.
+type Explained interface {
+	Explanation() string
+}
+
+// questionExplanation is embedded into every question type, so the factory can assign
+// explanation without changing question constructors.
+type questionExplanation struct {
+	explanation string
+}
+
+func (e *questionExplanation) Explanation() string {
+	return e.explanation
+}
+
+func (e *questionExplanation) setExplanation(explanation string) {
+	e.explanation = explanation
+}
+
+func explanationOf(question Question) string {
+	if explained, ok := question.(Explained); ok {
+		return explained.Explanation()
+	}
+
+	return ""
+}
+
+// SessionReview lists answers of the completed session together with the correct ones.
+type SessionReview struct {
+	SessionID string
+	UserID    string
+	Mode      SessionMode
+	Items     []ReviewItem
+}
+
+type ReviewItem struct {
+	QuestionID     string
+	Topic          string
+	Subject        string
+	Selections     []string
+	CorrectAnswers []string
+	IsCorrect      bool
+	// Credit is share of the question weight the answer earns.
+	Credit      float64
+	Explanation string
+}
+
+// GetReview builds review of the completed session if the policy the session was created with
+// lets the reviewer see it at the moment. Unanswered questions have no selections.
+func (s *Session) GetReview(reviewer Reviewer, now time.Time) (*SessionReview, error) {
+	if s.GetStatus() != CompletedState {
+		return nil, errors.Wrapf(ErrInvalidState, "%s not support review", s.GetStatus())
+	}
+
+	deadline, err := s.reviewDeadline()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.policy.Review.Check(reviewer, deadline, now); err != nil {
+		return nil, err
+	}
+
+	questions, err := s.GetQuestions()
+	if err != nil {
+		return nil, errors.Wrap(err, "GetQuestions")
+	}
+
+	answers, err := s.GetUserAnswers()
+	if err != nil {
+		return nil, errors.Wrap(err, "GetUserAnswers")
+	}
+
+	answersByQuestion := make(map[string]*UserAnswer, len(answers))
+	for _, answer := range answers {
+		answersByQuestion[answer.GetQuestionID()] = answer
+	}
+
+	items := make([]ReviewItem, 0, len(questions))
+	for _, question := range questions {
+		item := ReviewItem{
+			QuestionID:     question.ID(),
+			Topic:          question.Topic(),
+			Subject:        question.Subject(),
+			Selections:     []string{},
+			CorrectAnswers: question.CorrectAnswers(),
+			Explanation:    explanationOf(question),
+		}
+
+		if answer, ok := answersByQuestion[question.ID()]; ok {
+			item.Selections = answer.GetSelections()
+			item.IsCorrect = question.IsAnswerCorrect(answer)
+			item.Credit = s.policy.Scoring.Score(question, answer)
+		}
+
+		items = append(items, item)
+	}
+
+	return &SessionReview{
+		SessionID: s.sessionID,
+		UserID:    s.userID,
+		Mode:      s.policy.Mode,
+		Items:     items,
+	}, nil
+}
+
+// reviewDeadline returns zero time for untimed sessions and sessions completed before their
+// duration limit was stored.
+func (s *Session) reviewDeadline() (time.Time, error) {
+	if !s.policy.IsTimed() {
+		return time.Time{}, nil
+	}
+
+	duration, err := s.GetSessionDurationLimit()
+	if err != nil {
+		return time.Time{}, nil //nolint:nilerr // the limit is unknown
+	}
+
+	startedAt, err := s.GetStartedAt()
+	if err != nil {
+		return time.Time{}, errors.Wrap(err, "GetStartedAt")
+	}
+
+	return startedAt.Add(duration), nil
+}
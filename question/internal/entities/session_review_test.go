@@ -0,0 +1,214 @@
+package entities_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/parta4ok/kvs/question/internal/entities"
+	"github.com/parta4ok/kvs/question/internal/entities/testdata"
+)
+
+func TestNewReviewVisibility(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name          string
+		visibility    string
+		expected      entities.ReviewVisibility
+		expectedError error
+	}{
+		{
+			name:     "default_immediately",
+			expected: entities.ReviewImmediately,
+		},
+		{
+			name:       "after_deadline",
+			visibility: "after_deadline",
+			expected:   entities.ReviewAfterDeadline,
+		},
+		{
+			name:       "mentor_only",
+			visibility: "mentor_only",
+			expected:   entities.ReviewMentorOnly,
+		},
+		{
+			name:          "unknown",
+			visibility:    "never",
+			expectedError: entities.ErrInvalidParam,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			visibility, err := entities.NewReviewVisibility(tc.visibility)
+			if tc.expectedError != nil {
+				require.ErrorIs(t, err, tc.expectedError)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, visibility)
+		})
+	}
+}
+
+func TestReviewPolicy_Check(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now().UTC()
+
+	testCases := []struct {
+		name          string
+		visibility    entities.ReviewVisibility
+		reviewer      entities.Reviewer
+		deadline      time.Time
+		expectedError error
+	}{
+		{
+			name:       "immediately",
+			visibility: entities.ReviewImmediately,
+			reviewer:   entities.StudentReviewer,
+			deadline:   now.Add(time.Hour),
+		},
+		{
+			name:          "before_deadline",
+			visibility:    entities.ReviewAfterDeadline,
+			reviewer:      entities.StudentReviewer,
+			deadline:      now.Add(time.Hour),
+			expectedError: entities.ErrForbidden,
+		},
+		{
+			name:       "after_deadline",
+			visibility: entities.ReviewAfterDeadline,
+			reviewer:   entities.StudentReviewer,
+			deadline:   now.Add(-time.Second),
+		},
+		{
+			name:       "no_deadline",
+			visibility: entities.ReviewAfterDeadline,
+			reviewer:   entities.StudentReviewer,
+		},
+		{
+			name:          "mentor_only_student",
+			visibility:    entities.ReviewMentorOnly,
+			reviewer:      entities.StudentReviewer,
+			expectedError: entities.ErrForbidden,
+		},
+		{
+			name:       "mentor_only_mentor",
+			visibility: entities.ReviewMentorOnly,
+			reviewer:   entities.MentorReviewer,
+		},
+		{
+			name:       "mentor_before_deadline",
+			visibility: entities.ReviewAfterDeadline,
+			reviewer:   entities.MentorReviewer,
+			deadline:   now.Add(time.Hour),
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			policy := entities.ReviewPolicy{Visibility: tc.visibility}
+			err := policy.Check(tc.reviewer, tc.deadline, now)
+			if tc.expectedError != nil {
+				require.ErrorIs(t, err, tc.expectedError)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestSession_GetReview(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	factory := &entities.QuestionFactory{}
+	explained, err := factory.NewQuestion("1", entities.SingleSelection, "Go", "subject",
+		[]string{"a", "b"}, []string{"a"}, entities.WithExplanation("a is correct"))
+	require.NoError(t, err)
+	unanswered := entities.NewTrueOrFalseSelectionQuestion("2", "Go", "subject", true)
+
+	questions := map[string]entities.Question{"1": explained, "2": unanswered}
+	startedAt := time.Now().UTC().Add(-time.Minute)
+
+	policy := entities.DefaultSessionPolicy()
+	policy.Review.Visibility = entities.ReviewAfterDeadline
+
+	generator := testdata.NewMockIDGenerator(ctrl)
+	generator.EXPECT().GenerateID().Return("10")
+
+	session, err := entities.NewSession("1", []string{"Go"}, generator, entities.WithPolicy(policy))
+	require.NoError(t, err)
+
+	_, err = session.GetReview(entities.StudentReviewer, time.Now().UTC())
+	require.ErrorIs(t, err, entities.ErrInvalidState)
+
+	answer, err := entities.NewUserAnswer("1", []string{"b"})
+	require.NoError(t, err)
+
+	session.ChangeState(entities.NewCompletedSessionState(questions, session,
+		[]*entities.UserAnswer{answer}, startedAt, false,
+		entities.WithCompletedStatePolicy(policy),
+		entities.WithCompletedDurationLimit(time.Hour)))
+
+	_, err = session.GetReview(entities.StudentReviewer, time.Now().UTC())
+	require.ErrorIs(t, err, entities.ErrForbidden)
+
+	review, err := session.GetReview(entities.StudentReviewer, startedAt.Add(2*time.Hour))
+	require.NoError(t, err)
+	require.Equal(t, "10", review.SessionID)
+	require.Equal(t, entities.ExamMode, review.Mode)
+	require.Equal(t, []entities.ReviewItem{
+		{
+			QuestionID:     "1",
+			Topic:          "Go",
+			Subject:        "subject",
+			Selections:     []string{"b"},
+			CorrectAnswers: []string{"a"},
+			Explanation:    "a is correct",
+		},
+		{
+			QuestionID:     "2",
+			Topic:          "Go",
+			Subject:        "subject",
+			Selections:     []string{},
+			CorrectAnswers: []string{"true"},
+		},
+	}, review.Items)
+
+	// mentors do not wait for the deadline
+	_, err = session.GetReview(entities.MentorReviewer, time.Now().UTC())
+	require.NoError(t, err)
+}
+
+func TestSession_GetReview_Explanations(t *testing.T) {
+	t.Parallel()
+
+	questions := make(map[string]entities.Question)
+	for _, question := range newQuestionsOfEveryType(t, entities.WithExplanation("explanation")) {
+		questions[question.ID()] = question
+	}
+
+	session := entities.NewSessionWithCustomState("1", "1", []string{"Go"}, nil)
+	session.ChangeState(entities.NewCompletedSessionState(questions, session, nil,
+		time.Now().UTC(), false))
+
+	review, err := session.GetReview(entities.MentorReviewer, time.Now().UTC())
+	require.NoError(t, err)
+	require.Len(t, review.Items, len(questions))
+	for _, item := range review.Items {
+		require.Equal(t, "explanation", item.Explanation, item.QuestionID)
+	}
+}
@@ -1,7 +1,6 @@
 package entities
 
 import (
-	"context"
 	"time"
 )
 
@@ -9,6 +8,7 @@ const (
 	InitState      = "init state"
 	ActiveState    = "active state"
 	CompletedState = "completed state"
+	PausedState    = "paused state"
 )
 
 //go:generate mockgen -source=session_state.go -destination=./testdata/session_state.go -package=testdata
@@ -22,7 +22,9 @@ type SessionState interface {
 	GetSessionResult() (*SessionResult, error)
 	GetSessionDurationLimit() (time.Duration, error)
 	IsExpired() (bool, error)
-	IsDailySessionLimitReached(ctx context.Context, userID string, topics []string) (bool, error)
+	Pause(now time.Time) error
+	Resume(now time.Time) error
+	GetClock() (SessionClock, error)
 }
 
 type StateHolder interface {
@@ -1,7 +1,6 @@
 package entities_test
 
 import (
-	"context"
 	"testing"
 	"time"
 
@@ -19,27 +18,20 @@ func TestNewSession(t *testing.T) {
 	defer func() {
 		t.Cleanup(ctrl.Finish)
 	}()
-	ctx := context.TODO()
 	userID := "1"
 	topics := []string{"1"}
 
 	generator := testdata.NewMockIDGenerator(ctrl)
 	generator.EXPECT().GenerateID().Return("2")
-	storage := testdata.NewMockSessionStorage(ctrl)
-	storage.EXPECT().IsDailySessionLimitReached(ctx, userID, topics).Return(false, nil)
 
 	question := testdata.NewMockQuestion(ctrl)
 
-	session, err := entities.NewSession(userID, topics, generator, storage)
+	session, err := entities.NewSession(userID, topics, generator)
 	require.NoError(t, err)
 	require.NotNil(t, session)
 
 	require.Equal(t, entities.InitState, session.GetStatus())
 
-	forbidden, err := session.IsDailySessionLimitReached(ctx, userID, topics)
-	require.False(t, forbidden)
-	require.NoError(t, err)
-
 	quesionMap := map[string]entities.Question{"3": question}
 
 	err = session.SetQuestions(quesionMap, time.Second*30)
@@ -67,9 +59,8 @@ func TestSession_WithSessionID(t *testing.T) {
 	sessionID := "123"
 	generator := testdata.NewMockIDGenerator(ctrl)
 	generator.EXPECT().GenerateID().Return("999")
-	storage := testdata.NewMockSessionStorage(ctrl)
 
-	session, err := entities.NewSession(userID, topics, generator, storage,
+	session, err := entities.NewSession(userID, topics, generator,
 		entities.WithSessionID(sessionID))
 
 	require.NoError(t, err)
@@ -87,9 +78,8 @@ func TestSession_WithNilState(t *testing.T) {
 	topics := []string{"topic1"}
 	generator := testdata.NewMockIDGenerator(ctrl)
 	generator.EXPECT().GenerateID().Return("1")
-	storage := testdata.NewMockSessionStorage(ctrl)
 
-	session, err := entities.NewSession(userID, topics, generator, storage,
+	session, err := entities.NewSession(userID, topics, generator,
 		entities.WithNilState())
 
 	require.NoError(t, err)
@@ -125,9 +115,8 @@ func TestSession_GetUserID(t *testing.T) {
 	topics := []string{"topic1"}
 	generator := testdata.NewMockIDGenerator(ctrl)
 	generator.EXPECT().GenerateID().Return("1")
-	storage := testdata.NewMockSessionStorage(ctrl)
 
-	session, err := entities.NewSession(userID, topics, generator, storage)
+	session, err := entities.NewSession(userID, topics, generator)
 	require.NoError(t, err)
 
 	result := session.GetUserID()
@@ -145,9 +134,8 @@ func TestSession_GetTopics(t *testing.T) {
 	topics := []string{"Go", "Databases"}
 	generator := testdata.NewMockIDGenerator(ctrl)
 	generator.EXPECT().GenerateID().Return("1")
-	storage := testdata.NewMockSessionStorage(ctrl)
 
-	session, err := entities.NewSession(userID, topics, generator, storage)
+	session, err := entities.NewSession(userID, topics, generator)
 	require.NoError(t, err)
 
 	result := session.GetTopics()
@@ -165,9 +153,8 @@ func TestSession_GetSessionResult(t *testing.T) {
 	topics := []string{"topic1"}
 	generator := testdata.NewMockIDGenerator(ctrl)
 	generator.EXPECT().GenerateID().Return("1")
-	storage := testdata.NewMockSessionStorage(ctrl)
 
-	session, err := entities.NewSession(userID, topics, generator, storage)
+	session, err := entities.NewSession(userID, topics, generator)
 	require.NoError(t, err)
 
 	mockState := testdata.NewMockSessionState(ctrl)
@@ -192,9 +179,8 @@ func TestSession_GetSessionDurationLimit(t *testing.T) {
 	topics := []string{"topic1"}
 	generator := testdata.NewMockIDGenerator(ctrl)
 	generator.EXPECT().GenerateID().Return("1")
-	storage := testdata.NewMockSessionStorage(ctrl)
 
-	session, err := entities.NewSession(userID, topics, generator, storage)
+	session, err := entities.NewSession(userID, topics, generator)
 	require.NoError(t, err)
 
 	mockState := testdata.NewMockSessionState(ctrl)
@@ -219,9 +205,8 @@ func TestSession_IsExpired(t *testing.T) {
 	topics := []string{"topic1"}
 	generator := testdata.NewMockIDGenerator(ctrl)
 	generator.EXPECT().GenerateID().Return("1")
-	storage := testdata.NewMockSessionStorage(ctrl)
 
-	session, err := entities.NewSession(userID, topics, generator, storage)
+	session, err := entities.NewSession(userID, topics, generator)
 	require.NoError(t, err)
 
 	mockState := testdata.NewMockSessionState(ctrl)
@@ -245,9 +230,8 @@ func TestSession_GetQuestions(t *testing.T) {
 	topics := []string{"topic1"}
 	generator := testdata.NewMockIDGenerator(ctrl)
 	generator.EXPECT().GenerateID().Return("1")
-	storage := testdata.NewMockSessionStorage(ctrl)
 
-	session, err := entities.NewSession(userID, topics, generator, storage)
+	session, err := entities.NewSession(userID, topics, generator)
 	require.NoError(t, err)
 
 	mockState := testdata.NewMockSessionState(ctrl)
@@ -273,9 +257,8 @@ func TestSession_GetStartedAt(t *testing.T) {
 	topics := []string{"topic1"}
 	generator := testdata.NewMockIDGenerator(ctrl)
 	generator.EXPECT().GenerateID().Return("1")
-	storage := testdata.NewMockSessionStorage(ctrl)
 
-	session, err := entities.NewSession(userID, topics, generator, storage)
+	session, err := entities.NewSession(userID, topics, generator)
 	require.NoError(t, err)
 
 	mockState := testdata.NewMockSessionState(ctrl)
@@ -300,9 +283,8 @@ func TestSession_GetUserAnswers(t *testing.T) {
 	topics := []string{"topic1"}
 	generator := testdata.NewMockIDGenerator(ctrl)
 	generator.EXPECT().GenerateID().Return("1")
-	storage := testdata.NewMockSessionStorage(ctrl)
 
-	session, err := entities.NewSession(userID, topics, generator, storage)
+	session, err := entities.NewSession(userID, topics, generator)
 	require.NoError(t, err)
 
 	mockState := testdata.NewMockSessionState(ctrl)
@@ -329,25 +311,162 @@ func Test_NewSession_Failed(t *testing.T) {
 
 	invalidUserID := "0"
 	var invalidGenerator entities.IDGenerator
-	var invalidStorage entities.SessionStorage
 
 	userID := "1"
 	generator := testdata.NewMockIDGenerator(ctrl)
-	storage := testdata.NewMockSessionStorage(ctrl)
 
-	s, err := entities.NewSession(invalidUserID, []string{}, generator, storage)
+	s, err := entities.NewSession(invalidUserID, []string{}, generator)
 	require.Nil(t, s)
 	require.ErrorIs(t, err, entities.ErrInvalidParam)
 
-	s, err = entities.NewSession(userID, []string{}, invalidGenerator, storage)
+	s, err = entities.NewSession(userID, []string{}, invalidGenerator)
 	require.Nil(t, s)
 	require.ErrorIs(t, err, entities.ErrInvalidParam)
 
-	s, err = entities.NewSession(userID, []string{}, generator, invalidStorage)
+	s, err = entities.NewSession(userID, []string{}, generator)
 	require.Nil(t, s)
 	require.ErrorIs(t, err, entities.ErrInvalidParam)
+}
 
-	s, err = entities.NewSession(userID, []string{}, generator, storage)
-	require.Nil(t, s)
-	require.ErrorIs(t, err, entities.ErrInvalidParam)
+func TestSession_CheckDraftAnswer(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	startedAt := time.Date(2025, 9, 1, 10, 0, 0, 0, time.UTC)
+	question := entities.NewTrueOrFalseSelectionQuestion("1", "Go", "subject", true)
+
+	newSession := func() *entities.Session {
+		generator := testdata.NewMockIDGenerator(ctrl)
+		generator.EXPECT().GenerateID().Return("1")
+
+		session, err := entities.NewSession("1", []string{"Go"}, generator, entities.WithNilState())
+		require.NoError(t, err)
+
+		session.ChangeState(entities.NewActiveSessionState(
+			map[string]entities.Question{"1": question}, session, time.Minute,
+			entities.WithStartedAt(startedAt)))
+
+		return session
+	}
+
+	testCases := []struct {
+		name          string
+		questionID    string
+		now           time.Time
+		complete      bool
+		expectedError error
+	}{
+		{
+			name:       "success",
+			questionID: "1",
+			now:        startedAt.Add(time.Second * 30),
+		},
+		{
+			name:          "unknown_question",
+			questionID:    "2",
+			now:           startedAt.Add(time.Second * 30),
+			expectedError: entities.ErrInvalidParam,
+		},
+		{
+			name:          "deadline_passed",
+			questionID:    "1",
+			now:           startedAt.Add(time.Minute * 2),
+			expectedError: entities.ErrInvalidState,
+		},
+		{
+			name:          "session_completed",
+			questionID:    "1",
+			now:           startedAt.Add(time.Second * 30),
+			complete:      true,
+			expectedError: entities.ErrInvalidState,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			session := newSession()
+			if tc.complete {
+				require.NoError(t, session.SetUserAnswer(nil))
+			}
+
+			answer, err := entities.NewUserAnswer(tc.questionID, []string{"true"})
+			require.NoError(t, err)
+
+			err = session.CheckDraftAnswer(answer, tc.now)
+			if tc.expectedError != nil {
+				require.ErrorIs(t, err, tc.expectedError)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestSession_GetRemainingTime(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	startedAt := time.Date(2025, 9, 1, 10, 0, 0, 0, time.UTC)
+
+	mockState := testdata.NewMockSessionState(ctrl)
+	mockState.EXPECT().GetClock().Return(
+		entities.SessionClock{RunningSince: startedAt}, nil).Times(2)
+	mockState.EXPECT().GetSessionDurationLimit().Return(time.Minute*10, nil).Times(2)
+
+	session := entities.NewSessionWithCustomState("1", "1", []string{"Go"}, mockState)
+
+	remaining, err := session.GetRemainingTime(startedAt.Add(time.Minute * 3))
+	require.NoError(t, err)
+	require.Equal(t, time.Minute*7, remaining)
+
+	remaining, err = session.GetRemainingTime(startedAt.Add(time.Hour))
+	require.NoError(t, err)
+	require.Equal(t, time.Duration(0), remaining)
+}
+
+func TestSession_PauseAndResume(t *testing.T) {
+	t.Parallel()
+
+	startedAt := time.Date(2025, 9, 1, 10, 0, 0, 0, time.UTC)
+
+	question := entities.NewTrueOrFalseSelectionQuestion("1", "Go", "subject", true)
+
+	session := entities.NewSessionWithCustomState("1", "1", []string{"Go"}, nil)
+	policy := entities.DefaultSessionPolicy()
+	policy.Pause.MaxPauses = 1
+	session.ChangeState(entities.NewActiveSessionState(map[string]entities.Question{"1": question},
+		session, time.Minute*10, entities.WithStartedAt(startedAt),
+		entities.WithActiveStatePolicy(policy)))
+
+	require.NoError(t, session.Pause(startedAt.Add(time.Minute*3)))
+	require.Equal(t, entities.PausedState, session.GetStatus())
+
+	// time of the pause is not counted
+	remaining, err := session.GetRemainingTime(startedAt.Add(time.Hour))
+	require.NoError(t, err)
+	require.Equal(t, time.Minute*7, remaining)
+
+	_, err = session.GetDeadline()
+	require.ErrorIs(t, err, entities.ErrInvalidState)
+
+	require.NoError(t, session.Resume(startedAt.Add(time.Hour)))
+	require.Equal(t, entities.ActiveState, session.GetStatus())
+
+	deadline, err := session.GetDeadline()
+	require.NoError(t, err)
+	require.Equal(t, startedAt.Add(time.Hour+time.Minute*7), deadline)
+
+	clock, err := session.GetClock()
+	require.NoError(t, err)
+	require.Equal(t, 1, clock.Pauses)
+
+	err = session.Pause(startedAt.Add(time.Hour + time.Minute))
+	require.ErrorIs(t, err, entities.ErrForbidden)
 }
@@ -0,0 +1,102 @@
+package entities
+
+import (
+	"regexp"
+
+	"github.com/pkg/errors"
+)
+
+var (
+	_ Question = (*ShortAnswerQuestion)(nil)
+)
+
+type ShortAnswerQuestion struct {
+	questionWeight
+	questionExplanation
+	questionDifficulty
+	questionVersion
+
+	id              string
+	topic           string
+	subject         string
+	acceptedAnswers []string
+	normalized      []string
+	normalization   AnswerNormalization
+	pattern         *regexp.Regexp
+}
+
+func NewShortAnswerQuestion(id string, topic string, subject string, acceptedAnswers []string,
+	normalization AnswerNormalization) (*ShortAnswerQuestion, error) {
+	question := &ShortAnswerQuestion{
+		id:              id,
+		topic:           topic,
+		subject:         subject,
+		acceptedAnswers: acceptedAnswers,
+		normalized:      make([]string, 0, len(acceptedAnswers)),
+		normalization:   normalization,
+	}
+
+	for _, accepted := range acceptedAnswers {
+		question.normalized = append(question.normalized, normalization.Normalize(accepted))
+	}
+
+	if normalization.Pattern != "" {
+		// case is folded by the regexp flag: lowering the pattern would break escapes like \S
+		expr := "^(?:" + normalization.foldRunes(normalization.Pattern) + ")$"
+		if normalization.FoldCase {
+			expr = "(?i)" + expr
+		}
+
+		pattern, err := regexp.Compile(expr)
+		if err != nil {
+			return nil, errors.Wrapf(ErrInvalidParam, "invalid answer pattern: %v", err)
+		}
+		question.pattern = pattern
+	}
+
+	return question, nil
+}
+
+func (q *ShortAnswerQuestion) ID() string {
+	return q.id
+}
+
+func (q *ShortAnswerQuestion) Type() QuestionType {
+	return ShortAnswer
+}
+
+func (q *ShortAnswerQuestion) Topic() string {
+	return q.topic
+}
+
+func (q *ShortAnswerQuestion) Subject() string {
+	return q.subject
+}
+
+// Variants returns nothing: the student types the answer instead of choosing it.
+func (q *ShortAnswerQuestion) Variants() []string {
+	return nil
+}
+
+func (q *ShortAnswerQuestion) CorrectAnswers() []string {
+	return q.acceptedAnswers
+}
+
+func (q *ShortAnswerQuestion) Normalization() AnswerNormalization {
+	return q.normalization
+}
+
+func (q *ShortAnswerQuestion) IsAnswerCorrect(ans *UserAnswer) bool {
+	if len(ans.answer) != 1 {
+		return false
+	}
+
+	userAnswer := q.normalization.Normalize(ans.answer[0])
+	for _, accepted := range q.normalized {
+		if accepted == userAnswer {
+			return true
+		}
+	}
+
+	return q.pattern != nil && q.pattern.MatchString(userAnswer)
+}
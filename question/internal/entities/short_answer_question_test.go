@@ -0,0 +1,201 @@
+package entities_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/parta4ok/kvs/question/internal/entities"
+)
+
+func TestNewShortAnswerQuestion(t *testing.T) {
+	t.Parallel()
+
+	question, err := entities.NewShortAnswerQuestion("1", "Go", "Как называется нулевое значение указателя?",
+		[]string{"nil"}, entities.DefaultAnswerNormalization())
+	require.NoError(t, err)
+
+	require.Equal(t, "1", question.ID())
+	require.Equal(t, "Go", question.Topic())
+	require.Equal(t, entities.ShortAnswer, question.Type())
+	require.Empty(t, question.Variants())
+	require.Equal(t, []string{"nil"}, question.CorrectAnswers())
+	require.True(t, question.Type().IsFreeInput())
+}
+
+func TestNewShortAnswerQuestion_InvalidPattern(t *testing.T) {
+	t.Parallel()
+
+	normalization := entities.DefaultAnswerNormalization()
+	normalization.Pattern = "(unclosed"
+
+	question, err := entities.NewShortAnswerQuestion("1", "Go", "subject", nil, normalization)
+
+	require.ErrorIs(t, err, entities.ErrInvalidParam)
+	require.Nil(t, question)
+}
+
+func TestShortAnswerQuestion_IsAnswerCorrect(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name          string
+		accepted      []string
+		normalization entities.AnswerNormalization
+		answer        []string
+		expected      bool
+	}{
+		{
+			name:          "exact_match",
+			accepted:      []string{"goroutine"},
+			normalization: entities.AnswerNormalization{},
+			answer:        []string{"goroutine"},
+			expected:      true,
+		},
+		{
+			name:          "case_and_spaces_default",
+			accepted:      []string{"Read Committed"},
+			normalization: entities.DefaultAnswerNormalization(),
+			answer:        []string{"  read   COMMITTED "},
+			expected:      true,
+		},
+		{
+			name:          "case_sensitive",
+			accepted:      []string{"Read Committed"},
+			normalization: entities.AnswerNormalization{TrimSpace: true},
+			answer:        []string{"read committed"},
+			expected:      false,
+		},
+		{
+			name:     "cyrillic_lookalikes",
+			accepted: []string{"CPU"},
+			normalization: entities.AnswerNormalization{
+				FoldCase:       true,
+				FoldLookalikes: true,
+			},
+			answer:   []string{"срu"},
+			expected: true,
+		},
+		{
+			name:          "cyrillic_lookalikes_disabled",
+			accepted:      []string{"CPU"},
+			normalization: entities.DefaultAnswerNormalization(),
+			answer:        []string{"СРU"},
+			expected:      false,
+		},
+		{
+			name:     "cyrillic_lookalikes_in_accepted_answer",
+			accepted: []string{"СРU"},
+			normalization: entities.AnswerNormalization{
+				FoldCase:       true,
+				FoldLookalikes: true,
+			},
+			answer:   []string{"cpu"},
+			expected: true,
+		},
+		{
+			name:     "cyrillic_lookalikes_in_pattern",
+			accepted: nil,
+			normalization: entities.AnswerNormalization{
+				FoldCase:       true,
+				TrimSpace:      true,
+				FoldLookalikes: true,
+				Pattern:        `СРU\s?\d+`,
+			},
+			answer:   []string{"cpu 4"},
+			expected: true,
+		},
+		{
+			name:          "nfkc_always_applied",
+			accepted:      []string{"int64"},
+			normalization: entities.DefaultAnswerNormalization(),
+			answer:        []string{"ｉｎｔ６４"},
+			expected:      true,
+		},
+		{
+			name:          "nfkc_applied_to_pattern",
+			accepted:      nil,
+			normalization: entities.AnswerNormalization{Pattern: `ｉｎｔ(8|16)`},
+			answer:        []string{"int8"},
+			expected:      true,
+		},
+		{
+			name:     "pattern",
+			accepted: nil,
+			normalization: entities.AnswerNormalization{
+				FoldCase:  true,
+				TrimSpace: true,
+				Pattern:   `map\[string\]\s?int`,
+			},
+			answer:   []string{" MAP[string]int"},
+			expected: true,
+		},
+		{
+			name:     "pattern_is_anchored",
+			accepted: nil,
+			normalization: entities.AnswerNormalization{
+				Pattern: `int`,
+			},
+			answer:   []string{"int64"},
+			expected: false,
+		},
+		{
+			name:          "several_answers",
+			accepted:      []string{"nil"},
+			normalization: entities.DefaultAnswerNormalization(),
+			answer:        []string{"nil", "null"},
+			expected:      false,
+		},
+		{
+			name:          "empty_answer",
+			accepted:      []string{"nil"},
+			normalization: entities.DefaultAnswerNormalization(),
+			answer:        []string{},
+			expected:      false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			question, err := entities.NewShortAnswerQuestion("1", "Go", "subject", tc.accepted,
+				tc.normalization)
+			require.NoError(t, err)
+
+			answer, err := entities.NewUserAnswer(question.ID(), tc.answer)
+			require.NoError(t, err)
+
+			require.Equal(t, tc.expected, question.IsAnswerCorrect(answer))
+		})
+	}
+}
+
+func TestQuestionFactory_NewQuestion_ShortAnswer(t *testing.T) {
+	t.Parallel()
+
+	factory := &entities.QuestionFactory{}
+
+	question, err := factory.NewQuestion("1", entities.ShortAnswer, "Go", "subject", nil,
+		[]string{"nil"})
+	require.NoError(t, err)
+	require.Equal(t, entities.ShortAnswer, question.Type())
+
+	_, err = factory.NewQuestion("1", entities.ShortAnswer, "Go", "subject",
+		[]string{"nil", "null"}, []string{"nil"})
+	require.ErrorIs(t, err, entities.ErrInvalidParam)
+
+	_, err = factory.NewQuestion("1", entities.ShortAnswer, "Go", "subject", nil, nil)
+	require.ErrorIs(t, err, entities.ErrInvalidParam)
+
+	question, err = factory.NewQuestion("1", entities.ShortAnswer, "Go", "subject", nil, nil,
+		entities.WithAnswerNormalization(entities.AnswerNormalization{Pattern: `u?int(8|16)`}))
+	require.NoError(t, err)
+	require.Equal(t, `u?int(8|16)`, question.(*entities.ShortAnswerQuestion).Normalization().Pattern)
+
+	question, err = factory.NewQuestion("1", entities.ShortAnswer, "Go", "subject", nil, nil,
+		entities.WithAnswerNormalization(entities.AnswerNormalization{Pattern: `(`}))
+	require.ErrorIs(t, err, entities.ErrInvalidParam)
+	require.Nil(t, question)
+}
@@ -0,0 +1,93 @@
+package entities
+
+import (
+	"cmp"
+	"hash/fnv"
+	"math/rand/v2"
+	"slices"
+	"strings"
+)
+
+// ShufflePolicy tells whether the session shows variants and questions in a random order. The
+// order is derived from Seed, so every request and the review of the session show the same
+// order and a mentor can reproduce exactly what the student saw.
+type ShufflePolicy struct {
+	Variants  bool
+	Questions bool
+	Seed      uint64
+}
+
+// IsEnabled tells whether the session needs a seed.
+func (p ShufflePolicy) IsEnabled() bool {
+	return p.Variants || p.Questions
+}
+
+// variantsShuffler is implemented by questions whose variants are shown as a list to choose
+// from or to arrange. True or false questions keep the natural order of their variants.
+type variantsShuffler interface {
+	withVariants(variants []string) Question
+}
+
+// ShuffleVariants returns copies of the questions with variants in the order of the session.
+// Answers carry variant texts, so grading of the copies does not change. The order depends
+// only on the seed, the question id and the set of variants, so shuffling the shuffled
+// questions again keeps the order.
+func (p ShufflePolicy) ShuffleVariants(questions map[string]Question) map[string]Question {
+	if !p.Variants {
+		return questions
+	}
+
+	shuffled := make(map[string]Question, len(questions))
+	for id, question := range questions {
+		if shuffler, ok := question.(variantsShuffler); ok {
+			variants := slices.Clone(question.Variants())
+			slices.Sort(variants)
+			p.permute(question.ID(), len(variants), func(i, j int) {
+				variants[i], variants[j] = variants[j], variants[i]
+			})
+			question = shuffler.withVariants(variants)
+		}
+		shuffled[id] = question
+	}
+
+	return shuffled
+}
+
+// OrderQuestions sorts questions by id and shuffles them by the seed if the policy shuffles
+// questions.
+func (p ShufflePolicy) OrderQuestions(questions []Question) []Question {
+	ordered := slices.Clone(questions)
+	slices.SortFunc(ordered, func(a, b Question) int {
+		return compareIDs(a.ID(), b.ID())
+	})
+
+	if p.Questions {
+		p.permute("", len(ordered), func(i, j int) {
+			ordered[i], ordered[j] = ordered[j], ordered[i]
+		})
+	}
+
+	return ordered
+}
+
+// permute runs Fisher-Yates shuffle of n items with the PCG generator seeded by the seed and
+// the key. Both algorithms are fixed here, so the order does not depend on the Go version.
+func (p ShufflePolicy) permute(key string, n int, swap func(i, j int)) {
+	hash := fnv.New64a()
+	_, _ = hash.Write([]byte(key))
+
+	rng := rand.NewPCG(p.Seed, hash.Sum64())
+	for i := n - 1; i > 0; i-- {
+		j := int(rng.Uint64() % uint64(i+1)) //nolint:gosec // ok, less than n
+		swap(i, j)
+	}
+}
+
+// compareIDs orders numeric ids by value.
+func compareIDs(a string, b string) int {
+	if len(a) != len(b) {
+		return cmp.Compare(len(a), len(b))
+	}
+
+	return strings.Compare(a, b)
+}
@@ -0,0 +1,161 @@
+package entities_test
+
+import (
+	"slices"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/parta4ok/kvs/question/internal/entities"
+)
+
+func TestShufflePolicy_ShuffleVariants(t *testing.T) {
+	t.Parallel()
+
+	variants := []string{"a", "b", "c", "d", "e", "f"}
+	questions := map[string]entities.Question{
+		"1": entities.NewSingleSelectionQuestion("1", "Go", "subject", variants, "c"),
+		"2": entities.NewMultiSelectionQuestion("2", "Go", "subject", variants,
+			[]string{"a", "f"}),
+		"3": entities.NewOrderingQuestion("3", "Go", "subject", variants, variants, false),
+		"4": entities.NewTrueOrFalseSelectionQuestion("4", "Go", "subject", true),
+	}
+
+	policy := entities.ShufflePolicy{Variants: true, Seed: 42}
+	shuffled := policy.ShuffleVariants(questions)
+	require.Len(t, shuffled, len(questions))
+
+	for id, question := range shuffled {
+		require.ElementsMatch(t, questions[id].Variants(), question.Variants())
+		require.Equal(t, questions[id].Type(), question.Type())
+	}
+	require.Equal(t, questions["4"].Variants(), shuffled["4"].Variants())
+	// the original questions are not changed
+	require.Equal(t, variants, questions["1"].Variants())
+
+	// the order is reproducible and does not change when shuffled again
+	require.Equal(t, shuffled["1"].Variants(), policy.ShuffleVariants(questions)["1"].Variants())
+	require.Equal(t, shuffled["2"].Variants(), policy.ShuffleVariants(shuffled)["2"].Variants())
+
+	// grading does not depend on the order of variants
+	answer := mustUserAnswer(t, "3", variants...)
+	require.True(t, shuffled["3"].IsAnswerCorrect(answer))
+	answer = mustUserAnswer(t, "2", "f", "a")
+	require.True(t, shuffled["2"].IsAnswerCorrect(answer))
+
+	// other seeds give other orders
+	differs := false
+	for seed := range uint64(10) {
+		policy := entities.ShufflePolicy{Variants: true, Seed: seed}
+		if !slices.Equal(policy.ShuffleVariants(questions)["1"].Variants(), variants) {
+			differs = true
+		}
+	}
+	require.True(t, differs)
+}
+
+func TestShufflePolicy_ShuffleVariants_Disabled(t *testing.T) {
+	t.Parallel()
+
+	question := entities.NewSingleSelectionQuestion("1", "Go", "subject",
+		[]string{"c", "b", "a"}, "a")
+	questions := map[string]entities.Question{"1": question}
+
+	policy := entities.ShufflePolicy{Questions: true, Seed: 42}
+	require.True(t, policy.IsEnabled())
+	require.Equal(t, questions, policy.ShuffleVariants(questions))
+	require.False(t, entities.ShufflePolicy{}.IsEnabled())
+}
+
+func TestShufflePolicy_OrderQuestions(t *testing.T) {
+	t.Parallel()
+
+	questions := make([]entities.Question, 0, 12)
+	for _, id := range []string{"10", "2", "1", "12", "3", "11", "4", "5", "6", "7", "8", "9"} {
+		questions = append(questions, entities.NewTrueOrFalseSelectionQuestion(id, "Go",
+			"subject", true))
+	}
+
+	ordered := entities.ShufflePolicy{Variants: true}.OrderQuestions(questions)
+	ids := make([]string, 0, len(ordered))
+	for _, question := range ordered {
+		ids = append(ids, question.ID())
+	}
+	require.Equal(t, []string{"1", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12"}, ids)
+
+	policy := entities.ShufflePolicy{Questions: true, Seed: 7}
+	shuffled := policy.OrderQuestions(questions)
+	require.ElementsMatch(t, questions, shuffled)
+	require.NotEqual(t, ordered, shuffled)
+	require.Equal(t, shuffled, policy.OrderQuestions(shuffled))
+}
+
+func TestSession_ShuffledQuestions(t *testing.T) {
+	t.Parallel()
+
+	startedAt := time.Date(2025, 9, 1, 10, 0, 0, 0, time.UTC)
+	variants := []string{"a", "b", "c", "d", "e", "f"}
+	questions := map[string]entities.Question{
+		"1": entities.NewSingleSelectionQuestion("1", "Go", "subject", variants, "c"),
+		"2": entities.NewSingleSelectionQuestion("2", "Go", "subject", variants, "d"),
+		"3": entities.NewSingleSelectionQuestion("3", "Go", "subject", variants, "e"),
+	}
+
+	policy := entities.DefaultSessionPolicy()
+	policy.Pause.MaxPauses = 1
+	policy.Shuffle = entities.ShufflePolicy{Variants: true, Questions: true, Seed: 42}
+
+	session := entities.NewSessionWithCustomState("1", "1", []string{"Go"}, nil)
+	session.ChangeState(entities.NewActiveSessionState(questions, session, time.Minute*10,
+		entities.WithStartedAt(startedAt), entities.WithActiveStatePolicy(policy)))
+
+	active, err := session.GetQuestions()
+	require.NoError(t, err)
+	again, err := session.GetQuestions()
+	require.NoError(t, err)
+	require.Equal(t, active, again)
+
+	// the paused session shows the same order
+	require.NoError(t, session.Pause(startedAt.Add(time.Minute)))
+	paused, err := session.GetQuestions()
+	require.NoError(t, err)
+	require.Equal(t, active, paused)
+}
+
+func TestSession_GetReview_ShuffledOrder(t *testing.T) {
+	t.Parallel()
+
+	startedAt := time.Date(2025, 9, 1, 10, 0, 0, 0, time.UTC)
+	questions := make(map[string]entities.Question, 12)
+	for i := 1; i <= 12; i++ {
+		id := strconv.Itoa(i)
+		questions[id] = entities.NewTrueOrFalseSelectionQuestion(id, "Go", "subject", true)
+	}
+
+	policy := entities.DefaultSessionPolicy()
+	policy.Shuffle = entities.ShufflePolicy{Questions: true, Seed: 42}
+
+	session := entities.NewSessionWithCustomState("1", "1", []string{"Go"}, nil)
+	session.ChangeState(entities.NewActiveSessionState(questions, session, time.Minute*10,
+		entities.WithStartedAt(startedAt), entities.WithActiveStatePolicy(policy)))
+
+	shown, err := session.GetQuestions()
+	require.NoError(t, err)
+	session.ChangeState(entities.NewCompletedSessionState(questions, session, nil, startedAt,
+		false, entities.WithCompletedStatePolicy(policy)))
+
+	review, err := session.GetReview(entities.MentorReviewer, startedAt.Add(time.Minute))
+	require.NoError(t, err)
+	snapshot, err := session.Snapshot()
+	require.NoError(t, err)
+
+	// review and history show questions in the order the student saw them
+	require.Len(t, review.Items, len(shown))
+	require.Len(t, snapshot.Items, len(shown))
+	for i, question := range shown {
+		require.Equal(t, question.ID(), review.Items[i].QuestionID)
+		require.Equal(t, question.ID(), snapshot.Items[i].QuestionID)
+	}
+}
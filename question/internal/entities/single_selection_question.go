@@ -5,6 +5,11 @@ var (
 )
 
 type SingleSelectionQuestion struct {
+	questionWeight
+	questionExplanation
+	questionDifficulty
+	questionVersion
+
 	id            string
 	topic         string
 	subject       string
@@ -43,6 +48,16 @@ func (q *SingleSelectionQuestion) Variants() []string {
 	return q.variants
 }
 
+func (q *SingleSelectionQuestion) withVariants(variants []string) Question {
+	clone := *q
+	clone.variants = variants
+	return &clone
+}
+
+func (q *SingleSelectionQuestion) CorrectAnswers() []string {
+	return []string{q.correctAnswer}
+}
+
 func (q *SingleSelectionQuestion) IsAnswerCorrect(ans *UserAnswer) bool {
 	if len(ans.answer) == 0 || len(ans.answer) != 1 {
 		return false
@@ -0,0 +1,221 @@
+package entities
+
+import (
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// LeitnerSchedule keeps repetition intervals of the Leitner boxes. Every question starts in the
+// first box, a correct answer moves it to the next box, so it is repeated less often, a wrong
+// one returns it to the first box.
+type LeitnerSchedule struct {
+	Intervals []time.Duration
+}
+
+func DefaultLeitnerSchedule() LeitnerSchedule {
+	const day = 24 * time.Hour
+
+	return LeitnerSchedule{
+		Intervals: []time.Duration{day, 3 * day, 7 * day, 14 * day, 30 * day},
+	}
+}
+
+// Validate checks that there is at least one box and intervals grow from box to box.
+func (s LeitnerSchedule) Validate() error {
+	if len(s.Intervals) == 0 {
+		return errors.Wrap(ErrInvalidParam, "leitner schedule has no boxes")
+	}
+
+	for i, interval := range s.Intervals {
+		if interval <= 0 {
+			return errors.Wrapf(ErrInvalidParam, "interval of box %d is not positive", i+1)
+		}
+		if i > 0 && interval < s.Intervals[i-1] {
+			return errors.Wrapf(ErrInvalidParam, "interval of box %d is shorter than previous one",
+				i+1)
+		}
+	}
+
+	return nil
+}
+
+// AnswerOutcome tells whether the user answered the question correctly in one session.
+type AnswerOutcome struct {
+	QuestionID string
+	Topic      string
+	IsCorrect  bool
+	AnsweredAt time.Time
+}
+
+// RepetitionCard is the memory state of one question of the user.
+type RepetitionCard struct {
+	QuestionID string
+	Topic      string
+	// Box is the Leitner box of the question starting from 1.
+	Box int
+	// Lapses counts wrong answers to the question.
+	Lapses      int
+	LastCorrect bool
+	ReviewedAt  time.Time
+	DueAt       time.Time
+}
+
+func (c RepetitionCard) IsDue(now time.Time) bool {
+	return !c.DueAt.After(now)
+}
+
+// BuildCards replays outcomes in chronological order and returns cards sorted by question id.
+func (s LeitnerSchedule) BuildCards(outcomes []AnswerOutcome) []RepetitionCard {
+	sorted := slices.Clone(outcomes)
+	slices.SortStableFunc(sorted, func(a, b AnswerOutcome) int {
+		return a.AnsweredAt.Compare(b.AnsweredAt)
+	})
+
+	cards := make(map[string]*RepetitionCard)
+	for _, outcome := range sorted {
+		card, ok := cards[outcome.QuestionID]
+		if !ok {
+			card = &RepetitionCard{QuestionID: outcome.QuestionID, Box: 1}
+			cards[outcome.QuestionID] = card
+		}
+
+		card.Topic = outcome.Topic
+		card.LastCorrect = outcome.IsCorrect
+		card.ReviewedAt = outcome.AnsweredAt
+		if outcome.IsCorrect {
+			card.Box = min(card.Box+1, len(s.Intervals))
+		} else {
+			card.Box = 1
+			card.Lapses++
+		}
+		card.DueAt = outcome.AnsweredAt.Add(s.Intervals[card.Box-1])
+	}
+
+	result := make([]RepetitionCard, 0, len(cards))
+	for _, card := range cards {
+		result = append(result, *card)
+	}
+
+	slices.SortFunc(result, func(a, b RepetitionCard) int {
+		return strings.Compare(a.QuestionID, b.QuestionID)
+	})
+
+	return result
+}
+
+// DueCards returns cards due at now. Questions answered wrong last time go first, then the ones
+// with more lapses, then the ones which have been waiting for repetition longer.
+func DueCards(cards []RepetitionCard, now time.Time) []RepetitionCard {
+	due := make([]RepetitionCard, 0)
+	for _, card := range cards {
+		if card.IsDue(now) {
+			due = append(due, card)
+		}
+	}
+
+	slices.SortStableFunc(due, func(a, b RepetitionCard) int {
+		if a.LastCorrect != b.LastCorrect {
+			if !a.LastCorrect {
+				return -1
+			}
+			return 1
+		}
+		if a.Lapses != b.Lapses {
+			return b.Lapses - a.Lapses
+		}
+
+		return a.DueAt.Compare(b.DueAt)
+	})
+
+	return due
+}
+
+// DueReviews counts questions of the topic due for repetition.
+type DueReviews struct {
+	Topic string
+	Due   int
+	// Failed counts due questions answered wrong last time.
+	Failed int
+	// NextDueAt is the nearest due time of the questions which are not due yet, zero if there
+	// are no such questions.
+	NextDueAt time.Time
+}
+
+// CountDueReviews groups cards by topic, topics are sorted by name.
+func CountDueReviews(cards []RepetitionCard, now time.Time) []DueReviews {
+	byTopic := make(map[string]*DueReviews)
+	for _, card := range cards {
+		reviews, ok := byTopic[card.Topic]
+		if !ok {
+			reviews = &DueReviews{Topic: card.Topic}
+			byTopic[card.Topic] = reviews
+		}
+
+		switch {
+		case card.IsDue(now):
+			reviews.Due++
+			if !card.LastCorrect {
+				reviews.Failed++
+			}
+		case reviews.NextDueAt.IsZero() || card.DueAt.Before(reviews.NextDueAt):
+			reviews.NextDueAt = card.DueAt
+		}
+	}
+
+	result := make([]DueReviews, 0, len(byTopic))
+	for _, reviews := range byTopic {
+		result = append(result, *reviews)
+	}
+
+	slices.SortFunc(result, func(a, b DueReviews) int {
+		return strings.Compare(a.Topic, b.Topic)
+	})
+
+	return result
+}
+
+// GetAnswerOutcomes evaluates every question of the completed session, unanswered questions
+// count as answered wrong. Answers are dated by the session start.
+func (s *Session) GetAnswerOutcomes() ([]AnswerOutcome, error) {
+	if s.GetStatus() != CompletedState {
+		return nil, errors.Wrapf(ErrInvalidState, "%s has no answer outcomes", s.GetStatus())
+	}
+
+	questions, err := s.GetQuestions()
+	if err != nil {
+		return nil, errors.Wrap(err, "GetQuestions")
+	}
+
+	answers, err := s.GetUserAnswers()
+	if err != nil {
+		return nil, errors.Wrap(err, "GetUserAnswers")
+	}
+
+	startedAt, err := s.GetStartedAt()
+	if err != nil {
+		return nil, errors.Wrap(err, "GetStartedAt")
+	}
+
+	answersByQuestion := make(map[string]*UserAnswer, len(answers))
+	for _, answer := range answers {
+		answersByQuestion[answer.GetQuestionID()] = answer
+	}
+
+	outcomes := make([]AnswerOutcome, 0, len(questions))
+	for _, question := range questions {
+		outcome := AnswerOutcome{
+			QuestionID: question.ID(),
+			Topic:      question.Topic(),
+			AnsweredAt: startedAt,
+		}
+		if answer, ok := answersByQuestion[question.ID()]; ok {
+			outcome.IsCorrect = question.IsAnswerCorrect(answer)
+		}
+		outcomes = append(outcomes, outcome)
+	}
+
+	return outcomes, nil
+}
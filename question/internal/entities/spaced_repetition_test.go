@@ -0,0 +1,164 @@
+package entities_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/parta4ok/kvs/question/internal/entities"
+	"github.com/parta4ok/kvs/question/internal/entities/testdata"
+)
+
+func TestLeitnerSchedule_Validate(t *testing.T) {
+	t.Parallel()
+
+	require.NoError(t, entities.DefaultLeitnerSchedule().Validate())
+
+	require.ErrorIs(t, entities.LeitnerSchedule{}.Validate(), entities.ErrInvalidParam)
+	require.ErrorIs(t, entities.LeitnerSchedule{
+		Intervals: []time.Duration{time.Hour, 0},
+	}.Validate(), entities.ErrInvalidParam)
+	require.ErrorIs(t, entities.LeitnerSchedule{
+		Intervals: []time.Duration{2 * time.Hour, time.Hour},
+	}.Validate(), entities.ErrInvalidParam)
+}
+
+func TestLeitnerSchedule_BuildCards(t *testing.T) {
+	t.Parallel()
+
+	schedule := entities.LeitnerSchedule{
+		Intervals: []time.Duration{time.Hour, 2 * time.Hour, 4 * time.Hour},
+	}
+	start := time.Date(2025, 8, 28, 10, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		name     string
+		answers  []bool
+		expected entities.RepetitionCard
+	}{
+		{
+			name:    "correct_once",
+			answers: []bool{true},
+			expected: entities.RepetitionCard{
+				Box: 2, LastCorrect: true, DueAt: start.Add(2 * time.Hour),
+			},
+		},
+		{
+			name:    "promoted_to_last_box",
+			answers: []bool{true, true, true, true},
+			expected: entities.RepetitionCard{
+				Box: 3, LastCorrect: true, DueAt: start.Add(3*time.Hour + 4*time.Hour),
+			},
+		},
+		{
+			name:    "wrong_returns_to_first_box",
+			answers: []bool{true, true, false},
+			expected: entities.RepetitionCard{
+				Box: 1, Lapses: 1, DueAt: start.Add(2*time.Hour + time.Hour),
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			// outcomes are passed in reverse order to check they are replayed chronologically
+			outcomes := make([]entities.AnswerOutcome, 0, len(tc.answers))
+			for i := len(tc.answers) - 1; i >= 0; i-- {
+				outcomes = append(outcomes, entities.AnswerOutcome{
+					QuestionID: "1",
+					Topic:      "Go",
+					IsCorrect:  tc.answers[i],
+					AnsweredAt: start.Add(time.Duration(i) * time.Hour),
+				})
+			}
+
+			cards := schedule.BuildCards(outcomes)
+			require.Len(t, cards, 1)
+
+			tc.expected.QuestionID = "1"
+			tc.expected.Topic = "Go"
+			tc.expected.ReviewedAt = start.Add(time.Duration(len(tc.answers)-1) * time.Hour)
+			require.Equal(t, tc.expected, cards[0])
+		})
+	}
+}
+
+func TestDueCards(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2025, 8, 28, 10, 0, 0, 0, time.UTC)
+	cards := []entities.RepetitionCard{
+		{QuestionID: "1", LastCorrect: true, DueAt: now.Add(-3 * time.Hour)},
+		{QuestionID: "2", Lapses: 1, DueAt: now.Add(-time.Hour)},
+		{QuestionID: "3", Lapses: 2, DueAt: now},
+		{QuestionID: "4", Lapses: 3, DueAt: now.Add(time.Hour)},
+		{QuestionID: "5", LastCorrect: true, DueAt: now.Add(-5 * time.Hour)},
+	}
+
+	due := entities.DueCards(cards, now)
+
+	ids := make([]string, 0, len(due))
+	for _, card := range due {
+		ids = append(ids, card.QuestionID)
+	}
+	require.Equal(t, []string{"3", "2", "5", "1"}, ids)
+}
+
+func TestCountDueReviews(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2025, 8, 28, 10, 0, 0, 0, time.UTC)
+	cards := []entities.RepetitionCard{
+		{QuestionID: "1", Topic: "Go", DueAt: now.Add(-time.Hour)},
+		{QuestionID: "2", Topic: "Go", LastCorrect: true, DueAt: now},
+		{QuestionID: "3", Topic: "Go", LastCorrect: true, DueAt: now.Add(2 * time.Hour)},
+		{QuestionID: "4", Topic: "Базы данных", LastCorrect: true, DueAt: now.Add(time.Hour)},
+		{QuestionID: "5", Topic: "Базы данных", LastCorrect: true, DueAt: now.Add(3 * time.Hour)},
+	}
+
+	require.Equal(t, []entities.DueReviews{
+		{Topic: "Go", Due: 2, Failed: 1, NextDueAt: now.Add(2 * time.Hour)},
+		{Topic: "Базы данных", NextDueAt: now.Add(time.Hour)},
+	}, entities.CountDueReviews(cards, now))
+}
+
+func TestSession_GetAnswerOutcomes(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	generator := testdata.NewMockIDGenerator(ctrl)
+	generator.EXPECT().GenerateID().Return("1").Times(2)
+
+	session, err := entities.NewSession("1", []string{"Go"}, generator)
+	require.NoError(t, err)
+
+	_, err = session.GetAnswerOutcomes()
+	require.ErrorIs(t, err, entities.ErrInvalidState)
+
+	completed, err := entities.NewSession("1", []string{"Go"}, generator,
+		entities.WithNilState())
+	require.NoError(t, err)
+
+	startedAt := time.Date(2025, 8, 28, 10, 0, 0, 0, time.UTC)
+	answer, err := entities.NewUserAnswer("1", []string{"true"})
+	require.NoError(t, err)
+
+	completed.ChangeState(entities.NewCompletedSessionState(map[string]entities.Question{
+		"1": entities.NewTrueOrFalseSelectionQuestion("1", "Go", "nil map можно читать", true),
+		"2": entities.NewTrueOrFalseSelectionQuestion("2", "Go", "nil map можно писать", false),
+	}, completed, []*entities.UserAnswer{answer}, startedAt, false))
+
+	outcomes, err := completed.GetAnswerOutcomes()
+	require.NoError(t, err)
+	require.ElementsMatch(t, []entities.AnswerOutcome{
+		{QuestionID: "1", Topic: "Go", IsCorrect: true, AnsweredAt: startedAt},
+		{QuestionID: "2", Topic: "Go", AnsweredAt: startedAt},
+	}, outcomes)
+}
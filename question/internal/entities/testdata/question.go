@@ -34,6 +34,20 @@ func (m *MockQuestion) EXPECT() *MockQuestionMockRecorder {
 	return m.recorder
 }
 
+// CorrectAnswers mocks base method.
+func (m *MockQuestion) CorrectAnswers() []string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CorrectAnswers")
+	ret0, _ := ret[0].([]string)
+	return ret0
+}
+
+// CorrectAnswers indicates an expected call of CorrectAnswers.
+func (mr *MockQuestionMockRecorder) CorrectAnswers() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CorrectAnswers", reflect.TypeOf((*MockQuestion)(nil).CorrectAnswers))
+}
+
 // ID mocks base method.
 func (m *MockQuestion) ID() string {
 	m.ctrl.T.Helper()
@@ -117,3 +131,40 @@ func (mr *MockQuestionMockRecorder) Variants() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Variants", reflect.TypeOf((*MockQuestion)(nil).Variants))
 }
+
+// MockCreditScorer is a mock of CreditScorer interface.
+type MockCreditScorer struct {
+	ctrl     *gomock.Controller
+	recorder *MockCreditScorerMockRecorder
+}
+
+// MockCreditScorerMockRecorder is the mock recorder for MockCreditScorer.
+type MockCreditScorerMockRecorder struct {
+	mock *MockCreditScorer
+}
+
+// NewMockCreditScorer creates a new mock instance.
+func NewMockCreditScorer(ctrl *gomock.Controller) *MockCreditScorer {
+	mock := &MockCreditScorer{ctrl: ctrl}
+	mock.recorder = &MockCreditScorerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCreditScorer) EXPECT() *MockCreditScorerMockRecorder {
+	return m.recorder
+}
+
+// Credit mocks base method.
+func (m *MockCreditScorer) Credit(ans *entities.UserAnswer) float64 {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Credit", ans)
+	ret0, _ := ret[0].(float64)
+	return ret0
+}
+
+// Credit indicates an expected call of Credit.
+func (mr *MockCreditScorerMockRecorder) Credit(ans interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Credit", reflect.TypeOf((*MockCreditScorer)(nil).Credit), ans)
+}
@@ -5,7 +5,6 @@
 package testdata
 
 import (
-	context "context"
 	reflect "reflect"
 	time "time"
 
@@ -36,6 +35,21 @@ func (m *MockSessionState) EXPECT() *MockSessionStateMockRecorder {
 	return m.recorder
 }
 
+// GetClock mocks base method.
+func (m *MockSessionState) GetClock() (entities.SessionClock, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetClock")
+	ret0, _ := ret[0].(entities.SessionClock)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetClock indicates an expected call of GetClock.
+func (mr *MockSessionStateMockRecorder) GetClock() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetClock", reflect.TypeOf((*MockSessionState)(nil).GetClock))
+}
+
 // GetQuestions mocks base method.
 func (m *MockSessionState) GetQuestions() ([]entities.Question, error) {
 	m.ctrl.T.Helper()
@@ -125,21 +139,6 @@ func (mr *MockSessionStateMockRecorder) GetUserAnswers() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserAnswers", reflect.TypeOf((*MockSessionState)(nil).GetUserAnswers))
 }
 
-// IsDailySessionLimitReached mocks base method.
-func (m *MockSessionState) IsDailySessionLimitReached(ctx context.Context, userID string, topics []string) (bool, error) {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "IsDailySessionLimitReached", ctx, userID, topics)
-	ret0, _ := ret[0].(bool)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
-}
-
-// IsDailySessionLimitReached indicates an expected call of IsDailySessionLimitReached.
-func (mr *MockSessionStateMockRecorder) IsDailySessionLimitReached(ctx, userID, topics interface{}) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsDailySessionLimitReached", reflect.TypeOf((*MockSessionState)(nil).IsDailySessionLimitReached), ctx, userID, topics)
-}
-
 // IsExpired mocks base method.
 func (m *MockSessionState) IsExpired() (bool, error) {
 	m.ctrl.T.Helper()
@@ -155,6 +154,34 @@ func (mr *MockSessionStateMockRecorder) IsExpired() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsExpired", reflect.TypeOf((*MockSessionState)(nil).IsExpired))
 }
 
+// Pause mocks base method.
+func (m *MockSessionState) Pause(now time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Pause", now)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Pause indicates an expected call of Pause.
+func (mr *MockSessionStateMockRecorder) Pause(now interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Pause", reflect.TypeOf((*MockSessionState)(nil).Pause), now)
+}
+
+// Resume mocks base method.
+func (m *MockSessionState) Resume(now time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Resume", now)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Resume indicates an expected call of Resume.
+func (mr *MockSessionStateMockRecorder) Resume(now interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Resume", reflect.TypeOf((*MockSessionState)(nil).Resume), now)
+}
+
 // SetQuestions mocks base method.
 func (m *MockSessionState) SetQuestions(qestions map[string]entities.Question, duration time.Duration) error {
 	m.ctrl.T.Helper()
@@ -1,50 +0,0 @@
-// Code generated by MockGen. DO NOT EDIT.
-// Source: session_storage.go
-
-// Package testdata is a generated GoMock package.
-package testdata
-
-import (
-	context "context"
-	reflect "reflect"
-
-	gomock "github.com/golang/mock/gomock"
-)
-
-// MockSessionStorage is a mock of SessionStorage interface.
-type MockSessionStorage struct {
-	ctrl     *gomock.Controller
-	recorder *MockSessionStorageMockRecorder
-}
-
-// MockSessionStorageMockRecorder is the mock recorder for MockSessionStorage.
-type MockSessionStorageMockRecorder struct {
-	mock *MockSessionStorage
-}
-
-// NewMockSessionStorage creates a new mock instance.
-func NewMockSessionStorage(ctrl *gomock.Controller) *MockSessionStorage {
-	mock := &MockSessionStorage{ctrl: ctrl}
-	mock.recorder = &MockSessionStorageMockRecorder{mock}
-	return mock
-}
-
-// EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockSessionStorage) EXPECT() *MockSessionStorageMockRecorder {
-	return m.recorder
-}
-
-// IsDailySessionLimitReached mocks base method.
-func (m *MockSessionStorage) IsDailySessionLimitReached(ctx context.Context, userID string, topics []string) (bool, error) {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "IsDailySessionLimitReached", ctx, userID, topics)
-	ret0, _ := ret[0].(bool)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
-}
-
-// IsDailySessionLimitReached indicates an expected call of IsDailySessionLimitReached.
-func (mr *MockSessionStorageMockRecorder) IsDailySessionLimitReached(ctx, userID, topics interface{}) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsDailySessionLimitReached", reflect.TypeOf((*MockSessionStorage)(nil).IsDailySessionLimitReached), ctx, userID, topics)
-}
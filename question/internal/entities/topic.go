@@ -0,0 +1,106 @@
+package entities
+
+import (
+	"strings"
+	"unicode/utf8"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	MaxTopicNameLength = 255
+)
+
+type Topic struct {
+	id            string
+	name          string
+	passThreshold float64
+	maxPauses     int
+	selection     SelectionStrategy
+}
+
+type TopicOption func(*Topic)
+
+// WithPassThreshold sets percent of score required to pass sessions on the topic.
+func WithPassThreshold(threshold float64) TopicOption {
+	return func(t *Topic) {
+		t.passThreshold = threshold
+	}
+}
+
+// WithMaxPauses sets how many times sessions on the topic may be paused. Zero forbids pauses.
+func WithMaxPauses(maxPauses int) TopicOption {
+	return func(t *Topic) {
+		t.maxPauses = maxPauses
+	}
+}
+
+// WithSelectionStrategy sets how questions of the topic are selected for new sessions.
+func WithSelectionStrategy(strategy SelectionStrategy) TopicOption {
+	return func(t *Topic) {
+		t.selection = strategy
+	}
+}
+
+func NewTopic(id string, name string, opts ...TopicOption) (*Topic, error) {
+	if id == "" {
+		return nil, errors.Wrap(ErrInvalidParam, "invalid topic id")
+	}
+
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return nil, errors.Wrap(ErrInvalidParam, "topic name is empty")
+	}
+
+	if utf8.RuneCountInString(name) > MaxTopicNameLength {
+		return nil, errors.Wrapf(ErrInvalidParam,
+			"topic name must be shorter then %d symbols", MaxTopicNameLength)
+	}
+
+	topic := &Topic{
+		id:            id,
+		name:          name,
+		passThreshold: DefaultBorderResult,
+		selection:     DefaultSelectionStrategy,
+	}
+
+	for _, opt := range opts {
+		opt(topic)
+	}
+
+	if err := ValidatePassThreshold(topic.passThreshold); err != nil {
+		return nil, err
+	}
+
+	if err := ValidateMaxPauses(topic.maxPauses); err != nil {
+		return nil, err
+	}
+
+	selection, err := NewSelectionStrategy(string(topic.selection))
+	if err != nil {
+		return nil, err
+	}
+	topic.selection = selection
+
+	return topic, nil
+}
+
+func (t *Topic) ID() string {
+	return t.id
+}
+
+func (t *Topic) Name() string {
+	return t.name
+}
+
+func (t *Topic) PassThreshold() float64 {
+	return t.passThreshold
+}
+
+func (t *Topic) MaxPauses() int {
+	return t.maxPauses
+}
+
+func (t *Topic) SelectionStrategy() SelectionStrategy {
+	return t.selection
+}
@@ -0,0 +1,63 @@
+package entities
+
+import (
+	"sort"
+)
+
+// TopicScore is the part of the session result related to one topic of the session.
+type TopicScore struct {
+	Topic string
+	// Score is the weighted share of points earned in the topic in percents.
+	Score        float64
+	CorrectCount int
+	TotalCount   int
+}
+
+// scoreByTopics groups earned credits of the questions by their topics. Questions without
+// credit are counted as unanswered.
+func scoreByTopics(questions map[string]Question, credits map[string]float64) []TopicScore {
+	type topicTotals struct {
+		earned, total float64
+		correct       int
+		count         int
+	}
+
+	totals := make(map[string]*topicTotals)
+	for questionID, question := range questions {
+		topic := question.Topic()
+		if _, ok := totals[topic]; !ok {
+			totals[topic] = &topicTotals{}
+		}
+
+		weight := weightOf(question)
+		credit := credits[questionID]
+
+		totals[topic].total += weight
+		totals[topic].earned += weight * credit
+		totals[topic].count++
+		if credit >= 1 {
+			totals[topic].correct++
+		}
+	}
+
+	scores := make([]TopicScore, 0, len(totals))
+	for topic, topicTotal := range totals {
+		var percent float64
+		if topicTotal.total > 0 {
+			percent = max(0, topicTotal.earned/topicTotal.total*100)
+		}
+
+		scores = append(scores, TopicScore{
+			Topic:        topic,
+			Score:        percent,
+			CorrectCount: topicTotal.correct,
+			TotalCount:   topicTotal.count,
+		})
+	}
+
+	sort.Slice(scores, func(i, j int) bool {
+		return scores[i].Topic < scores[j].Topic
+	})
+
+	return scores
+}
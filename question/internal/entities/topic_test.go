@@ -0,0 +1,99 @@
+package entities_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/parta4ok/kvs/question/internal/entities"
+)
+
+func TestNewTopic(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name         string
+		id           string
+		topicName    string
+		expectedName string
+		expectedErr  string
+	}{
+		{
+			name:         "success",
+			id:           "1",
+			topicName:    "  Базы данных ",
+			expectedName: "Базы данных",
+		},
+		{
+			name:        "empty_id",
+			id:          "",
+			topicName:   "Базы данных",
+			expectedErr: "invalid topic id",
+		},
+		{
+			name:        "empty_name",
+			id:          "1",
+			topicName:   "   ",
+			expectedErr: "topic name is empty",
+		},
+		{
+			name:        "too_long_name",
+			id:          "1",
+			topicName:   strings.Repeat("я", entities.MaxTopicNameLength+1),
+			expectedErr: "topic name must be shorter",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			topic, err := entities.NewTopic(tc.id, tc.topicName)
+			if tc.expectedErr != "" {
+				require.ErrorIs(t, err, entities.ErrInvalidParam)
+				require.Contains(t, err.Error(), tc.expectedErr)
+				require.Nil(t, topic)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.id, topic.ID())
+			require.Equal(t, tc.expectedName, topic.Name())
+		})
+	}
+}
+
+func TestNewTopic_PassThreshold(t *testing.T) {
+	t.Parallel()
+
+	topic, err := entities.NewTopic("1", "Go")
+	require.NoError(t, err)
+	require.Equal(t, entities.DefaultBorderResult, topic.PassThreshold())
+
+	topic, err = entities.NewTopic("1", "Go", entities.WithPassThreshold(85))
+	require.NoError(t, err)
+	require.Equal(t, 85.0, topic.PassThreshold())
+
+	topic, err = entities.NewTopic("1", "Go", entities.WithPassThreshold(120))
+	require.ErrorIs(t, err, entities.ErrInvalidParam)
+	require.Nil(t, topic)
+}
+
+func TestNewTopic_SelectionStrategy(t *testing.T) {
+	t.Parallel()
+
+	topic, err := entities.NewTopic("1", "Go")
+	require.NoError(t, err)
+	require.Equal(t, entities.UniformSelection, topic.SelectionStrategy())
+
+	topic, err = entities.NewTopic("1", "Go", entities.WithSelectionStrategy(
+		entities.AdaptiveSelection))
+	require.NoError(t, err)
+	require.Equal(t, entities.AdaptiveSelection, topic.SelectionStrategy())
+
+	topic, err = entities.NewTopic("1", "Go", entities.WithSelectionStrategy("hardest"))
+	require.ErrorIs(t, err, entities.ErrInvalidParam)
+	require.Nil(t, topic)
+}
@@ -1,6 +1,7 @@
 package entities
 
 import (
+	"strconv"
 	"strings"
 )
 
@@ -9,6 +10,11 @@ var (
 )
 
 type TrueOrFalseSelectionQuestion struct {
+	questionWeight
+	questionExplanation
+	questionDifficulty
+	questionVersion
+
 	id            string
 	topic         string
 	subject       string
@@ -44,6 +50,10 @@ func (q *TrueOrFalseSelectionQuestion) Variants() []string {
 	return []string{"true", "false"}
 }
 
+func (q *TrueOrFalseSelectionQuestion) CorrectAnswers() []string {
+	return []string{strconv.FormatBool(q.correctAnswer)}
+}
+
 func (q *TrueOrFalseSelectionQuestion) IsAnswerCorrect(ans *UserAnswer) bool {
 	if len(ans.answer) != 1 {
 		return false
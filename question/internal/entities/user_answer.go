@@ -25,3 +25,25 @@ func (ans *UserAnswer) GetQuestionID() string {
 func (ans *UserAnswer) GetSelections() []string {
 	return ans.answer
 }
+
+// MergeAnswers completes the final answers with drafts of questions the final answers miss.
+// A final answer always overrides the draft of the same question.
+func MergeAnswers(drafts []*UserAnswer, final []*UserAnswer) []*UserAnswer {
+	merged := make([]*UserAnswer, 0, len(drafts)+len(final))
+	answered := make(map[string]struct{}, len(final))
+
+	for _, answer := range final {
+		answered[answer.questionID] = struct{}{}
+		merged = append(merged, answer)
+	}
+
+	for _, draft := range drafts {
+		if _, ok := answered[draft.questionID]; ok {
+			continue
+		}
+		answered[draft.questionID] = struct{}{}
+		merged = append(merged, draft)
+	}
+
+	return merged
+}
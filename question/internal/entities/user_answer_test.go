@@ -77,3 +77,31 @@ func TestUserAnswer_GetSelections(t *testing.T) {
 	require.Equal(t, answers, result)
 	require.Len(t, result, 3)
 }
+
+func TestMergeAnswers(t *testing.T) {
+	t.Parallel()
+
+	newAnswer := func(id string, selections ...string) *entities.UserAnswer {
+		answer, err := entities.NewUserAnswer(id, selections)
+		require.NoError(t, err)
+		return answer
+	}
+
+	drafts := []*entities.UserAnswer{newAnswer("1", "draft"), newAnswer("2", "draft")}
+	final := []*entities.UserAnswer{newAnswer("2", "final"), newAnswer("3", "final")}
+
+	merged := entities.MergeAnswers(drafts, final)
+
+	require.Len(t, merged, 3)
+	selections := make(map[string][]string, len(merged))
+	for _, answer := range merged {
+		selections[answer.GetQuestionID()] = answer.GetSelections()
+	}
+	require.Equal(t, map[string][]string{
+		"1": {"draft"},
+		"2": {"final"},
+		"3": {"final"},
+	}, selections)
+
+	require.Empty(t, entities.MergeAnswers(nil, nil))
+}
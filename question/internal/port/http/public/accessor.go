@@ -5,4 +5,7 @@ import "context"
 //go:generate mockgen -source=accessor.go -destination=./testdata/accessor.go -package=testdata
 type Accessor interface {
 	HasPermission(ctx context.Context, rights []string) (bool, error)
+	CanAccess(ctx context.Context, ownerID string) (bool, error)
+	IsOwner(ctx context.Context, ownerID string) (bool, error)
+	IsAdmin(ctx context.Context) (bool, error)
 }
@@ -0,0 +1,13 @@
+package public
+
+import (
+	"context"
+
+	"github.com/parta4ok/kvs/question/internal/entities"
+)
+
+//go:generate mockgen -source=attempt_service.go -destination=./testdata/attempt_service.go -package=testdata
+type AttemptService interface {
+	GrantAttempts(ctx context.Context, studentID string, grant entities.AttemptGrant) error
+	SetUserTimezone(ctx context.Context, userID string, timezone string, changedBy string) error
+}
@@ -0,0 +1,201 @@
+package public
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+
+	"github.com/go-chi/chi"
+	"github.com/parta4ok/kvs/toolkit/pkg/accessor"
+	"github.com/pkg/errors"
+
+	"github.com/parta4ok/kvs/question/internal/entities"
+	"github.com/parta4ok/kvs/question/pkg/dto"
+)
+
+// GrantAttempts gives the student extra attempts of the topic
+//
+// @Summary      Grant attempts
+// @Description  Adds attempts of the topic to the limit of the current attempts window of the student. Requires the manage_attempts right. Available to admins and mentors linked to the student, students cannot grant attempts to themselves
+// @Accept       json
+// @Produce      json
+// @Security     ApiKeyAuth
+// @Param        Authorization header string true "Bearer {token}"
+// @Param        user_id path int true "Student ID"
+// @Param        request body dto.AttemptGrantDTO true "Topic and number of attempts"
+// @Success      201 {object} dto.AttemptGrantDTO "Attempts granted"
+// @Failure      400 {object} dto.ErrorDTO "Invalid parameters"
+// @Failure      403 {object} dto.ErrorDTO "Not enough rights or the student is not linked to the mentor"
+// @Failure      404 {object} dto.ErrorDTO "Topic not found"
+// @Failure      500 {object} dto.ErrorDTO "Internal server error"
+// @Router       /{user_id}/attempts/grants [post]
+func (s *Server) GrantAttempts(resp http.ResponseWriter, req *http.Request) {
+	slog.Info("GrantAttempts started")
+
+	ctx := req.Context()
+
+	if err := s.checkUserRights(ctx, []string{right_manage_attempts}); err != nil {
+		slog.Error(err.Error())
+		s.errProcessing(resp, err)
+		return
+	}
+
+	resp.Header().Set("Content-Type", "application/json")
+
+	studentID := chi.URLParam(req, "user_id")
+	if studentID == "" {
+		err := errors.Wrap(entities.ErrInvalidParam, "userID invalid")
+		slog.Error(err.Error())
+		s.errProcessing(resp, err)
+		return
+	}
+
+	if err := s.checkOwnerAccess(ctx, studentID); err != nil {
+		slog.Error(err.Error())
+		s.errProcessing(resp, err)
+		return
+	}
+
+	isOwner, err := s.accessor.IsOwner(ctx, studentID)
+	if err != nil {
+		slog.Error(err.Error())
+		s.errProcessing(resp, err)
+		return
+	}
+
+	if isOwner {
+		err := errors.Wrap(entities.ErrForbidden, "attempts cannot be granted to oneself")
+		slog.Error(err.Error())
+		s.errProcessing(resp, err)
+		return
+	}
+
+	var grantDTO dto.AttemptGrantDTO
+	if err := json.NewDecoder(req.Body).Decode(&grantDTO); err != nil {
+		err := errors.Wrapf(entities.ErrInvalidParam,
+			"decode request body to attemptGrantDTO failure: %v", err)
+		slog.Error(err.Error())
+		s.errProcessing(resp, err)
+		return
+	}
+
+	mentorID, err := s.callerID(ctx)
+	if err != nil {
+		slog.Error(err.Error())
+		s.errProcessing(resp, err)
+		return
+	}
+
+	grant := entities.AttemptGrant{
+		Topic:     grantDTO.Topic,
+		Attempts:  grantDTO.Attempts,
+		GrantedBy: mentorID,
+	}
+
+	if err := s.attempts.GrantAttempts(ctx, studentID, grant); err != nil {
+		err := errors.Wrap(err, "GrantAttempts failure")
+		slog.Error(err.Error())
+		s.errProcessing(resp, err)
+		return
+	}
+
+	grantDTO.GrantedBy = mentorID
+	s.writeResponse(resp, http.StatusCreated, grantDTO)
+	slog.Info("GrantAttempts completed")
+}
+
+// SetUserTimezone sets timezone the day boundaries of the attempt policy are counted in
+//
+// @Summary      Set user timezone
+// @Description  Sets IANA timezone of the user. Calendar day attempt windows start at midnight in this timezone, UTC is used until the timezone is set. Users change own timezone not more often than kvs.attempts.timezone_change_interval allows, mentors linked to the user and admins with the manage_attempts right are not limited
+// @Accept       json
+// @Produce      json
+// @Security     ApiKeyAuth
+// @Param        Authorization header string true "Bearer {token}"
+// @Param        user_id path int true "User ID"
+// @Param        request body dto.TimezoneDTO true "Timezone"
+// @Success      204 "Timezone saved"
+// @Failure      400 {object} dto.ErrorDTO "Unknown timezone"
+// @Failure      403 {object} dto.ErrorDTO "No access to the user"
+// @Failure      409 {object} dto.ErrorDTO "Own timezone was changed recently"
+// @Failure      500 {object} dto.ErrorDTO "Internal server error"
+// @Router       /{user_id}/timezone [put]
+func (s *Server) SetUserTimezone(resp http.ResponseWriter, req *http.Request) {
+	slog.Info("SetUserTimezone started")
+
+	ctx := req.Context()
+
+	userID := chi.URLParam(req, "user_id")
+	if userID == "" {
+		err := errors.Wrap(entities.ErrInvalidParam, "userID invalid")
+		slog.Error(err.Error())
+		s.errProcessing(resp, err)
+		return
+	}
+
+	isOwner, err := s.accessor.IsOwner(ctx, userID)
+	if err != nil {
+		slog.Error(err.Error())
+		s.errProcessing(resp, err)
+		return
+	}
+
+	// timezone of another user moves the day boundaries of the attempts, so it is changed by
+	// the ones who manage attempts of the user
+	requiredRights := []string{right_start_session}
+	if !isOwner {
+		requiredRights = []string{right_manage_attempts}
+	}
+
+	if err := s.checkUserRights(ctx, requiredRights); err != nil {
+		slog.Error(err.Error())
+		s.errProcessing(resp, err)
+		return
+	}
+
+	resp.Header().Set("Content-Type", "application/json")
+
+	if err := s.checkOwnerAccess(ctx, userID); err != nil {
+		slog.Error(err.Error())
+		s.errProcessing(resp, err)
+		return
+	}
+
+	var timezoneDTO dto.TimezoneDTO
+	if err := json.NewDecoder(req.Body).Decode(&timezoneDTO); err != nil {
+		err := errors.Wrapf(entities.ErrInvalidParam,
+			"decode request body to timezoneDTO failure: %v", err)
+		slog.Error(err.Error())
+		s.errProcessing(resp, err)
+		return
+	}
+
+	callerID, err := s.callerID(ctx)
+	if err != nil {
+		slog.Error(err.Error())
+		s.errProcessing(resp, err)
+		return
+	}
+
+	if err := s.attempts.SetUserTimezone(ctx, userID, timezoneDTO.Timezone,
+		callerID); err != nil {
+		err := errors.Wrap(err, "SetUserTimezone failure")
+		slog.Error(err.Error())
+		s.errProcessing(resp, err)
+		return
+	}
+
+	resp.WriteHeader(http.StatusNoContent)
+	slog.Info("SetUserTimezone completed")
+}
+
+// callerID returns subject of the token the request was made with.
+func (s *Server) callerID(ctx context.Context) (string, error) {
+	claims, ok := ctx.Value(accessor.UserClaims).(*accessor.Claims)
+	if !ok {
+		return "", errors.Wrap(accessor.ErrAssertion, "assert data from context to claims failure")
+	}
+
+	return claims.Subject, nil
+}
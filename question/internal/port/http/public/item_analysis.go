@@ -0,0 +1,186 @@
+package public
+
+import (
+	"encoding/csv"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/go-chi/chi"
+	"github.com/pkg/errors"
+
+	"github.com/parta4ok/kvs/question/internal/entities"
+	"github.com/parta4ok/kvs/question/pkg/dto"
+)
+
+// GetItemStatistics returns item analysis of questions of the topic
+//
+// @Summary      Get item statistics
+// @Description  Returns item statistics of active questions of the topic computed from completed exam sessions by the last analysis: difficulty index, discrimination index, selections of every variant and flags of questions to look at. format=csv returns the same statistics as a CSV file
+// @Accept       json
+// @Produce      json
+// @Produce      text/csv
+// @Security     ApiKeyAuth
+// @Param        Authorization header string true "Bearer {token}"
+// @Param        topic_id path int true "Topic ID"
+// @Param        format query string false "Statistics format" Enums(json, csv)
+// @Success      200 {object} dto.ItemStatisticsListDTO "Item statistics"
+// @Failure      400 {object} dto.ErrorDTO "Invalid parameters"
+// @Failure      403 {object} dto.ErrorDTO "Not enough rights"
+// @Failure      404 {object} dto.ErrorDTO "Topic not found"
+// @Failure      500 {object} dto.ErrorDTO "Internal server error"
+// @Router       /topics/{topic_id}/item_analysis [get]
+func (s *Server) GetItemStatistics(resp http.ResponseWriter, req *http.Request) {
+	slog.Info("GetItemStatistics started")
+
+	if err := s.checkUserRights(req.Context(), []string{right_manage_questions}); err != nil {
+		slog.Error(err.Error())
+		s.errProcessing(resp, err)
+		return
+	}
+
+	format := req.URL.Query().Get("format")
+	if format == "" {
+		format = reportFormatJSON
+	}
+	if format != reportFormatJSON && format != reportFormatCSV {
+		err := errors.Wrapf(entities.ErrInvalidParam, "unknown statistics format: %s", format)
+		slog.Error(err.Error())
+		s.errProcessing(resp, err)
+		return
+	}
+
+	topicID := chi.URLParam(req, "topic_id")
+	statistics, err := s.itemAnalysis.GetItemStatistics(req.Context(), topicID)
+	if err != nil {
+		err := errors.Wrap(err, "GetItemStatistics failure")
+		slog.Error(err.Error())
+		s.errProcessing(resp, err)
+		return
+	}
+
+	statisticsDTO := s.toItemStatisticsListDTO(topicID, statistics)
+
+	if format == reportFormatCSV {
+		s.writeItemStatisticsCSV(resp, statisticsDTO)
+		slog.Info("GetItemStatistics completed")
+		return
+	}
+
+	resp.Header().Set("Content-Type", "application/json")
+	s.writeResponse(resp, http.StatusOK, statisticsDTO)
+	slog.Info("GetItemStatistics completed")
+}
+
+// AnalyzeTopic recomputes item analysis of questions of the topic
+//
+// @Summary      Analyze topic
+// @Description  Recomputes item statistics of active questions of the topic without waiting for the periodic analysis
+// @Accept       json
+// @Produce      json
+// @Security     ApiKeyAuth
+// @Param        Authorization header string true "Bearer {token}"
+// @Param        topic_id path int true "Topic ID"
+// @Success      200 {object} dto.ItemStatisticsListDTO "Item statistics"
+// @Failure      400 {object} dto.ErrorDTO "Invalid parameters"
+// @Failure      403 {object} dto.ErrorDTO "Not enough rights"
+// @Failure      404 {object} dto.ErrorDTO "Topic not found"
+// @Failure      500 {object} dto.ErrorDTO "Internal server error"
+// @Router       /topics/{topic_id}/item_analysis [post]
+func (s *Server) AnalyzeTopic(resp http.ResponseWriter, req *http.Request) {
+	slog.Info("AnalyzeTopic started")
+	resp.Header().Set("Content-Type", "application/json")
+
+	if err := s.checkUserRights(req.Context(), []string{right_manage_questions}); err != nil {
+		slog.Error(err.Error())
+		s.errProcessing(resp, err)
+		return
+	}
+
+	topicID := chi.URLParam(req, "topic_id")
+	statistics, err := s.itemAnalysis.AnalyzeTopic(req.Context(), topicID)
+	if err != nil {
+		err := errors.Wrap(err, "AnalyzeTopic failure")
+		slog.Error(err.Error())
+		s.errProcessing(resp, err)
+		return
+	}
+
+	s.writeResponse(resp, http.StatusOK, s.toItemStatisticsListDTO(topicID, statistics))
+	slog.Info("AnalyzeTopic completed")
+}
+
+func (s *Server) toItemStatisticsListDTO(topicID string,
+	statistics []entities.ItemStatistics) dto.ItemStatisticsListDTO {
+	items := make([]dto.ItemStatisticsDTO, 0, len(statistics))
+	for _, stats := range statistics {
+		distractors := make([]dto.DistractorStatsDTO, 0, len(stats.Distractors))
+		for _, distractor := range stats.Distractors {
+			distractors = append(distractors, dto.DistractorStatsDTO{
+				Variant:    distractor.Variant,
+				IsCorrect:  distractor.IsCorrect,
+				Selections: distractor.Selections,
+				Share:      distractor.Share,
+			})
+		}
+
+		flags := make([]string, 0, len(stats.Flags))
+		for _, flag := range stats.Flags {
+			flags = append(flags, string(flag))
+		}
+
+		items = append(items, dto.ItemStatisticsDTO{
+			QuestionID:     stats.QuestionID,
+			Topic:          stats.Topic,
+			Subject:        stats.Subject,
+			QuestionType:   stats.Type.String(),
+			Responses:      stats.Responses,
+			Correct:        stats.Correct,
+			Omitted:        stats.Omitted,
+			Difficulty:     stats.Difficulty,
+			Discrimination: stats.Discrimination,
+			Distractors:    distractors,
+			Flags:          flags,
+			ComputedAt:     stats.ComputedAt,
+		})
+	}
+
+	return dto.ItemStatisticsListDTO{TopicID: topicID, Items: items}
+}
+
+// writeItemStatisticsCSV writes two tables separated by an empty line: statistics of
+// questions and selections of their variants.
+func (s *Server) writeItemStatisticsCSV(resp http.ResponseWriter,
+	statistics dto.ItemStatisticsListDTO) {
+	resp.Header().Set("Content-Type", "text/csv; charset=utf-8")
+	resp.Header().Set("Content-Disposition",
+		fmt.Sprintf("attachment; filename=\"item_analysis_%s.csv\"", statistics.TopicID))
+	resp.WriteHeader(http.StatusOK)
+
+	records := [][]string{{"question_id", "topic", "subject", "question_type", "responses",
+		"correct", "omitted", "difficulty", "discrimination", "flags"}}
+	for _, item := range statistics.Items {
+		records = append(records, []string{item.QuestionID, item.Topic, item.Subject,
+			item.QuestionType, strconv.Itoa(item.Responses), strconv.Itoa(item.Correct),
+			strconv.Itoa(item.Omitted), formatFloat(item.Difficulty),
+			formatFloat(item.Discrimination), strings.Join(item.Flags, ";")})
+	}
+
+	records = append(records, nil,
+		[]string{"question_id", "variant", "is_correct", "selections", "share"})
+	for _, item := range statistics.Items {
+		for _, distractor := range item.Distractors {
+			records = append(records, []string{item.QuestionID, distractor.Variant,
+				strconv.FormatBool(distractor.IsCorrect), strconv.Itoa(distractor.Selections),
+				formatFloat(distractor.Share)})
+		}
+	}
+
+	// the status is already sent, so a write failure can only be logged
+	if err := csv.NewWriter(resp).WriteAll(records); err != nil {
+		err := errors.Wrapf(entities.ErrInternal, "write csv item statistics failure: %v", err)
+		slog.Error(err.Error())
+	}
+}
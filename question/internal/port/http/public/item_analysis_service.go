@@ -0,0 +1,13 @@
+package public
+
+import (
+	"context"
+
+	"github.com/parta4ok/kvs/question/internal/entities"
+)
+
+//go:generate mockgen -source=item_analysis_service.go -destination=./testdata/item_analysis_service.go -package=testdata
+type ItemAnalysisService interface {
+	AnalyzeTopic(ctx context.Context, topicID string) ([]entities.ItemStatistics, error)
+	GetItemStatistics(ctx context.Context, topicID string) ([]entities.ItemStatistics, error)
+}
@@ -0,0 +1,12 @@
+package public
+
+import (
+	"context"
+)
+
+//go:generate mockgen -source=mentor_service.go -destination=./testdata/mentor_service.go -package=testdata
+type MentorService interface {
+	LinkStudent(ctx context.Context, mentorID string, studentID string) error
+	UnlinkStudent(ctx context.Context, mentorID string, studentID string) error
+	GetMentorStudents(ctx context.Context, mentorID string) ([]string, error)
+}
@@ -0,0 +1,153 @@
+package public
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+
+	"github.com/go-chi/chi"
+	"github.com/pkg/errors"
+
+	"github.com/parta4ok/kvs/question/internal/entities"
+	"github.com/parta4ok/kvs/question/pkg/dto"
+)
+
+// GetMentorStudents returns students linked to the mentor
+//
+// @Summary      Get mentor students
+// @Description  Returns ids of students linked to the mentor. Linked mentors may view sessions and reports and manage attempts of the students. Available to admins only
+// @Accept       json
+// @Produce      json
+// @Security     ApiKeyAuth
+// @Param        Authorization header string true "Bearer {token}"
+// @Param        mentor_id path int true "Mentor ID"
+// @Success      200 {object} dto.MentorStudentsDTO "Linked students"
+// @Failure      400 {object} dto.ErrorDTO "Invalid parameters"
+// @Failure      403 {object} dto.ErrorDTO "Not an admin"
+// @Failure      500 {object} dto.ErrorDTO "Internal server error"
+// @Router       /mentors/{mentor_id}/students [get]
+func (s *Server) GetMentorStudents(resp http.ResponseWriter, req *http.Request) {
+	slog.Info("GetMentorStudents started")
+
+	ctx := req.Context()
+
+	if err := s.checkAdmin(ctx); err != nil {
+		slog.Error(err.Error())
+		s.errProcessing(resp, err)
+		return
+	}
+
+	resp.Header().Set("Content-Type", "application/json")
+
+	mentorID := chi.URLParam(req, "mentor_id")
+	students, err := s.mentors.GetMentorStudents(ctx, mentorID)
+	if err != nil {
+		err := errors.Wrap(err, "GetMentorStudents failure")
+		slog.Error(err.Error())
+		s.errProcessing(resp, err)
+		return
+	}
+
+	s.writeResponse(resp, http.StatusOK, dto.MentorStudentsDTO{
+		MentorID: mentorID,
+		Students: students,
+	})
+	slog.Info("GetMentorStudents completed")
+}
+
+// LinkStudent links the student to the mentor
+//
+// @Summary      Link student
+// @Description  Links the student to the mentor, so the mentor may view sessions and reports and manage attempts of the student. A student has one mentor, the link replaces the previous one. Linking twice is not an error. Available to admins only
+// @Accept       json
+// @Produce      json
+// @Security     ApiKeyAuth
+// @Param        Authorization header string true "Bearer {token}"
+// @Param        mentor_id path int true "Mentor ID"
+// @Param        student_id path int true "Student ID"
+// @Success      204 "Student linked"
+// @Failure      400 {object} dto.ErrorDTO "Invalid parameters"
+// @Failure      403 {object} dto.ErrorDTO "Not an admin"
+// @Failure      404 {object} dto.ErrorDTO "Mentor or student not found"
+// @Failure      500 {object} dto.ErrorDTO "Internal server error"
+// @Router       /mentors/{mentor_id}/students/{student_id} [put]
+func (s *Server) LinkStudent(resp http.ResponseWriter, req *http.Request) {
+	slog.Info("LinkStudent started")
+
+	ctx := req.Context()
+
+	if err := s.checkAdmin(ctx); err != nil {
+		slog.Error(err.Error())
+		s.errProcessing(resp, err)
+		return
+	}
+
+	resp.Header().Set("Content-Type", "application/json")
+
+	mentorID := chi.URLParam(req, "mentor_id")
+	studentID := chi.URLParam(req, "student_id")
+	if err := s.mentors.LinkStudent(ctx, mentorID, studentID); err != nil {
+		err := errors.Wrap(err, "LinkStudent failure")
+		slog.Error(err.Error())
+		s.errProcessing(resp, err)
+		return
+	}
+
+	resp.WriteHeader(http.StatusNoContent)
+	slog.Info("LinkStudent completed")
+}
+
+// UnlinkStudent removes link between the mentor and the student
+//
+// @Summary      Unlink student
+// @Description  Removes link between the mentor and the student, the mentor loses access to resources of the student. Available to admins only
+// @Accept       json
+// @Produce      json
+// @Security     ApiKeyAuth
+// @Param        Authorization header string true "Bearer {token}"
+// @Param        mentor_id path int true "Mentor ID"
+// @Param        student_id path int true "Student ID"
+// @Success      204 "Student unlinked"
+// @Failure      400 {object} dto.ErrorDTO "Invalid parameters"
+// @Failure      403 {object} dto.ErrorDTO "Not an admin"
+// @Failure      404 {object} dto.ErrorDTO "Student is not linked to the mentor"
+// @Failure      500 {object} dto.ErrorDTO "Internal server error"
+// @Router       /mentors/{mentor_id}/students/{student_id} [delete]
+func (s *Server) UnlinkStudent(resp http.ResponseWriter, req *http.Request) {
+	slog.Info("UnlinkStudent started")
+
+	ctx := req.Context()
+
+	if err := s.checkAdmin(ctx); err != nil {
+		slog.Error(err.Error())
+		s.errProcessing(resp, err)
+		return
+	}
+
+	resp.Header().Set("Content-Type", "application/json")
+
+	mentorID := chi.URLParam(req, "mentor_id")
+	studentID := chi.URLParam(req, "student_id")
+	if err := s.mentors.UnlinkStudent(ctx, mentorID, studentID); err != nil {
+		err := errors.Wrap(err, "UnlinkStudent failure")
+		slog.Error(err.Error())
+		s.errProcessing(resp, err)
+		return
+	}
+
+	resp.WriteHeader(http.StatusNoContent)
+	slog.Info("UnlinkStudent completed")
+}
+
+func (s *Server) checkAdmin(ctx context.Context) error {
+	isAdmin, err := s.accessor.IsAdmin(ctx)
+	if err != nil {
+		return err
+	}
+
+	if !isAdmin {
+		return errors.Wrap(entities.ErrForbidden, "only admins manage mentor students")
+	}
+
+	return nil
+}
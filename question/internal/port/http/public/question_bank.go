@@ -0,0 +1,554 @@
+package public
+
+import (
+	"encoding/json"
+	"log/slog"
+	"net/http"
+
+	"github.com/go-chi/chi"
+	"github.com/pkg/errors"
+
+	"github.com/parta4ok/kvs/question/internal/cases"
+	"github.com/parta4ok/kvs/question/internal/entities"
+	"github.com/parta4ok/kvs/question/pkg/dto"
+)
+
+// CreateTopic adds new topic to the question bank
+//
+// @Summary      Create topic
+// @Description  Adds new topic to the question bank
+// @Accept       json
+// @Produce      json
+// @Security     ApiKeyAuth
+// @Param        Authorization header string true "Bearer {token}"
+// @Param        request body dto.TopicDTO true "Topic name and pass threshold"
+// @Success      201 {object} dto.TopicDTO "Successfully created topic"
+// @Failure      400 {object} dto.ErrorDTO "Invalid parameters"
+// @Failure      403 {object} dto.ErrorDTO "Not enough rights"
+// @Failure      409 {object} dto.ErrorDTO "Topic already exists"
+// @Failure      500 {object} dto.ErrorDTO "Internal server error"
+// @Router       /topics [post]
+func (s *Server) CreateTopic(resp http.ResponseWriter, req *http.Request) {
+	slog.Info("CreateTopic started")
+	resp.Header().Set("Content-Type", "application/json")
+
+	if err := s.checkUserRights(req.Context(), []string{right_manage_questions}); err != nil {
+		slog.Error(err.Error())
+		s.errProcessing(resp, err)
+		return
+	}
+
+	var topicDTO dto.TopicDTO
+	if err := json.NewDecoder(req.Body).Decode(&topicDTO); err != nil {
+		err := errors.Wrapf(entities.ErrInvalidParam, "decode req body to topicDTO failure: %v", err)
+		slog.Error(err.Error())
+		s.errProcessing(resp, err)
+		return
+	}
+
+	topic, err := s.questionBank.CreateTopic(req.Context(), topicDTO.Name,
+		s.toTopicOptions(topicDTO)...)
+	if err != nil {
+		err := errors.Wrap(err, "CreateTopic failure")
+		slog.Error(err.Error())
+		s.errProcessing(resp, err)
+		return
+	}
+
+	s.writeResponse(resp, http.StatusCreated, s.toTopicDTO(topic))
+	slog.Info("CreateTopic completed")
+}
+
+// UpdateTopic renames topic of the question bank and changes its pass threshold
+//
+// @Summary      Update topic
+// @Description  Renames existing topic of the question bank and sets its pass threshold. Sessions and attempt grants refer to topics by name, so a topic used by them can not be renamed
+// @Accept       json
+// @Produce      json
+// @Security     ApiKeyAuth
+// @Param        Authorization header string true "Bearer {token}"
+// @Param        topic_id path int true "Topic ID"
+// @Param        request body dto.TopicDTO true "New topic name and pass threshold"
+// @Success      200 {object} dto.TopicDTO "Successfully updated topic"
+// @Failure      400 {object} dto.ErrorDTO "Invalid parameters"
+// @Failure      403 {object} dto.ErrorDTO "Not enough rights"
+// @Failure      404 {object} dto.ErrorDTO "Topic not found"
+// @Failure      409 {object} dto.ErrorDTO "Topic already exists or the used topic is renamed"
+// @Failure      500 {object} dto.ErrorDTO "Internal server error"
+// @Router       /topics/{topic_id} [put]
+func (s *Server) UpdateTopic(resp http.ResponseWriter, req *http.Request) {
+	slog.Info("UpdateTopic started")
+	resp.Header().Set("Content-Type", "application/json")
+
+	if err := s.checkUserRights(req.Context(), []string{right_manage_questions}); err != nil {
+		slog.Error(err.Error())
+		s.errProcessing(resp, err)
+		return
+	}
+
+	var topicDTO dto.TopicDTO
+	if err := json.NewDecoder(req.Body).Decode(&topicDTO); err != nil {
+		err := errors.Wrapf(entities.ErrInvalidParam, "decode req body to topicDTO failure: %v", err)
+		slog.Error(err.Error())
+		s.errProcessing(resp, err)
+		return
+	}
+
+	topic, err := s.questionBank.UpdateTopic(req.Context(), chi.URLParam(req, "topic_id"),
+		topicDTO.Name, s.toTopicOptions(topicDTO)...)
+	if err != nil {
+		err := errors.Wrap(err, "UpdateTopic failure")
+		slog.Error(err.Error())
+		s.errProcessing(resp, err)
+		return
+	}
+
+	s.writeResponse(resp, http.StatusOK, s.toTopicDTO(topic))
+	slog.Info("UpdateTopic completed")
+}
+
+// DeleteTopic removes topic from the question bank
+//
+// @Summary      Delete topic
+// @Description  Removes topic from the list of topics. Completed sessions keep their history.
+// @Produce      json
+// @Security     ApiKeyAuth
+// @Param        Authorization header string true "Bearer {token}"
+// @Param        topic_id path int true "Topic ID"
+// @Success      204 "Successfully deleted topic"
+// @Failure      400 {object} dto.ErrorDTO "Invalid parameters"
+// @Failure      403 {object} dto.ErrorDTO "Not enough rights"
+// @Failure      404 {object} dto.ErrorDTO "Topic not found"
+// @Failure      500 {object} dto.ErrorDTO "Internal server error"
+// @Router       /topics/{topic_id} [delete]
+func (s *Server) DeleteTopic(resp http.ResponseWriter, req *http.Request) {
+	slog.Info("DeleteTopic started")
+	resp.Header().Set("Content-Type", "application/json")
+
+	if err := s.checkUserRights(req.Context(), []string{right_manage_questions}); err != nil {
+		slog.Error(err.Error())
+		s.errProcessing(resp, err)
+		return
+	}
+
+	if err := s.questionBank.DeleteTopic(req.Context(), chi.URLParam(req, "topic_id")); err != nil {
+		err := errors.Wrap(err, "DeleteTopic failure")
+		slog.Error(err.Error())
+		s.errProcessing(resp, err)
+		return
+	}
+
+	resp.WriteHeader(http.StatusNoContent)
+	slog.Info("DeleteTopic completed")
+}
+
+// GetTopicQuestions returns all questions of the topic with correct answers
+//
+// @Summary      Get topic questions
+// @Description  Returns all active questions of the topic including correct answers
+// @Produce      json
+// @Security     ApiKeyAuth
+// @Param        Authorization header string true "Bearer {token}"
+// @Param        topic_id path int true "Topic ID"
+// @Success      200 {object} dto.ManagedQuestionsListDTO "List of questions"
+// @Failure      400 {object} dto.ErrorDTO "Invalid parameters"
+// @Failure      403 {object} dto.ErrorDTO "Not enough rights"
+// @Failure      404 {object} dto.ErrorDTO "Topic not found"
+// @Failure      500 {object} dto.ErrorDTO "Internal server error"
+// @Router       /topics/{topic_id}/questions [get]
+func (s *Server) GetTopicQuestions(resp http.ResponseWriter, req *http.Request) {
+	slog.Info("GetTopicQuestions started")
+	resp.Header().Set("Content-Type", "application/json")
+
+	if err := s.checkUserRights(req.Context(), []string{right_manage_questions}); err != nil {
+		slog.Error(err.Error())
+		s.errProcessing(resp, err)
+		return
+	}
+
+	questions, err := s.questionBank.GetTopicQuestions(req.Context(),
+		chi.URLParam(req, "topic_id"))
+	if err != nil {
+		err := errors.Wrap(err, "GetTopicQuestions failure")
+		slog.Error(err.Error())
+		s.errProcessing(resp, err)
+		return
+	}
+
+	questionsDTO := dto.ManagedQuestionsListDTO{
+		Questions: make([]dto.ManagedQuestionDTO, 0, len(questions)),
+	}
+	for _, question := range questions {
+		questionsDTO.Questions = append(questionsDTO.Questions, s.toManagedQuestionDTO(question))
+	}
+
+	s.writeResponse(resp, http.StatusOK, questionsDTO)
+	slog.Info("GetTopicQuestions completed")
+}
+
+// CreateQuestion adds new question to the topic
+//
+// @Summary      Create question
+// @Description  Adds new question to the topic of the question bank
+// @Accept       json
+// @Produce      json
+// @Security     ApiKeyAuth
+// @Param        Authorization header string true "Bearer {token}"
+// @Param        topic_id path int true "Topic ID"
+// @Param        request body dto.QuestionContentDTO true "Question"
+// @Success      201 {object} dto.ManagedQuestionDTO "Successfully created question"
+// @Failure      400 {object} dto.ErrorDTO "Invalid parameters"
+// @Failure      403 {object} dto.ErrorDTO "Not enough rights"
+// @Failure      404 {object} dto.ErrorDTO "Topic not found"
+// @Failure      500 {object} dto.ErrorDTO "Internal server error"
+// @Router       /topics/{topic_id}/questions [post]
+func (s *Server) CreateQuestion(resp http.ResponseWriter, req *http.Request) {
+	slog.Info("CreateQuestion started")
+	resp.Header().Set("Content-Type", "application/json")
+
+	if err := s.checkUserRights(req.Context(), []string{right_manage_questions}); err != nil {
+		slog.Error(err.Error())
+		s.errProcessing(resp, err)
+		return
+	}
+
+	content, err := s.decodeQuestionContent(req)
+	if err != nil {
+		slog.Error(err.Error())
+		s.errProcessing(resp, err)
+		return
+	}
+
+	question, err := s.questionBank.CreateQuestion(req.Context(), chi.URLParam(req, "topic_id"),
+		content)
+	if err != nil {
+		err := errors.Wrap(err, "CreateQuestion failure")
+		slog.Error(err.Error())
+		s.errProcessing(resp, err)
+		return
+	}
+
+	s.writeResponse(resp, http.StatusCreated, s.toManagedQuestionDTO(question))
+	slog.Info("CreateQuestion completed")
+}
+
+// UpdateQuestion replaces content of the question
+//
+// @Summary      Update question
+// @Description  Saves new version of the question. Sessions keep the versions they were started
+// @Description  with, so completed sessions are not graded again until the question is rescored.
+// @Accept       json
+// @Produce      json
+// @Security     ApiKeyAuth
+// @Param        Authorization header string true "Bearer {token}"
+// @Param        question_id path int true "Question ID"
+// @Param        request body dto.QuestionContentDTO true "Question"
+// @Success      200 {object} dto.ManagedQuestionDTO "Successfully updated question"
+// @Failure      400 {object} dto.ErrorDTO "Invalid parameters"
+// @Failure      403 {object} dto.ErrorDTO "Not enough rights"
+// @Failure      404 {object} dto.ErrorDTO "Question not found or deleted"
+// @Failure      409 {object} dto.ErrorDTO "Question was changed concurrently"
+// @Failure      500 {object} dto.ErrorDTO "Internal server error"
+// @Router       /questions/{question_id} [put]
+func (s *Server) UpdateQuestion(resp http.ResponseWriter, req *http.Request) {
+	slog.Info("UpdateQuestion started")
+	resp.Header().Set("Content-Type", "application/json")
+
+	if err := s.checkUserRights(req.Context(), []string{right_manage_questions}); err != nil {
+		slog.Error(err.Error())
+		s.errProcessing(resp, err)
+		return
+	}
+
+	content, err := s.decodeQuestionContent(req)
+	if err != nil {
+		slog.Error(err.Error())
+		s.errProcessing(resp, err)
+		return
+	}
+
+	question, err := s.questionBank.UpdateQuestion(req.Context(),
+		chi.URLParam(req, "question_id"), content)
+	if err != nil {
+		err := errors.Wrap(err, "UpdateQuestion failure")
+		slog.Error(err.Error())
+		s.errProcessing(resp, err)
+		return
+	}
+
+	s.writeResponse(resp, http.StatusOK, s.toManagedQuestionDTO(question))
+	slog.Info("UpdateQuestion completed")
+}
+
+// DeleteQuestion removes question from the question bank
+//
+// @Summary      Delete question
+// @Description  Excludes question from new sessions. Completed sessions keep their history.
+// @Produce      json
+// @Security     ApiKeyAuth
+// @Param        Authorization header string true "Bearer {token}"
+// @Param        question_id path int true "Question ID"
+// @Success      204 "Successfully deleted question"
+// @Failure      400 {object} dto.ErrorDTO "Invalid parameters"
+// @Failure      403 {object} dto.ErrorDTO "Not enough rights"
+// @Failure      404 {object} dto.ErrorDTO "Question not found"
+// @Failure      500 {object} dto.ErrorDTO "Internal server error"
+// @Router       /questions/{question_id} [delete]
+func (s *Server) DeleteQuestion(resp http.ResponseWriter, req *http.Request) {
+	slog.Info("DeleteQuestion started")
+	resp.Header().Set("Content-Type", "application/json")
+
+	if err := s.checkUserRights(req.Context(), []string{right_manage_questions}); err != nil {
+		slog.Error(err.Error())
+		s.errProcessing(resp, err)
+		return
+	}
+
+	err := s.questionBank.DeleteQuestion(req.Context(), chi.URLParam(req, "question_id"))
+	if err != nil {
+		err := errors.Wrap(err, "DeleteQuestion failure")
+		slog.Error(err.Error())
+		s.errProcessing(resp, err)
+		return
+	}
+
+	resp.WriteHeader(http.StatusNoContent)
+	slog.Info("DeleteQuestion completed")
+}
+
+// RescoreQuestion queues grading of completed sessions again with the current version of the
+// question
+//
+// @Summary      Rescore question
+// @Description  Queues grading of completed sessions answered with older versions of the
+// @Description  question against its current version, e.g. after the correct answers were fixed.
+// @Description  Sessions are graded in the background, the response contains progress of the task
+// @Produce      json
+// @Security     ApiKeyAuth
+// @Param        Authorization header string true "Bearer {token}"
+// @Param        question_id path int true "Question ID"
+// @Success      202 {object} dto.RescoreTaskDTO "Rescoring queued"
+// @Failure      400 {object} dto.ErrorDTO "Invalid parameters"
+// @Failure      403 {object} dto.ErrorDTO "Not enough rights"
+// @Failure      404 {object} dto.ErrorDTO "Question not found"
+// @Failure      500 {object} dto.ErrorDTO "Internal server error"
+// @Router       /questions/{question_id}/rescore [post]
+func (s *Server) RescoreQuestion(resp http.ResponseWriter, req *http.Request) {
+	slog.Info("RescoreQuestion started")
+	resp.Header().Set("Content-Type", "application/json")
+
+	if err := s.checkUserRights(req.Context(), []string{right_manage_questions}); err != nil {
+		slog.Error(err.Error())
+		s.errProcessing(resp, err)
+		return
+	}
+
+	task, err := s.questionBank.RescoreQuestion(req.Context(), chi.URLParam(req, "question_id"))
+	if err != nil {
+		err := errors.Wrap(err, "RescoreQuestion failure")
+		slog.Error(err.Error())
+		s.errProcessing(resp, err)
+		return
+	}
+
+	s.writeResponse(resp, http.StatusAccepted, toRescoreTaskDTO(task))
+	slog.Info("RescoreQuestion completed")
+}
+
+// GetRescoreTask returns progress of the last rescoring of the question
+//
+// @Summary      Get rescore progress
+// @Description  Returns progress of the last rescoring of the question
+// @Produce      json
+// @Security     ApiKeyAuth
+// @Param        Authorization header string true "Bearer {token}"
+// @Param        question_id path int true "Question ID"
+// @Success      200 {object} dto.RescoreTaskDTO "Rescoring progress"
+// @Failure      400 {object} dto.ErrorDTO "Invalid parameters"
+// @Failure      403 {object} dto.ErrorDTO "Not enough rights"
+// @Failure      404 {object} dto.ErrorDTO "Question was never rescored"
+// @Failure      500 {object} dto.ErrorDTO "Internal server error"
+// @Router       /questions/{question_id}/rescore [get]
+func (s *Server) GetRescoreTask(resp http.ResponseWriter, req *http.Request) {
+	slog.Info("GetRescoreTask started")
+	resp.Header().Set("Content-Type", "application/json")
+
+	if err := s.checkUserRights(req.Context(), []string{right_manage_questions}); err != nil {
+		slog.Error(err.Error())
+		s.errProcessing(resp, err)
+		return
+	}
+
+	task, err := s.questionBank.GetRescoreTask(req.Context(), chi.URLParam(req, "question_id"))
+	if err != nil {
+		err := errors.Wrap(err, "GetRescoreTask failure")
+		slog.Error(err.Error())
+		s.errProcessing(resp, err)
+		return
+	}
+
+	s.writeResponse(resp, http.StatusOK, toRescoreTaskDTO(task))
+	slog.Info("GetRescoreTask completed")
+}
+
+func toRescoreTaskDTO(task *entities.RescoreTask) dto.RescoreTaskDTO {
+	return dto.RescoreTaskDTO{
+		QuestionID: task.QuestionID,
+		Version:    task.Version,
+		Status:     string(task.Status),
+		Rescored:   task.Rescored,
+		Failed:     task.Failed,
+		Attempts:   task.Attempts,
+		Error:      task.Error,
+		CreatedAt:  task.CreatedAt,
+		UpdatedAt:  task.UpdatedAt,
+	}
+}
+
+// toTopicOptions leaves threshold and pause limit unset when they are omitted, so the topic gets
+// the default ones.
+func (s *Server) toTopicOptions(topicDTO dto.TopicDTO) []entities.TopicOption {
+	opts := make([]entities.TopicOption, 0, 2)
+
+	if topicDTO.PassThreshold != nil {
+		opts = append(opts, entities.WithPassThreshold(*topicDTO.PassThreshold))
+	}
+
+	if topicDTO.MaxPauses != nil {
+		opts = append(opts, entities.WithMaxPauses(*topicDTO.MaxPauses))
+	}
+
+	if topicDTO.SelectionStrategy != "" {
+		opts = append(opts, entities.WithSelectionStrategy(
+			entities.SelectionStrategy(topicDTO.SelectionStrategy)))
+	}
+
+	return opts
+}
+
+func (s *Server) toTopicDTO(topic *entities.Topic) dto.TopicDTO {
+	threshold := topic.PassThreshold()
+	maxPauses := topic.MaxPauses()
+
+	return dto.TopicDTO{
+		ID:                topic.ID(),
+		Name:              topic.Name(),
+		PassThreshold:     &threshold,
+		MaxPauses:         &maxPauses,
+		SelectionStrategy: string(topic.SelectionStrategy()),
+	}
+}
+
+func (s *Server) decodeQuestionContent(req *http.Request) (cases.QuestionContent, error) {
+	var contentDTO dto.QuestionContentDTO
+	if err := json.NewDecoder(req.Body).Decode(&contentDTO); err != nil {
+		return cases.QuestionContent{}, errors.Wrapf(entities.ErrInvalidParam,
+			"decode req body to questionContentDTO failure: %v", err)
+	}
+
+	questionType, err := entities.ParseQuestionType(contentDTO.QuestionType)
+	if err != nil {
+		return cases.QuestionContent{}, errors.Wrap(err, "ParseQuestionType")
+	}
+
+	content := cases.QuestionContent{
+		Type:           questionType,
+		Subject:        contentDTO.Subject,
+		Variants:       contentDTO.Variants,
+		CorrectAnswers: contentDTO.CorrectAnswers,
+	}
+
+	if contentDTO.Options != nil {
+		content.Options = s.toQuestionOptions(*contentDTO.Options)
+	}
+
+	if contentDTO.Weight != 0 {
+		content.Options = append(content.Options, entities.WithWeight(contentDTO.Weight))
+	}
+
+	if contentDTO.Explanation != "" {
+		content.Options = append(content.Options, entities.WithExplanation(contentDTO.Explanation))
+	}
+
+	if contentDTO.Difficulty != "" {
+		content.Options = append(content.Options,
+			entities.WithDifficulty(entities.Difficulty(contentDTO.Difficulty)))
+	}
+
+	return content, nil
+}
+
+func (s *Server) toManagedQuestionDTO(question entities.Question) dto.ManagedQuestionDTO {
+	managedDTO := dto.ManagedQuestionDTO{
+		QuestionDTO:    s.toQuestionDTO(question),
+		CorrectAnswers: question.CorrectAnswers(),
+		Options:        s.toQuestionOptionsDTO(question),
+		Weight:         entities.DefaultQuestionWeight,
+		Difficulty:     string(entities.DifficultyOf(question)),
+		Version:        entities.VersionOf(question),
+	}
+
+	if weighted, ok := question.(entities.Weighted); ok {
+		managedDTO.Weight = weighted.Weight()
+	}
+
+	if explained, ok := question.(entities.Explained); ok {
+		managedDTO.Explanation = explained.Explanation()
+	}
+
+	return managedDTO
+}
+
+func (s *Server) toQuestionOptions(optionsDTO dto.QuestionOptionsDTO) []entities.QuestionOption {
+	opts := make([]entities.QuestionOption, 0)
+
+	if normalization := optionsDTO.Normalization; normalization != nil {
+		opts = append(opts, entities.WithAnswerNormalization(entities.AnswerNormalization{
+			FoldCase:       normalization.FoldCase,
+			TrimSpace:      normalization.TrimSpace,
+			FoldLookalikes: normalization.FoldLookalikes,
+			Pattern:        normalization.Pattern,
+		}))
+	}
+
+	if optionsDTO.PartialCredit {
+		opts = append(opts, entities.WithPartialCredit(true))
+	}
+
+	if tolerance := optionsDTO.Tolerance; tolerance != nil {
+		opts = append(opts, entities.WithTolerance(entities.Tolerance{
+			Absolute: tolerance.Absolute,
+			Relative: tolerance.Relative,
+		}))
+	}
+
+	return opts
+}
+
+// toQuestionOptionsDTO returns nil for question types without specific settings.
+func (s *Server) toQuestionOptionsDTO(question entities.Question) *dto.QuestionOptionsDTO {
+	switch q := question.(type) {
+	case *entities.ShortAnswerQuestion:
+		normalization := q.Normalization()
+		return &dto.QuestionOptionsDTO{
+			Normalization: &dto.AnswerNormalizationDTO{
+				FoldCase:       normalization.FoldCase,
+				TrimSpace:      normalization.TrimSpace,
+				FoldLookalikes: normalization.FoldLookalikes,
+				Pattern:        normalization.Pattern,
+			},
+		}
+	case *entities.OrderingQuestion:
+		return &dto.QuestionOptionsDTO{PartialCredit: q.PartialCredit()}
+	case *entities.NumericQuestion:
+		tolerance := q.Tolerance()
+		return &dto.QuestionOptionsDTO{
+			Tolerance: &dto.ToleranceDTO{
+				Absolute: tolerance.Absolute,
+				Relative: tolerance.Relative,
+			},
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,25 @@
+package public
+
+import (
+	"context"
+
+	"github.com/parta4ok/kvs/question/internal/cases"
+	"github.com/parta4ok/kvs/question/internal/entities"
+)
+
+//go:generate mockgen -source=question_bank_service.go -destination=./testdata/question_bank_service.go -package=testdata
+type QuestionBankService interface {
+	CreateTopic(ctx context.Context, name string, opts ...entities.TopicOption) (
+		*entities.Topic, error)
+	UpdateTopic(ctx context.Context, topicID string, name string,
+		opts ...entities.TopicOption) (*entities.Topic, error)
+	DeleteTopic(ctx context.Context, topicID string) error
+	GetTopicQuestions(ctx context.Context, topicID string) ([]entities.Question, error)
+	CreateQuestion(ctx context.Context, topicID string, content cases.QuestionContent) (
+		entities.Question, error)
+	UpdateQuestion(ctx context.Context, questionID string, content cases.QuestionContent) (
+		entities.Question, error)
+	DeleteQuestion(ctx context.Context, questionID string) error
+	RescoreQuestion(ctx context.Context, questionID string) (*entities.RescoreTask, error)
+	GetRescoreTask(ctx context.Context, questionID string) (*entities.RescoreTask, error)
+}
@@ -0,0 +1,206 @@
+package public
+
+import (
+	"encoding/csv"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/go-chi/chi"
+	"github.com/pkg/errors"
+
+	"github.com/parta4ok/kvs/question/internal/entities"
+	"github.com/parta4ok/kvs/question/pkg/dto"
+)
+
+const (
+	reportFormatJSON = "json"
+	reportFormatCSV  = "csv"
+)
+
+// GetKnowledgeReport returns knowledge report of the user
+//
+// @Summary      Get knowledge report
+// @Description  Aggregates completed sessions of the user: accuracy by topic and week, the most frequently failed questions, time to complete, number of expired sessions and direction of exam scores. format=csv returns the same report as a CSV file for mentors
+// @Accept       json
+// @Produce      json
+// @Produce      text/csv
+// @Security     ApiKeyAuth
+// @Param        Authorization header string true "Bearer {token}"
+// @Param        user_id path int true "User ID"
+// @Param        format query string false "Report format" Enums(json, csv)
+// @Success      200 {object} dto.KnowledgeReportDTO "Knowledge report"
+// @Failure      400 {object} dto.ErrorDTO "Invalid parameters"
+// @Failure      403 {object} dto.ErrorDTO "No access to sessions of the user"
+// @Failure      500 {object} dto.ErrorDTO "Internal server error"
+// @Router       /{user_id}/report [get]
+func (s *Server) GetKnowledgeReport(resp http.ResponseWriter, req *http.Request) {
+	slog.Info("GetKnowledgeReport started")
+
+	if err := s.checkUserRights(req.Context(), []string{right_view_completed_sessions}); err != nil {
+		slog.Error(err.Error())
+		s.errProcessing(resp, err)
+		return
+	}
+
+	userID := chi.URLParam(req, "user_id")
+	if userID == "" {
+		err := errors.Wrap(entities.ErrInvalidParam, "userID invalid")
+		slog.Error(err.Error())
+		s.errProcessing(resp, err)
+		return
+	}
+
+	format := req.URL.Query().Get("format")
+	if format == "" {
+		format = reportFormatJSON
+	}
+	if format != reportFormatJSON && format != reportFormatCSV {
+		err := errors.Wrapf(entities.ErrInvalidParam, "unknown report format: %s", format)
+		slog.Error(err.Error())
+		s.errProcessing(resp, err)
+		return
+	}
+
+	if err := s.checkOwnerAccess(req.Context(), userID); err != nil {
+		slog.Error(err.Error())
+		s.errProcessing(resp, err)
+		return
+	}
+
+	report, err := s.reports.GetKnowledgeReport(req.Context(), userID)
+	if err != nil {
+		err := errors.Wrap(err, "GetKnowledgeReport failure")
+		slog.Error(err.Error())
+		s.errProcessing(resp, err)
+		return
+	}
+
+	reportDTO := s.toKnowledgeReportDTO(report)
+
+	if format == reportFormatCSV {
+		s.writeReportCSV(resp, reportDTO)
+		slog.Info("GetKnowledgeReport completed")
+		return
+	}
+
+	resp.Header().Set("Content-Type", "application/json")
+	s.writeResponse(resp, http.StatusOK, reportDTO)
+	slog.Info("GetKnowledgeReport completed")
+}
+
+func (s *Server) toKnowledgeReportDTO(report *entities.KnowledgeReport) dto.KnowledgeReportDTO {
+	topics := make([]dto.TopicAccuracyDTO, 0, len(report.Topics))
+	for _, topic := range report.Topics {
+		periods := make([]dto.AccuracyPeriodDTO, 0, len(topic.Periods))
+		for _, period := range topic.Periods {
+			periods = append(periods, dto.AccuracyPeriodDTO{
+				PeriodStart: period.Start,
+				Correct:     period.Correct,
+				Total:       period.Total,
+				Accuracy:    period.Accuracy(),
+			})
+		}
+
+		topics = append(topics, dto.TopicAccuracyDTO{
+			Topic:    topic.Topic,
+			Correct:  topic.Correct,
+			Total:    topic.Total,
+			Accuracy: topic.Accuracy(),
+			Periods:  periods,
+		})
+	}
+
+	failed := make([]dto.FailedQuestionDTO, 0, len(report.FailedQuestions))
+	for _, question := range report.FailedQuestions {
+		failed = append(failed, dto.FailedQuestionDTO{
+			QuestionID: question.QuestionID,
+			Topic:      question.Topic,
+			Subject:    question.Subject,
+			Failures:   question.Failures,
+			Answers:    question.Answers,
+		})
+	}
+
+	return dto.KnowledgeReportDTO{
+		UserID:      report.UserID,
+		GeneratedAt: report.GeneratedAt,
+		Sessions: dto.SessionStatsDTO{
+			Total:                  report.Sessions.Total,
+			Exams:                  report.Sessions.Exams,
+			Passed:                 report.Sessions.Passed,
+			Failed:                 report.Sessions.Failed,
+			Expired:                report.Sessions.Expired,
+			AverageDurationSeconds: report.Sessions.AverageDuration.Seconds(),
+			MedianDurationSeconds:  report.Sessions.MedianDuration.Seconds(),
+			MinDurationSeconds:     report.Sessions.MinDuration.Seconds(),
+			MaxDurationSeconds:     report.Sessions.MaxDuration.Seconds(),
+		},
+		Topics:          topics,
+		FailedQuestions: failed,
+		Trend: dto.ScoreTrendDTO{
+			Direction: string(report.Trend.Direction()),
+			Sessions:  report.Trend.Sessions,
+			Slope:     report.Trend.Slope,
+		},
+	}
+}
+
+// writeReportCSV writes the report as three tables separated by empty lines: summary metrics,
+// accuracy by topic and week, failed questions.
+func (s *Server) writeReportCSV(resp http.ResponseWriter, report dto.KnowledgeReportDTO) {
+	resp.Header().Set("Content-Type", "text/csv; charset=utf-8")
+	resp.Header().Set("Content-Disposition",
+		fmt.Sprintf("attachment; filename=\"report_%s.csv\"", report.UserID))
+	resp.WriteHeader(http.StatusOK)
+
+	stats := report.Sessions
+	records := [][]string{
+		{"metric", "value"},
+		{"user_id", report.UserID},
+		{"generated_at", report.GeneratedAt.Format(time.RFC3339)},
+		{"sessions_total", strconv.Itoa(stats.Total)},
+		{"sessions_exams", strconv.Itoa(stats.Exams)},
+		{"sessions_passed", strconv.Itoa(stats.Passed)},
+		{"sessions_failed", strconv.Itoa(stats.Failed)},
+		{"sessions_expired", strconv.Itoa(stats.Expired)},
+		{"average_duration_seconds", formatFloat(stats.AverageDurationSeconds)},
+		{"median_duration_seconds", formatFloat(stats.MedianDurationSeconds)},
+		{"min_duration_seconds", formatFloat(stats.MinDurationSeconds)},
+		{"max_duration_seconds", formatFloat(stats.MaxDurationSeconds)},
+		{"trend_direction", report.Trend.Direction},
+		{"trend_sessions", strconv.Itoa(report.Trend.Sessions)},
+		{"trend_slope", formatFloat(report.Trend.Slope)},
+		nil,
+		{"topic", "period_start", "correct", "total", "accuracy"},
+	}
+
+	for _, topic := range report.Topics {
+		for _, period := range topic.Periods {
+			records = append(records, []string{topic.Topic,
+				period.PeriodStart.Format(time.DateOnly), strconv.Itoa(period.Correct),
+				strconv.Itoa(period.Total), formatFloat(period.Accuracy)})
+		}
+		records = append(records, []string{topic.Topic, "total", strconv.Itoa(topic.Correct),
+			strconv.Itoa(topic.Total), formatFloat(topic.Accuracy)})
+	}
+
+	records = append(records, nil,
+		[]string{"question_id", "topic", "subject", "failures", "answers"})
+	for _, question := range report.FailedQuestions {
+		records = append(records, []string{question.QuestionID, question.Topic,
+			question.Subject, strconv.Itoa(question.Failures), strconv.Itoa(question.Answers)})
+	}
+
+	// the status is already sent, so a write failure can only be logged
+	if err := csv.NewWriter(resp).WriteAll(records); err != nil {
+		err := errors.Wrapf(entities.ErrInternal, "write csv report failure: %v", err)
+		slog.Error(err.Error())
+	}
+}
+
+func formatFloat(value float64) string {
+	return strconv.FormatFloat(value, 'f', 2, 64)
+}
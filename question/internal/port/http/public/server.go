@@ -7,6 +7,7 @@ import (
 	"net/http"
 	"os"
 	"os/signal"
+	"strconv"
 	"strings"
 	"syscall"
 	"time"
@@ -24,17 +25,40 @@ const (
 	startSessionPath         = "/start_session"
 	completeSessionPath      = "/complete_session"
 	allCompletedSessionsPath = "/completed_sessions"
+	questionsPath            = "/questions"
+	answersPath              = "/answers"
+	resumeSessionPath        = "/resume"
+	pauseSessionPath         = "/pause"
+	checkAnswerPath          = "/check"
+	reviewSessionPath        = "/review"
+	attemptGrantsPath        = "/attempts/grants"
+	timezonePath             = "/timezone"
+	unpauseSessionPath       = "/unpause"
+	startReviewSessionPath   = "/start_review_session"
+	dueReviewsPath           = "/due_reviews"
+	mentorsPath              = "/mentors"
+	studentsPath             = "/students"
+	reportPath               = "/report"
+	itemAnalysisPath         = "/item_analysis"
+	rescorePath              = "/rescore"
 
 	right_view_topic_list         = "view_topic_list"
 	right_start_session           = "start_session"
 	right_complete_session        = "complete_session"
 	right_view_completed_sessions = "view_completed_sessions"
+	right_manage_questions        = "manage_questions"
+	right_manage_attempts         = "manage_attempts"
 )
 
 type Server struct {
 	router       *chi.Mux
 	server       *http.Server
 	service      Service
+	questionBank QuestionBankService
+	attempts     AttemptService
+	reports      ReportService
+	itemAnalysis ItemAnalysisService
+	mentors      MentorService
 	introspector Introspector
 	accessor     Accessor
 	cfg          *ServerCfg
@@ -53,6 +77,36 @@ func WithService(srv Service) ServerOption {
 	}
 }
 
+func WithQuestionBankService(questionBank QuestionBankService) ServerOption {
+	return func(s *Server) {
+		s.questionBank = questionBank
+	}
+}
+
+func WithAttemptService(attempts AttemptService) ServerOption {
+	return func(s *Server) {
+		s.attempts = attempts
+	}
+}
+
+func WithReportService(reports ReportService) ServerOption {
+	return func(s *Server) {
+		s.reports = reports
+	}
+}
+
+func WithItemAnalysisService(itemAnalysis ItemAnalysisService) ServerOption {
+	return func(s *Server) {
+		s.itemAnalysis = itemAnalysis
+	}
+}
+
+func WithMentorService(mentors MentorService) ServerOption {
+	return func(s *Server) {
+		s.mentors = mentors
+	}
+}
+
 func WithConfig(cfg *ServerCfg) ServerOption {
 	return func(s *Server) {
 		s.cfg = cfg
@@ -92,6 +146,36 @@ func New(opts ...ServerOption) (*Server, error) {
 		return nil, err
 	}
 
+	if serv.questionBank == nil {
+		err := errors.Wrap(entities.ErrInternal, "question bank service not set")
+		slog.Error(err.Error())
+		return nil, err
+	}
+
+	if serv.attempts == nil {
+		err := errors.Wrap(entities.ErrInternal, "attempt service not set")
+		slog.Error(err.Error())
+		return nil, err
+	}
+
+	if serv.reports == nil {
+		err := errors.Wrap(entities.ErrInternal, "report service not set")
+		slog.Error(err.Error())
+		return nil, err
+	}
+
+	if serv.itemAnalysis == nil {
+		err := errors.Wrap(entities.ErrInternal, "item analysis service not set")
+		slog.Error(err.Error())
+		return nil, err
+	}
+
+	if serv.mentors == nil {
+		err := errors.Wrap(entities.ErrInternal, "mentor service not set")
+		slog.Error(err.Error())
+		return nil, err
+	}
+
 	if serv.introspector == nil {
 		err := errors.Wrap(entities.ErrInternal, "introspector not set")
 		slog.Error(err.Error())
@@ -163,12 +247,39 @@ func (s *Server) registerRoutes() {
 		s.introspectMiddleware,
 	)
 
-	s.router.Get(basePath+topicsPath, s.GetTopics)
-	s.router.Get(basePath+"/{user_id}"+allCompletedSessionsPath, s.GetAllCompletedUserSessions)
-
 	s.router.Route(basePath, func(r chi.Router) {
+		r.Get(topicsPath, s.GetTopics)
+		r.Get("/{user_id}"+allCompletedSessionsPath, s.GetCompletedUserSessions)
+		r.Get("/{user_id}/{session_id}", s.GetSession)
 		r.Post("/{user_id}"+startSessionPath, s.StartSession)
 		r.Post("/{user_id}/{session_id}"+completeSessionPath, s.CompleteSession)
+		r.Put("/{user_id}/{session_id}"+answersPath+"/{question_id}", s.SaveDraftAnswer)
+		r.Get("/{user_id}/{session_id}"+resumeSessionPath, s.ResumeSession)
+		r.Post("/{user_id}/{session_id}"+pauseSessionPath, s.PauseSession)
+		r.Post("/{user_id}/{session_id}"+unpauseSessionPath, s.UnpauseSession)
+		r.Post("/{user_id}/{session_id}"+checkAnswerPath+"/{question_id}", s.CheckAnswer)
+		r.Get("/{user_id}/{session_id}"+reviewSessionPath, s.GetSessionReview)
+		r.Post("/{user_id}"+attemptGrantsPath, s.GrantAttempts)
+		r.Put("/{user_id}"+timezonePath, s.SetUserTimezone)
+		r.Post("/{user_id}"+startReviewSessionPath, s.StartReviewSession)
+		r.Get("/{user_id}"+dueReviewsPath, s.GetDueReviews)
+		r.Get("/{user_id}"+reportPath, s.GetKnowledgeReport)
+
+		r.Post(topicsPath, s.CreateTopic)
+		r.Put(topicsPath+"/{topic_id}", s.UpdateTopic)
+		r.Delete(topicsPath+"/{topic_id}", s.DeleteTopic)
+		r.Get(topicsPath+"/{topic_id}"+questionsPath, s.GetTopicQuestions)
+		r.Post(topicsPath+"/{topic_id}"+questionsPath, s.CreateQuestion)
+		r.Get(topicsPath+"/{topic_id}"+itemAnalysisPath, s.GetItemStatistics)
+		r.Post(topicsPath+"/{topic_id}"+itemAnalysisPath, s.AnalyzeTopic)
+		r.Put(questionsPath+"/{question_id}", s.UpdateQuestion)
+		r.Delete(questionsPath+"/{question_id}", s.DeleteQuestion)
+		r.Post(questionsPath+"/{question_id}"+rescorePath, s.RescoreQuestion)
+		r.Get(questionsPath+"/{question_id}"+rescorePath, s.GetRescoreTask)
+
+		r.Get(mentorsPath+"/{mentor_id}"+studentsPath, s.GetMentorStudents)
+		r.Put(mentorsPath+"/{mentor_id}"+studentsPath+"/{student_id}", s.LinkStudent)
+		r.Delete(mentorsPath+"/{mentor_id}"+studentsPath+"/{student_id}", s.UnlinkStudent)
 	})
 }
 
@@ -230,9 +341,10 @@ func (s *Server) GetTopics(resp http.ResponseWriter, req *http.Request) {
 // @Security     ApiKeyAuth
 // @Param        Authorization header string true "Bearer {token}"
 // @Param        user_id path int true "User ID"
-// @Param        request body dto.TopicsDTO true "Selected topics"
+// @Param        request body dto.StartSessionDTO true "Selected topics and session mode"
 // @Success      201 {object} dto.SessionDTO "Successfully created session"
 // @Failure      400 {object} dto.ErrorDTO "Invalid parameters"
+// @Failure      403 {object} dto.ErrorDTO "No access to sessions of the user or no attempts left"
 // @Failure      404 {object} dto.ErrorDTO "Topics not found"
 // @Failure      500 {object} dto.ErrorDTO "Internal server error"
 // @Router       /{user_id}/start_session [post]
@@ -258,16 +370,30 @@ func (s *Server) StartSession(resp http.ResponseWriter, req *http.Request) {
 		return
 	}
 
-	var topicsDTO dto.TopicsDTO
-	if err := json.NewDecoder(req.Body).Decode(&topicsDTO); err != nil {
-		err := errors.Wrapf(entities.ErrInvalidParam, "decode req body to topicsDTO failure: %v", err)
+	if err := s.checkOwner(req.Context(), userID); err != nil {
+		slog.Error(err.Error())
+		s.errProcessing(resp, err)
+		return
+	}
+
+	var startDTO dto.StartSessionDTO
+	if err := json.NewDecoder(req.Body).Decode(&startDTO); err != nil {
+		err := errors.Wrapf(entities.ErrInvalidParam, "decode req body to startSessionDTO failure: %v",
+			err)
+		slog.Error(err.Error())
+		s.errProcessing(resp, err)
+		return
+	}
+
+	mode, err := entities.NewSessionMode(startDTO.Mode)
+	if err != nil {
 		slog.Error(err.Error())
 		s.errProcessing(resp, err)
 		return
 	}
 
 	sessionID, questions, err := s.service.CreateSession(req.Context(), userID,
-		topicsDTO.Topics)
+		startDTO.Topics, mode)
 	if err != nil {
 		err := errors.Wrap(err, "CreateSession failure")
 		slog.Error(err.Error())
@@ -277,18 +403,13 @@ func (s *Server) StartSession(resp http.ResponseWriter, req *http.Request) {
 
 	questionsDTO := make([]dto.QuestionDTO, 0, len(questions))
 	for _, question := range questions {
-		questionsDTO = append(questionsDTO, dto.QuestionDTO{
-			ID:           question.ID(),
-			QuestionType: question.Type().String(),
-			Topic:        question.Topic(),
-			Subject:      question.Subject(),
-			Variants:     question.Variants(),
-		})
+		questionsDTO = append(questionsDTO, s.toQuestionDTO(question))
 	}
 
 	sessionDTO := dto.SessionDTO{
 		SessionID: sessionID,
-		Topics:    topicsDTO.Topics,
+		Mode:      string(mode),
+		Topics:    startDTO.Topics,
 		Questions: questionsDTO,
 	}
 
@@ -322,6 +443,7 @@ func (s *Server) StartSession(resp http.ResponseWriter, req *http.Request) {
 // @Param        request body dto.UserAnswersListDTO true "User answers"
 // @Success      200 {object} dto.SessionResultDTO "Successfully completed session"
 // @Failure      400 {object} dto.ErrorDTO "Invalid parameters"
+// @Failure      403 {object} dto.ErrorDTO "No access to sessions of the user"
 // @Failure      404 {object} dto.ErrorDTO "Session not found"
 // @Failure      500 {object} dto.ErrorDTO "Internal server error"
 // @Router       /{user_id}/{session_id}/complete_session [post]
@@ -338,10 +460,17 @@ func (s *Server) CompleteSession(resp http.ResponseWriter, req *http.Request) {
 
 	resp.Header().Set("Content-Type", "application/json")
 
+	userID := chi.URLParam(req, "user_id")
 	sessionID := chi.URLParam(req, "session_id")
 
-	if sessionID == "" {
-		err := errors.Wrap(entities.ErrInvalidParam, "sessionID invalid")
+	if userID == "" || sessionID == "" {
+		err := errors.Wrap(entities.ErrInvalidParam, "userID or sessionID invalid")
+		slog.Error(err.Error())
+		s.errProcessing(resp, err)
+		return
+	}
+
+	if _, err := s.checkSessionOwner(req.Context(), userID, sessionID); err != nil {
 		slog.Error(err.Error())
 		s.errProcessing(resp, err)
 		return
@@ -358,7 +487,7 @@ func (s *Server) CompleteSession(resp http.ResponseWriter, req *http.Request) {
 
 	userAnswers := make([]*entities.UserAnswer, 0, len(userAnswersListDTO.AnswersList))
 	for _, answerDTO := range userAnswersListDTO.AnswersList {
-		userAnswer, err := entities.NewUserAnswer(answerDTO.QuestionID, answerDTO.Answers)
+		userAnswer, err := s.toUserAnswer(answerDTO)
 		if err != nil {
 			err := errors.Wrapf(entities.ErrInvalidParam, "create user answer failure: %v", err)
 			slog.Error(err.Error())
@@ -376,10 +505,7 @@ func (s *Server) CompleteSession(resp http.ResponseWriter, req *http.Request) {
 		return
 	}
 
-	resultDTO := dto.SessionResultDTO{
-		IsSuccess: sessionResult.IsSuccess,
-		Grade:     sessionResult.Grade,
-	}
+	resultDTO := s.toSessionResultDTO(sessionResult)
 
 	data, err := json.Marshal(resultDTO)
 	if err != nil {
@@ -400,22 +526,30 @@ func (s *Server) CompleteSession(resp http.ResponseWriter, req *http.Request) {
 	slog.Info("CompleteSession completed successfully")
 }
 
-// GetAllCompletedUserSessions returns detailed information about all completed sessions for a user.
+// GetCompletedUserSessions returns the page of results of the completed sessions of a user.
 //
-// @Summary      Get all completed sessions for a user
-// @Description  Returns detailed list of all completed sessions for the user with the specified id.
+// @Summary      Get completed sessions of a user
+// @Description  Returns the page of results of the completed sessions of the user with the specified id, filtered and sorted by the query, with totals of all sessions matching the filters. Pages follow each other by the cursor. Results are stored when the session is completed and do not change when questions or thresholds change later, only rescoring of the session rewrites them
 // @Accept       json
 // @Produce      json
 // @Security     ApiKeyAuth
 // @Param        Authorization header string true "Bearer {token}"
 // @Param        user_id path string true "User ID"
-// @Success      200 {object} dto.CompletedSessionsResponseListDTO "List of completed sessions"
-// @Failure      400 {object} dto.ErrorDTO "Invalid user_id"
-// @Failure      404 {object} dto.ErrorDTO "No completed sessions found"
+// @Param        topic query string false "Only sessions on the topic"
+// @Param        from query string false "Only sessions completed at or after the time, RFC 3339"
+// @Param        to query string false "Only sessions completed before the time, RFC 3339"
+// @Param        outcome query string false "Comma separated outcomes: passed, failed, expired"
+// @Param        sort query string false "Sort by date or score, date by default" Enums(date, score)
+// @Param        order query string false "Sort order, desc by default" Enums(desc, asc)
+// @Param        limit query int false "Page size, 20 by default, 100 at most"
+// @Param        cursor query string false "next_cursor of the previous page"
+// @Success      200 {object} dto.CompletedSessionsResponseListDTO "Page of completed sessions"
+// @Failure      400 {object} dto.ErrorDTO "Invalid user_id or query parameters"
+// @Failure      403 {object} dto.ErrorDTO "No access to sessions of the user"
 // @Failure      500 {object} dto.ErrorDTO "Internal server error"
 // @Router       /{user_id}/completed_sessions [get]
-func (s *Server) GetAllCompletedUserSessions(resp http.ResponseWriter, req *http.Request) {
-	slog.Info("GetAllCompletedUserSessions started")
+func (s *Server) GetCompletedUserSessions(resp http.ResponseWriter, req *http.Request) {
+	slog.Info("GetCompletedUserSessions started")
 
 	if err := s.checkUserRights(req.Context(), []string{right_view_completed_sessions}); err != nil {
 		slog.Error(err.Error())
@@ -434,27 +568,43 @@ func (s *Server) GetAllCompletedUserSessions(resp http.ResponseWriter, req *http
 		return
 	}
 
-	sessions, err := s.service.GetAllCompletedUserSessions(req.Context(), userID)
+	if err := s.checkOwnerAccess(req.Context(), userID); err != nil {
+		slog.Error(err.Error())
+		s.errProcessing(resp, err)
+		return
+	}
+
+	query, err := s.parseHistoryQuery(req)
 	if err != nil {
-		err := errors.Wrap(err, "GetAllCompletedUserSessions failure")
 		slog.Error(err.Error())
 		s.errProcessing(resp, err)
 		return
 	}
 
-	sessionsListDTO := dto.CompletedSessionsResponseListDTO{
-		CompletedSessions: make([]dto.CompletedSessionResponseDTO, 0, len(sessions)),
+	page, err := s.service.GetCompletedUserSessions(req.Context(), userID, query)
+	if err != nil {
+		err := errors.Wrap(err, "GetCompletedUserSessions failure")
+		slog.Error(err.Error())
+		s.errProcessing(resp, err)
+		return
 	}
-	for _, session := range sessions {
-		sessionInfo, err := s.extractDataFromCompleteSession(*session)
-		if err != nil {
-			err = errors.Wrap(err, "extractDataFromCompleteSession failure")
-			slog.Error(err.Error())
-			s.errProcessing(resp, err)
-			return
-		}
 
-		sessionsListDTO.CompletedSessions = append(sessionsListDTO.CompletedSessions, sessionInfo)
+	sessionsListDTO := dto.CompletedSessionsResponseListDTO{
+		CompletedSessions: make([]dto.CompletedSessionResponseDTO, 0, len(page.Sessions)),
+		Totals: dto.HistoryTotalsDTO{
+			Total:   page.Totals.Total,
+			Passed:  page.Totals.Passed,
+			Failed:  page.Totals.Failed,
+			Expired: page.Totals.Expired,
+		},
+		Limit: query.Limit,
+	}
+	for _, snapshot := range page.Sessions {
+		sessionsListDTO.CompletedSessions = append(sessionsListDTO.CompletedSessions,
+			s.toCompletedSessionDTO(snapshot))
+	}
+	if page.Next != nil {
+		sessionsListDTO.NextCursor = page.Next.String()
 	}
 
 	data, err := json.Marshal(sessionsListDTO)
@@ -473,7 +623,65 @@ func (s *Server) GetAllCompletedUserSessions(resp http.ResponseWriter, req *http
 		return
 	}
 
-	slog.Info("CompleteSession completed successfully")
+	slog.Info("GetCompletedUserSessions completed successfully")
+}
+
+// parseHistoryQuery reads filters, sort, limit and cursor of the history from query
+// parameters, omitted parameters are zero.
+func (s *Server) parseHistoryQuery(req *http.Request) (entities.HistoryQuery, error) {
+	params := req.URL.Query()
+	filter := entities.HistoryFilter{Topic: params.Get("topic")}
+
+	var err error
+	if filter.From, err = s.parseTimeParam(params.Get("from"), "from"); err != nil {
+		return entities.HistoryQuery{}, err
+	}
+
+	if filter.To, err = s.parseTimeParam(params.Get("to"), "to"); err != nil {
+		return entities.HistoryQuery{}, err
+	}
+
+	if raw := params.Get("outcome"); raw != "" {
+		for _, name := range strings.Split(raw, ",") {
+			outcome, err := entities.NewOutcome(strings.TrimSpace(name))
+			if err != nil {
+				return entities.HistoryQuery{}, err
+			}
+			filter.Outcomes = append(filter.Outcomes, outcome)
+		}
+	}
+
+	var limit int
+	if raw := params.Get("limit"); raw != "" {
+		if limit, err = strconv.Atoi(raw); err != nil {
+			return entities.HistoryQuery{}, errors.Wrapf(entities.ErrInvalidParam,
+				"invalid limit: %s", raw)
+		}
+	}
+
+	var after *entities.HistoryCursor
+	if raw := params.Get("cursor"); raw != "" {
+		if after, err = entities.ParseHistoryCursor(raw); err != nil {
+			return entities.HistoryQuery{}, err
+		}
+	}
+
+	return entities.NewHistoryQuery(filter, entities.HistorySort(params.Get("sort")),
+		entities.SortOrder(params.Get("order")), limit, after)
+}
+
+// parseTimeParam parses time in RFC 3339, empty value is zero time.
+func (s *Server) parseTimeParam(raw string, name string) (time.Time, error) {
+	if raw == "" {
+		return time.Time{}, nil
+	}
+
+	value, err := time.Parse(time.RFC3339, raw)
+	if err != nil {
+		return time.Time{}, errors.Wrapf(entities.ErrInvalidParam, "invalid %s: %s", name, raw)
+	}
+
+	return value, nil
 }
 
 func (s *Server) errProcessing(resp http.ResponseWriter, err error) {
@@ -490,6 +698,8 @@ func (s *Server) errProcessing(resp http.ResponseWriter, err error) {
 		errDTO.StatusCode = http.StatusForbidden
 	case errors.Is(err, entities.ErrNotFound):
 		errDTO.StatusCode = http.StatusNotFound
+	case errors.Is(err, entities.ErrConflict) || errors.Is(err, entities.ErrInvalidState):
+		errDTO.StatusCode = http.StatusConflict
 	}
 
 	errDtoData, err := json.Marshal(&errDTO)
@@ -504,6 +714,22 @@ func (s *Server) errProcessing(resp http.ResponseWriter, err error) {
 	resp.Write(errDtoData) //nolint:errcheck,gosec //ok
 }
 
+func (s *Server) writeResponse(resp http.ResponseWriter, statusCode int, payload any) {
+	data, err := json.Marshal(payload)
+	if err != nil {
+		err := errors.Wrapf(entities.ErrInternal, "marshal failure: %v", err)
+		slog.Error(err.Error())
+		s.errProcessing(resp, err)
+		return
+	}
+
+	resp.WriteHeader(statusCode)
+	if _, err = resp.Write(data); err != nil {
+		err := errors.Wrapf(entities.ErrInternal, "write data to response failure: %v", err)
+		slog.Error(err.Error())
+	}
+}
+
 func (s *Server) timeoutMiddleware(next http.Handler) http.Handler {
 	return http.HandlerFunc(func(resp http.ResponseWriter, req *http.Request) {
 		ctx, cancel := context.WithTimeout(req.Context(), s.cfg.Timeout)
@@ -568,64 +794,139 @@ func (s *Server) checkUserRights(ctx context.Context, requiredRights []string) e
 	return nil
 }
 
-func (s *Server) extractDataFromCompleteSession(session entities.Session) (
-	dto.CompletedSessionResponseDTO, error) {
-	var completeSessionDTO dto.CompletedSessionResponseDTO
-
-	startedAt, err := session.GetStartedAt()
-	if err != nil {
-		return completeSessionDTO, err
-	}
+func (s *Server) toCompletedSessionDTO(
+	snapshot *entities.ResultSnapshot) dto.CompletedSessionResponseDTO {
+	answersList := dto.UserAnswersListDTO{
+		AnswersList: make([]dto.UserAnswerDTO, 0, len(snapshot.Items)),
+	}
+	results := make([]dto.ResultItemDTO, 0, len(snapshot.Items))
+
+	for _, item := range snapshot.Items {
+		results = append(results, dto.ResultItemDTO{
+			QuestionID:   item.QuestionID,
+			Version:      item.Version,
+			QuestionType: item.Type.String(),
+			Topic:        item.Topic,
+			Subject:      item.Subject,
+			Weight:       item.Weight,
+			Answered:     item.Answered,
+			Selections:   item.Selections,
+			IsCorrect:    item.IsCorrect,
+			Credit:       item.Credit,
+		})
 
-	topics := session.GetTopics()
+		if !item.Answered {
+			continue
+		}
 
-	answers, err := session.GetUserAnswers()
-	if err != nil {
-		return completeSessionDTO, err
+		answerDTO := dto.UserAnswerDTO{
+			QuestionID:      item.QuestionID,
+			QuestionSubject: item.Subject,
+			Answers:         item.Selections,
+		}
+		if item.Type == entities.Matching {
+			answerDTO = s.splitPairs(answerDTO)
+		}
+		answersList.AnswersList = append(answersList.AnswersList, answerDTO)
 	}
 
-	answersList := dto.UserAnswersListDTO{
-		AnswersList: make([]dto.UserAnswerDTO, 0, len(answers)),
+	return dto.CompletedSessionResponseDTO{
+		SessionID:     snapshot.SessionID,
+		StartedAt:     snapshot.StartedAt,
+		CompletedAt:   snapshot.CompletedAt,
+		Mode:          string(snapshot.Mode),
+		Topics:        snapshot.Topics,
+		UserAnswers:   answersList,
+		Results:       results,
+		IsExpired:     snapshot.IsExpired,
+		SessionResult: s.toSessionResultDTO(snapshot.Result),
 	}
+}
 
-	questions, err := session.GetQuestions()
-	if err != nil {
-		return completeSessionDTO, err
+func (s *Server) toQuestionDTO(question entities.Question) dto.QuestionDTO {
+	questionDTO := dto.QuestionDTO{
+		ID:           question.ID(),
+		QuestionType: question.Type().String(),
+		Topic:        question.Topic(),
+		Subject:      question.Subject(),
+		Variants:     question.Variants(),
+		FreeInput:    question.Type().IsFreeInput(),
+		Ordered:      question.Type().IsOrdered(),
+	}
+
+	if mq, ok := question.(*entities.MatchingQuestion); ok {
+		questionDTO.Matching = &dto.MatchingDTO{
+			LeftItems:  mq.LeftItems(),
+			RightItems: mq.RightItems(),
+		}
 	}
 
-	questionsMap := make(map[string]entities.Question, len(questions))
-	for _, question := range questions {
-		questionsMap[question.ID()] = question
-	}
+	return questionDTO
+}
 
-	for _, answer := range answers {
-		answersList.AnswersList = append(answersList.AnswersList, dto.UserAnswerDTO{
-			QuestionID:      answer.GetQuestionID(),
-			QuestionSubject: questionsMap[answer.GetQuestionID()].Subject(),
-			Answers:         answer.GetSelections(),
-		})
+// toUserAnswer keeps selected pairs of matching question as selections encoded with
+// entities.PairSeparator, so every question type is answered with a flat list of strings.
+func (s *Server) toUserAnswer(answerDTO dto.UserAnswerDTO) (*entities.UserAnswer, error) {
+	selections := answerDTO.Answers
+	if len(answerDTO.Pairs) != 0 {
+		selections = make([]string, 0, len(answerDTO.Answers)+len(answerDTO.Pairs))
+		selections = append(selections, answerDTO.Answers...)
+		for _, pairDTO := range answerDTO.Pairs {
+			pair, err := entities.NewPair(pairDTO.Left, pairDTO.Right)
+			if err != nil {
+				return nil, err
+			}
+			selections = append(selections, pair.String())
+		}
 	}
 
-	isExpired, err := session.IsExpired()
-	if err != nil {
-		return completeSessionDTO, err
+	return entities.NewUserAnswer(answerDTO.QuestionID, selections)
+}
+
+func (s *Server) toUserAnswerDTO(question entities.Question,
+	answer *entities.UserAnswer) dto.UserAnswerDTO {
+	answerDTO := dto.UserAnswerDTO{
+		QuestionID: answer.GetQuestionID(),
+		Answers:    answer.GetSelections(),
+	}
+	if question == nil {
+		return answerDTO
 	}
+	answerDTO.QuestionSubject = question.Subject()
 
-	result, err := session.GetSessionResult()
-	if err != nil {
-		return completeSessionDTO, err
+	if question.Type() != entities.Matching {
+		return answerDTO
 	}
 
-	resultDTO := dto.SessionResultDTO{
-		IsSuccess: result.IsSuccess,
-		Grade:     result.Grade,
+	return s.splitPairs(answerDTO)
+}
+
+// splitPairs moves selections of the matching question encoded with entities.PairSeparator to
+// pairs.
+func (s *Server) splitPairs(answerDTO dto.UserAnswerDTO) dto.UserAnswerDTO {
+	selections := answerDTO.Answers
+	answerDTO.Answers = make([]string, 0)
+	for _, selection := range selections {
+		pair, err := entities.ParsePair(selection)
+		if err != nil {
+			answerDTO.Answers = append(answerDTO.Answers, selection)
+			continue
+		}
+		answerDTO.Pairs = append(answerDTO.Pairs, dto.PairDTO{Left: pair.Left, Right: pair.Right})
 	}
 
-	completeSessionDTO.StartedAt = startedAt
-	completeSessionDTO.Topics = topics
-	completeSessionDTO.UserAnswers = answersList
-	completeSessionDTO.IsExpired = isExpired
-	completeSessionDTO.SessionResult = resultDTO
+	return answerDTO
+}
 
-	return completeSessionDTO, nil
+func (s *Server) toSessionResultDTO(result *entities.SessionResult) dto.SessionResultDTO {
+	return dto.SessionResultDTO{
+		IsSuccess:     result.IsSuccess,
+		Grade:         result.Grade,
+		Score:         result.Score,
+		CorrectCount:  result.CorrectCount,
+		TotalCount:    result.TotalCount,
+		Outcome:       string(result.Outcome),
+		TopicScores:   dto.NewTopicScoresDTO(result.TopicScores),
+		PassThreshold: result.PassThreshold,
+	}
 }
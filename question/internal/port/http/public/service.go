@@ -3,6 +3,7 @@ package public
 import (
 	"context"
 
+	"github.com/parta4ok/kvs/question/internal/cases"
 	"github.com/parta4ok/kvs/question/internal/entities"
 )
 
@@ -10,8 +11,21 @@ import (
 type Service interface {
 	CompleteSession(ctx context.Context, sessionID string, answers []*entities.UserAnswer) (
 		*entities.SessionResult, error)
-	CreateSession(ctx context.Context, userID string, topics []string) (string,
-		map[string]entities.Question, error)
+	CreateSession(ctx context.Context, userID string, topics []string,
+		mode entities.SessionMode) (string, []entities.Question, error)
 	ShowTopics(ctx context.Context) ([]string, error)
-	GetAllCompletedUserSessions(ctx context.Context, userID string) ([]*entities.Session, error)
+	GetCompletedUserSessions(ctx context.Context, userID string, query entities.HistoryQuery) (
+		*entities.HistoryPage, error)
+	GetSession(ctx context.Context, sessionID string) (*entities.Session, error)
+	SaveDraftAnswer(ctx context.Context, sessionID string, answer *entities.UserAnswer) error
+	ResumeSession(ctx context.Context, sessionID string) (*cases.ResumedSession, error)
+	PauseSession(ctx context.Context, sessionID string) (*entities.Session, error)
+	UnpauseSession(ctx context.Context, sessionID string) (*entities.Session, error)
+	CheckAnswer(ctx context.Context, sessionID string, answer *entities.UserAnswer) (
+		*entities.AnswerFeedback, error)
+	GetSessionReview(ctx context.Context, sessionID string, reviewer entities.Reviewer) (
+		*entities.SessionReview, error)
+	CreateReviewSession(ctx context.Context, userID string, topics []string) (string,
+		[]entities.Question, error)
+	GetDueReviews(ctx context.Context, userID string) ([]entities.DueReviews, error)
 }
@@ -0,0 +1,223 @@
+package public
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/go-chi/chi"
+	"github.com/pkg/errors"
+
+	"github.com/parta4ok/kvs/question/internal/entities"
+	"github.com/parta4ok/kvs/question/pkg/dto"
+)
+
+// GetSession returns current state of the session
+//
+// @Summary      Get session
+// @Description  Returns status, questions without answers, start time, duration limit and server-computed remaining time of the session. Available to the session owner, admins and mentors linked to the owner
+// @Accept       json
+// @Produce      json
+// @Security     ApiKeyAuth
+// @Param        Authorization header string true "Bearer {token}"
+// @Param        user_id path int true "User ID"
+// @Param        session_id path int true "Session ID"
+// @Success      200 {object} dto.SessionInfoDTO "Session"
+// @Failure      400 {object} dto.ErrorDTO "Invalid parameters"
+// @Failure      403 {object} dto.ErrorDTO "Session belongs to another user"
+// @Failure      404 {object} dto.ErrorDTO "Session not found"
+// @Failure      500 {object} dto.ErrorDTO "Internal server error"
+// @Router       /{user_id}/{session_id} [get]
+func (s *Server) GetSession(resp http.ResponseWriter, req *http.Request) {
+	slog.Info("GetSession started")
+
+	if err := s.checkUserRights(req.Context(), []string{right_view_topic_list}); err != nil {
+		slog.Error(err.Error())
+		s.errProcessing(resp, err)
+		return
+	}
+
+	resp.Header().Set("Content-Type", "application/json")
+
+	userID := chi.URLParam(req, "user_id")
+	sessionID := chi.URLParam(req, "session_id")
+	if userID == "" || sessionID == "" {
+		err := errors.Wrap(entities.ErrInvalidParam, "userID or sessionID invalid")
+		slog.Error(err.Error())
+		s.errProcessing(resp, err)
+		return
+	}
+
+	session, err := s.checkSessionAccess(req.Context(), userID, sessionID)
+	if err != nil {
+		slog.Error(err.Error())
+		s.errProcessing(resp, err)
+		return
+	}
+
+	sessionDTO, err := s.toSessionInfoDTO(session, time.Now().UTC())
+	if err != nil {
+		err := errors.Wrap(err, "toSessionInfoDTO failure")
+		slog.Error(err.Error())
+		s.errProcessing(resp, err)
+		return
+	}
+
+	s.writeResponse(resp, http.StatusOK, sessionDTO)
+	slog.Info("GetSession completed")
+}
+
+// checkOwnerAccess allows access to resources of the owner for the owner itself, admins and
+// mentors linked to the owner.
+func (s *Server) checkOwnerAccess(ctx context.Context, ownerID string) error {
+	canAccess, err := s.accessor.CanAccess(ctx, ownerID)
+	if err != nil {
+		return err
+	}
+
+	if !canAccess {
+		return errors.Wrapf(entities.ErrForbidden, "no access to resources of user %s", ownerID)
+	}
+
+	return nil
+}
+
+// checkOwner allows changes of resources of the owner only to the owner itself, admins and
+// linked mentors may only view them.
+func (s *Server) checkOwner(ctx context.Context, ownerID string) error {
+	isOwner, err := s.accessor.IsOwner(ctx, ownerID)
+	if err != nil {
+		return err
+	}
+
+	if !isOwner {
+		return errors.Wrapf(entities.ErrForbidden, "only user %s may change own resources", ownerID)
+	}
+
+	return nil
+}
+
+// checkSessionAccess loads the session of the user and checks the caller may view it.
+func (s *Server) checkSessionAccess(ctx context.Context, userID string, sessionID string) (
+	*entities.Session, error) {
+	return s.loadUserSession(ctx, userID, sessionID, s.checkOwnerAccess)
+}
+
+// checkSessionOwner loads the session of the user and checks the caller is its owner.
+func (s *Server) checkSessionOwner(ctx context.Context, userID string, sessionID string) (
+	*entities.Session, error) {
+	return s.loadUserSession(ctx, userID, sessionID, s.checkOwner)
+}
+
+func (s *Server) loadUserSession(ctx context.Context, userID string, sessionID string,
+	checkAccess func(ctx context.Context, ownerID string) error) (*entities.Session, error) {
+	session, err := s.service.GetSession(ctx, sessionID)
+	if err != nil {
+		return nil, errors.Wrap(err, "GetSession failure")
+	}
+
+	// session of another user is reported as missing to not disclose its existence
+	if session.GetUserID() != userID {
+		return nil, errors.Wrapf(entities.ErrNotFound, "user %s has no session %s", userID, sessionID)
+	}
+
+	if err := checkAccess(ctx, userID); err != nil {
+		return nil, err
+	}
+
+	return session, nil
+}
+
+func (s *Server) toSessionInfoDTO(session *entities.Session, now time.Time) (
+	dto.SessionInfoDTO, error) {
+	sessionDTO := dto.SessionInfoDTO{
+		SessionID:  session.GetSesionID(),
+		UserID:     session.GetUserID(),
+		Mode:       string(session.GetPolicy().Mode),
+		Status:     s.toSessionStatus(session.GetStatus()),
+		Topics:     session.GetTopics(),
+		Questions:  make([]dto.QuestionDTO, 0),
+		ServerTime: now,
+	}
+
+	if session.GetStatus() == entities.InitState {
+		return sessionDTO, nil
+	}
+
+	questions, err := session.GetQuestions()
+	if err != nil {
+		return sessionDTO, errors.Wrap(err, "GetQuestions")
+	}
+
+	for _, question := range questions {
+		sessionDTO.Questions = append(sessionDTO.Questions, s.toQuestionDTO(question))
+	}
+
+	startedAt, err := session.GetStartedAt()
+	if err != nil {
+		return sessionDTO, errors.Wrap(err, "GetStartedAt")
+	}
+	sessionDTO.StartedAt = &startedAt
+
+	switch session.GetStatus() {
+	case entities.ActiveState, entities.PausedState:
+		if !session.GetPolicy().IsTimed() {
+			break
+		}
+
+		duration, err := session.GetSessionDurationLimit()
+		if err != nil {
+			return sessionDTO, errors.Wrap(err, "GetSessionDurationLimit")
+		}
+
+		remaining, err := session.GetRemainingTime(now)
+		if err != nil {
+			return sessionDTO, errors.Wrap(err, "GetRemainingTime")
+		}
+
+		clock, err := session.GetClock()
+		if err != nil {
+			return sessionDTO, errors.Wrap(err, "GetClock")
+		}
+
+		durationSeconds := int64(duration / time.Second)
+		remainingSeconds := int64(remaining / time.Second)
+		pausesLeft := max(0, session.GetPolicy().Pause.MaxPauses-clock.Pauses)
+
+		sessionDTO.DurationLimitSeconds = &durationSeconds
+		sessionDTO.RemainingSeconds = &remainingSeconds
+		sessionDTO.PausesLeft = &pausesLeft
+
+		if clock.IsRunning() {
+			deadline, err := session.GetDeadline()
+			if err != nil {
+				return sessionDTO, errors.Wrap(err, "GetDeadline")
+			}
+			sessionDTO.Deadline = &deadline
+		}
+	case entities.CompletedState:
+		isExpired, err := session.IsExpired()
+		if err != nil {
+			return sessionDTO, errors.Wrap(err, "IsExpired")
+		}
+		sessionDTO.IsExpired = &isExpired
+	}
+
+	return sessionDTO, nil
+}
+
+func (s *Server) toSessionStatus(state string) string {
+	switch state {
+	case entities.InitState:
+		return "init"
+	case entities.ActiveState:
+		return "active"
+	case entities.PausedState:
+		return "paused"
+	case entities.CompletedState:
+		return "completed"
+	default:
+		return state
+	}
+}
@@ -0,0 +1,184 @@
+package public
+
+import (
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/go-chi/chi"
+	"github.com/pkg/errors"
+
+	"github.com/parta4ok/kvs/question/internal/entities"
+	"github.com/parta4ok/kvs/question/pkg/dto"
+)
+
+// SaveDraftAnswer saves or overwrites answer to one question of the active session
+//
+// @Summary      Save draft answer
+// @Description  Saves answer to one question while session is active. Saved answers are merged with answers submitted on completion
+// @Accept       json
+// @Produce      json
+// @Security     ApiKeyAuth
+// @Param        Authorization header string true "Bearer {token}"
+// @Param        user_id path int true "User ID"
+// @Param        session_id path int true "Session ID"
+// @Param        question_id path int true "Question ID"
+// @Param        request body dto.UserAnswerDTO true "User answer"
+// @Success      204 "Answer saved"
+// @Failure      400 {object} dto.ErrorDTO "Invalid parameters"
+// @Failure      403 {object} dto.ErrorDTO "No access to sessions of the user"
+// @Failure      404 {object} dto.ErrorDTO "Session not found"
+// @Failure      409 {object} dto.ErrorDTO "Session is not active or expired"
+// @Failure      500 {object} dto.ErrorDTO "Internal server error"
+// @Router       /{user_id}/{session_id}/answers/{question_id} [put]
+func (s *Server) SaveDraftAnswer(resp http.ResponseWriter, req *http.Request) {
+	slog.Info("SaveDraftAnswer started")
+
+	if err := s.checkUserRights(req.Context(), []string{right_complete_session}); err != nil {
+		slog.Error(err.Error())
+		s.errProcessing(resp, err)
+		return
+	}
+
+	resp.Header().Set("Content-Type", "application/json")
+
+	userID := chi.URLParam(req, "user_id")
+	sessionID := chi.URLParam(req, "session_id")
+	if userID == "" || sessionID == "" {
+		err := errors.Wrap(entities.ErrInvalidParam, "userID or sessionID invalid")
+		slog.Error(err.Error())
+		s.errProcessing(resp, err)
+		return
+	}
+
+	if _, err := s.checkSessionOwner(req.Context(), userID, sessionID); err != nil {
+		slog.Error(err.Error())
+		s.errProcessing(resp, err)
+		return
+	}
+
+	var answerDTO dto.UserAnswerDTO
+	if err := json.NewDecoder(req.Body).Decode(&answerDTO); err != nil {
+		err := errors.Wrapf(entities.ErrInvalidParam,
+			"decode request body to userAnswerDTO failure: %v", err)
+		slog.Error(err.Error())
+		s.errProcessing(resp, err)
+		return
+	}
+	answerDTO.QuestionID = chi.URLParam(req, "question_id")
+
+	answer, err := s.toUserAnswer(answerDTO)
+	if err != nil {
+		err := errors.Wrapf(entities.ErrInvalidParam, "create user answer failure: %v", err)
+		slog.Error(err.Error())
+		s.errProcessing(resp, err)
+		return
+	}
+
+	if err := s.service.SaveDraftAnswer(req.Context(), sessionID, answer); err != nil {
+		err := errors.Wrap(err, "SaveDraftAnswer failure")
+		slog.Error(err.Error())
+		s.errProcessing(resp, err)
+		return
+	}
+
+	resp.WriteHeader(http.StatusNoContent)
+	slog.Info("SaveDraftAnswer completed")
+}
+
+// ResumeSession returns active session with saved draft answers
+//
+// @Summary      Resume session
+// @Description  Returns questions of the active session, saved draft answers and remaining time
+// @Accept       json
+// @Produce      json
+// @Security     ApiKeyAuth
+// @Param        Authorization header string true "Bearer {token}"
+// @Param        user_id path int true "User ID"
+// @Param        session_id path int true "Session ID"
+// @Success      200 {object} dto.ResumedSessionDTO "Active session"
+// @Failure      400 {object} dto.ErrorDTO "Invalid parameters"
+// @Failure      403 {object} dto.ErrorDTO "No access to sessions of the user"
+// @Failure      404 {object} dto.ErrorDTO "Session not found"
+// @Failure      409 {object} dto.ErrorDTO "Session is not active or expired"
+// @Failure      500 {object} dto.ErrorDTO "Internal server error"
+// @Router       /{user_id}/{session_id}/resume [get]
+func (s *Server) ResumeSession(resp http.ResponseWriter, req *http.Request) {
+	slog.Info("ResumeSession started")
+
+	if err := s.checkUserRights(req.Context(), []string{right_complete_session}); err != nil {
+		slog.Error(err.Error())
+		s.errProcessing(resp, err)
+		return
+	}
+
+	resp.Header().Set("Content-Type", "application/json")
+
+	userID := chi.URLParam(req, "user_id")
+	sessionID := chi.URLParam(req, "session_id")
+	if userID == "" || sessionID == "" {
+		err := errors.Wrap(entities.ErrInvalidParam, "userID or sessionID invalid")
+		slog.Error(err.Error())
+		s.errProcessing(resp, err)
+		return
+	}
+
+	if _, err := s.checkSessionAccess(req.Context(), userID, sessionID); err != nil {
+		slog.Error(err.Error())
+		s.errProcessing(resp, err)
+		return
+	}
+
+	resumed, err := s.service.ResumeSession(req.Context(), sessionID)
+	if err != nil {
+		err := errors.Wrap(err, "ResumeSession failure")
+		slog.Error(err.Error())
+		s.errProcessing(resp, err)
+		return
+	}
+
+	questions, err := resumed.Session.GetQuestions()
+	if err != nil {
+		err := errors.Wrap(err, "GetQuestions failure")
+		slog.Error(err.Error())
+		s.errProcessing(resp, err)
+		return
+	}
+
+	resumedDTO := dto.ResumedSessionDTO{
+		SessionID: resumed.Session.GetSesionID(),
+		Mode:      string(resumed.Session.GetPolicy().Mode),
+		Topics:    resumed.Session.GetTopics(),
+		Questions: make([]dto.QuestionDTO, 0, len(questions)),
+		Drafts:    make([]dto.UserAnswerDTO, 0, len(resumed.Drafts)),
+	}
+
+	if resumed.Session.GetPolicy().IsTimed() {
+		deadline, err := resumed.Session.GetDeadline()
+		if err != nil {
+			err := errors.Wrap(err, "GetDeadline failure")
+			slog.Error(err.Error())
+			s.errProcessing(resp, err)
+			return
+		}
+
+		remainingSeconds := int64(resumed.Remaining / time.Second)
+		resumedDTO.Deadline = &deadline
+		resumedDTO.RemainingSeconds = &remainingSeconds
+	}
+
+	questionsMap := make(map[string]entities.Question, len(questions))
+	for _, question := range questions {
+		questionsMap[question.ID()] = question
+		resumedDTO.Questions = append(resumedDTO.Questions, s.toQuestionDTO(question))
+	}
+
+	for _, draft := range resumed.Drafts {
+		resumedDTO.Drafts = append(resumedDTO.Drafts,
+			s.toUserAnswerDTO(questionsMap[draft.GetQuestionID()], draft))
+	}
+
+	s.writeResponse(resp, http.StatusOK, resumedDTO)
+	slog.Info("ResumeSession completed")
+}
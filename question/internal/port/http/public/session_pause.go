@@ -0,0 +1,138 @@
+package public
+
+import (
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/go-chi/chi"
+	"github.com/pkg/errors"
+
+	"github.com/parta4ok/kvs/question/internal/entities"
+)
+
+// PauseSession stops the clock of the active session
+//
+// @Summary      Pause session
+// @Description  Pauses the active session if the pause limit of its topics allows it. Time of the pause is not counted in the session duration
+// @Accept       json
+// @Produce      json
+// @Security     ApiKeyAuth
+// @Param        Authorization header string true "Bearer {token}"
+// @Param        user_id path int true "User ID"
+// @Param        session_id path int true "Session ID"
+// @Success      200 {object} dto.SessionInfoDTO "Paused session"
+// @Failure      400 {object} dto.ErrorDTO "Invalid parameters"
+// @Failure      403 {object} dto.ErrorDTO "Pause limit reached or no access to sessions of the user"
+// @Failure      404 {object} dto.ErrorDTO "Session not found"
+// @Failure      409 {object} dto.ErrorDTO "Session is not active or expired"
+// @Failure      500 {object} dto.ErrorDTO "Internal server error"
+// @Router       /{user_id}/{session_id}/pause [post]
+func (s *Server) PauseSession(resp http.ResponseWriter, req *http.Request) {
+	slog.Info("PauseSession started")
+
+	if err := s.checkUserRights(req.Context(), []string{right_complete_session}); err != nil {
+		slog.Error(err.Error())
+		s.errProcessing(resp, err)
+		return
+	}
+
+	resp.Header().Set("Content-Type", "application/json")
+
+	userID := chi.URLParam(req, "user_id")
+	sessionID := chi.URLParam(req, "session_id")
+	if userID == "" || sessionID == "" {
+		err := errors.Wrap(entities.ErrInvalidParam, "userID or sessionID invalid")
+		slog.Error(err.Error())
+		s.errProcessing(resp, err)
+		return
+	}
+
+	if _, err := s.checkSessionOwner(req.Context(), userID, sessionID); err != nil {
+		slog.Error(err.Error())
+		s.errProcessing(resp, err)
+		return
+	}
+
+	session, err := s.service.PauseSession(req.Context(), sessionID)
+	if err != nil {
+		err := errors.Wrap(err, "PauseSession failure")
+		slog.Error(err.Error())
+		s.errProcessing(resp, err)
+		return
+	}
+
+	sessionDTO, err := s.toSessionInfoDTO(session, time.Now().UTC())
+	if err != nil {
+		err := errors.Wrap(err, "toSessionInfoDTO failure")
+		slog.Error(err.Error())
+		s.errProcessing(resp, err)
+		return
+	}
+
+	s.writeResponse(resp, http.StatusOK, sessionDTO)
+	slog.Info("PauseSession completed")
+}
+
+// UnpauseSession starts the clock of the paused session again
+//
+// @Summary      Unpause session
+// @Description  Continues the paused session with the time left before the pause
+// @Accept       json
+// @Produce      json
+// @Security     ApiKeyAuth
+// @Param        Authorization header string true "Bearer {token}"
+// @Param        user_id path int true "User ID"
+// @Param        session_id path int true "Session ID"
+// @Success      200 {object} dto.SessionInfoDTO "Active session"
+// @Failure      400 {object} dto.ErrorDTO "Invalid parameters"
+// @Failure      403 {object} dto.ErrorDTO "No access to sessions of the user"
+// @Failure      404 {object} dto.ErrorDTO "Session not found"
+// @Failure      409 {object} dto.ErrorDTO "Session is not paused"
+// @Failure      500 {object} dto.ErrorDTO "Internal server error"
+// @Router       /{user_id}/{session_id}/unpause [post]
+func (s *Server) UnpauseSession(resp http.ResponseWriter, req *http.Request) {
+	slog.Info("UnpauseSession started")
+
+	if err := s.checkUserRights(req.Context(), []string{right_complete_session}); err != nil {
+		slog.Error(err.Error())
+		s.errProcessing(resp, err)
+		return
+	}
+
+	resp.Header().Set("Content-Type", "application/json")
+
+	userID := chi.URLParam(req, "user_id")
+	sessionID := chi.URLParam(req, "session_id")
+	if userID == "" || sessionID == "" {
+		err := errors.Wrap(entities.ErrInvalidParam, "userID or sessionID invalid")
+		slog.Error(err.Error())
+		s.errProcessing(resp, err)
+		return
+	}
+
+	if _, err := s.checkSessionOwner(req.Context(), userID, sessionID); err != nil {
+		slog.Error(err.Error())
+		s.errProcessing(resp, err)
+		return
+	}
+
+	session, err := s.service.UnpauseSession(req.Context(), sessionID)
+	if err != nil {
+		err := errors.Wrap(err, "UnpauseSession failure")
+		slog.Error(err.Error())
+		s.errProcessing(resp, err)
+		return
+	}
+
+	sessionDTO, err := s.toSessionInfoDTO(session, time.Now().UTC())
+	if err != nil {
+		err := errors.Wrap(err, "toSessionInfoDTO failure")
+		slog.Error(err.Error())
+		s.errProcessing(resp, err)
+		return
+	}
+
+	s.writeResponse(resp, http.StatusOK, sessionDTO)
+	slog.Info("UnpauseSession completed")
+}
@@ -0,0 +1,98 @@
+package public
+
+import (
+	"encoding/json"
+	"log/slog"
+	"net/http"
+
+	"github.com/go-chi/chi"
+	"github.com/pkg/errors"
+
+	"github.com/parta4ok/kvs/question/internal/entities"
+	"github.com/parta4ok/kvs/question/pkg/dto"
+)
+
+// CheckAnswer returns immediate feedback on one answer of the practice session
+//
+// @Summary      Check answer
+// @Description  Evaluates answer to one question of the active practice session and returns correct answers. The session is not completed
+// @Accept       json
+// @Produce      json
+// @Security     ApiKeyAuth
+// @Param        Authorization header string true "Bearer {token}"
+// @Param        user_id path int true "User ID"
+// @Param        session_id path int true "Session ID"
+// @Param        question_id path int true "Question ID"
+// @Param        request body dto.UserAnswerDTO true "User answer"
+// @Success      200 {object} dto.AnswerFeedbackDTO "Answer feedback"
+// @Failure      400 {object} dto.ErrorDTO "Invalid parameters"
+// @Failure      403 {object} dto.ErrorDTO "Session is not a practice session or no access to sessions of the user"
+// @Failure      404 {object} dto.ErrorDTO "Session not found"
+// @Failure      409 {object} dto.ErrorDTO "Session is not active"
+// @Failure      500 {object} dto.ErrorDTO "Internal server error"
+// @Router       /{user_id}/{session_id}/check/{question_id} [post]
+func (s *Server) CheckAnswer(resp http.ResponseWriter, req *http.Request) {
+	slog.Info("CheckAnswer started")
+
+	if err := s.checkUserRights(req.Context(), []string{right_complete_session}); err != nil {
+		slog.Error(err.Error())
+		s.errProcessing(resp, err)
+		return
+	}
+
+	resp.Header().Set("Content-Type", "application/json")
+
+	userID := chi.URLParam(req, "user_id")
+	sessionID := chi.URLParam(req, "session_id")
+	if userID == "" || sessionID == "" {
+		err := errors.Wrap(entities.ErrInvalidParam, "userID or sessionID invalid")
+		slog.Error(err.Error())
+		s.errProcessing(resp, err)
+		return
+	}
+
+	if _, err := s.checkSessionOwner(req.Context(), userID, sessionID); err != nil {
+		slog.Error(err.Error())
+		s.errProcessing(resp, err)
+		return
+	}
+
+	var answerDTO dto.UserAnswerDTO
+	if err := json.NewDecoder(req.Body).Decode(&answerDTO); err != nil {
+		err := errors.Wrapf(entities.ErrInvalidParam,
+			"decode request body to userAnswerDTO failure: %v", err)
+		slog.Error(err.Error())
+		s.errProcessing(resp, err)
+		return
+	}
+	answerDTO.QuestionID = chi.URLParam(req, "question_id")
+
+	answer, err := s.toUserAnswer(answerDTO)
+	if err != nil {
+		err := errors.Wrapf(entities.ErrInvalidParam, "create user answer failure: %v", err)
+		slog.Error(err.Error())
+		s.errProcessing(resp, err)
+		return
+	}
+
+	feedback, err := s.service.CheckAnswer(req.Context(), sessionID, answer)
+	if err != nil {
+		err := errors.Wrap(err, "CheckAnswer failure")
+		slog.Error(err.Error())
+		s.errProcessing(resp, err)
+		return
+	}
+
+	correctAnswers := feedback.CorrectAnswers
+	if correctAnswers == nil {
+		correctAnswers = []string{}
+	}
+
+	s.writeResponse(resp, http.StatusOK, dto.AnswerFeedbackDTO{
+		QuestionID:     feedback.QuestionID,
+		IsCorrect:      feedback.IsCorrect,
+		Credit:         feedback.Credit,
+		CorrectAnswers: correctAnswers,
+	})
+	slog.Info("CheckAnswer completed")
+}
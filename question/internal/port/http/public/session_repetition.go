@@ -0,0 +1,159 @@
+package public
+
+import (
+	"encoding/json"
+	"io"
+	"log/slog"
+	"net/http"
+	"slices"
+
+	"github.com/go-chi/chi"
+	"github.com/pkg/errors"
+
+	"github.com/parta4ok/kvs/question/internal/entities"
+	"github.com/parta4ok/kvs/question/pkg/dto"
+)
+
+// StartReviewSession creates a practice session of questions due for repetition
+//
+// @Summary      Create review session
+// @Description  Starts a practice session of questions due for repetition by the Leitner schedule built from answers of the completed sessions of the user. Questions answered wrong last time go first. Empty topics mean all topics with questions due
+// @Accept       json
+// @Produce      json
+// @Security     ApiKeyAuth
+// @Param        Authorization header string true "Bearer {token}"
+// @Param        user_id path int true "User ID"
+// @Param        request body dto.StartReviewSessionDTO false "Topics to review"
+// @Success      201 {object} dto.SessionDTO "Successfully created review session"
+// @Failure      400 {object} dto.ErrorDTO "Invalid parameters"
+// @Failure      403 {object} dto.ErrorDTO "No access to sessions of the user"
+// @Failure      404 {object} dto.ErrorDTO "Topics not found or no questions due for review"
+// @Failure      500 {object} dto.ErrorDTO "Internal server error"
+// @Router       /{user_id}/start_review_session [post]
+func (s *Server) StartReviewSession(resp http.ResponseWriter, req *http.Request) {
+	slog.Info("StartReviewSession started")
+
+	if err := s.checkUserRights(req.Context(), []string{right_start_session}); err != nil {
+		slog.Error(err.Error())
+		s.errProcessing(resp, err)
+		return
+	}
+
+	resp.Header().Set("Content-Type", "application/json")
+
+	userID := chi.URLParam(req, "user_id")
+	if userID == "" {
+		err := errors.Wrap(entities.ErrInvalidParam, "userID invalid")
+		slog.Error(err.Error())
+		s.errProcessing(resp, err)
+		return
+	}
+
+	if err := s.checkOwner(req.Context(), userID); err != nil {
+		slog.Error(err.Error())
+		s.errProcessing(resp, err)
+		return
+	}
+
+	// the request body is optional
+	var startDTO dto.StartReviewSessionDTO
+	if err := json.NewDecoder(req.Body).Decode(&startDTO); err != nil && !errors.Is(err, io.EOF) {
+		err := errors.Wrapf(entities.ErrInvalidParam,
+			"decode req body to startReviewSessionDTO failure: %v", err)
+		slog.Error(err.Error())
+		s.errProcessing(resp, err)
+		return
+	}
+
+	sessionID, questions, err := s.service.CreateReviewSession(req.Context(), userID,
+		startDTO.Topics)
+	if err != nil {
+		err := errors.Wrap(err, "CreateReviewSession failure")
+		slog.Error(err.Error())
+		s.errProcessing(resp, err)
+		return
+	}
+
+	topics := make([]string, 0)
+	questionsDTO := make([]dto.QuestionDTO, 0, len(questions))
+	for _, question := range questions {
+		questionsDTO = append(questionsDTO, s.toQuestionDTO(question))
+		if !slices.Contains(topics, question.Topic()) {
+			topics = append(topics, question.Topic())
+		}
+	}
+	slices.Sort(topics)
+
+	s.writeResponse(resp, http.StatusCreated, dto.SessionDTO{
+		SessionID: sessionID,
+		Mode:      string(entities.PracticeMode),
+		Topics:    topics,
+		Questions: questionsDTO,
+	})
+	slog.Info("StartReviewSession completed")
+}
+
+// GetDueReviews returns how many questions of every topic are due for repetition
+//
+// @Summary      Get due reviews
+// @Description  Counts questions due for repetition by the Leitner schedule by topic, including the ones answered wrong last time. Topics without due questions show when the nearest question becomes due
+// @Accept       json
+// @Produce      json
+// @Security     ApiKeyAuth
+// @Param        Authorization header string true "Bearer {token}"
+// @Param        user_id path int true "User ID"
+// @Success      200 {object} dto.DueReviewsListDTO "Questions due for repetition by topic"
+// @Failure      400 {object} dto.ErrorDTO "Invalid parameters"
+// @Failure      403 {object} dto.ErrorDTO "No access to sessions of the user"
+// @Failure      500 {object} dto.ErrorDTO "Internal server error"
+// @Router       /{user_id}/due_reviews [get]
+func (s *Server) GetDueReviews(resp http.ResponseWriter, req *http.Request) {
+	slog.Info("GetDueReviews started")
+
+	if err := s.checkUserRights(req.Context(), []string{right_view_topic_list}); err != nil {
+		slog.Error(err.Error())
+		s.errProcessing(resp, err)
+		return
+	}
+
+	resp.Header().Set("Content-Type", "application/json")
+
+	userID := chi.URLParam(req, "user_id")
+	if userID == "" {
+		err := errors.Wrap(entities.ErrInvalidParam, "userID invalid")
+		slog.Error(err.Error())
+		s.errProcessing(resp, err)
+		return
+	}
+
+	if err := s.checkOwnerAccess(req.Context(), userID); err != nil {
+		slog.Error(err.Error())
+		s.errProcessing(resp, err)
+		return
+	}
+
+	reviews, err := s.service.GetDueReviews(req.Context(), userID)
+	if err != nil {
+		err := errors.Wrap(err, "GetDueReviews failure")
+		slog.Error(err.Error())
+		s.errProcessing(resp, err)
+		return
+	}
+
+	reviewsDTO := make([]dto.DueReviewsDTO, 0, len(reviews))
+	for _, review := range reviews {
+		reviewDTO := dto.DueReviewsDTO{
+			Topic:  review.Topic,
+			Due:    review.Due,
+			Failed: review.Failed,
+		}
+		if !review.NextDueAt.IsZero() {
+			nextDueAt := review.NextDueAt
+			reviewDTO.NextDueAt = &nextDueAt
+		}
+		reviewsDTO = append(reviewsDTO, reviewDTO)
+	}
+
+	s.writeResponse(resp, http.StatusOK, dto.DueReviewsListDTO{Topics: reviewsDTO})
+	slog.Info("GetDueReviews completed")
+}
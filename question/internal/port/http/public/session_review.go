@@ -0,0 +1,109 @@
+package public
+
+import (
+	"log/slog"
+	"net/http"
+
+	"github.com/go-chi/chi"
+	"github.com/pkg/errors"
+
+	"github.com/parta4ok/kvs/question/internal/entities"
+	"github.com/parta4ok/kvs/question/pkg/dto"
+)
+
+// GetSessionReview returns answers of the completed session with the correct ones
+//
+// @Summary      Get session review
+// @Description  Returns selections of the user, correct answers, correctness and explanation for every question of the completed session. When the student sees the review is set by the review policy the session was created with: immediately, after the session deadline or never (mentors only). Mentors linked to the owner and admins see the review regardless of the policy
+// @Accept       json
+// @Produce      json
+// @Security     ApiKeyAuth
+// @Param        Authorization header string true "Bearer {token}"
+// @Param        user_id path int true "User ID"
+// @Param        session_id path int true "Session ID"
+// @Success      200 {object} dto.SessionReviewDTO "Session review"
+// @Failure      400 {object} dto.ErrorDTO "Invalid parameters"
+// @Failure      403 {object} dto.ErrorDTO "Review is not available yet or no access to sessions of the user"
+// @Failure      404 {object} dto.ErrorDTO "Session not found"
+// @Failure      409 {object} dto.ErrorDTO "Session is not completed"
+// @Failure      500 {object} dto.ErrorDTO "Internal server error"
+// @Router       /{user_id}/{session_id}/review [get]
+func (s *Server) GetSessionReview(resp http.ResponseWriter, req *http.Request) {
+	slog.Info("GetSessionReview started")
+
+	if err := s.checkUserRights(req.Context(), []string{right_view_topic_list}); err != nil {
+		slog.Error(err.Error())
+		s.errProcessing(resp, err)
+		return
+	}
+
+	resp.Header().Set("Content-Type", "application/json")
+
+	userID := chi.URLParam(req, "user_id")
+	sessionID := chi.URLParam(req, "session_id")
+	if userID == "" || sessionID == "" {
+		err := errors.Wrap(entities.ErrInvalidParam, "userID or sessionID invalid")
+		slog.Error(err.Error())
+		s.errProcessing(resp, err)
+		return
+	}
+
+	if _, err := s.checkSessionAccess(req.Context(), userID, sessionID); err != nil {
+		slog.Error(err.Error())
+		s.errProcessing(resp, err)
+		return
+	}
+
+	// the access is checked above, so the caller who is not the owner is a mentor or an admin
+	isOwner, err := s.accessor.IsOwner(req.Context(), userID)
+	if err != nil {
+		slog.Error(err.Error())
+		s.errProcessing(resp, err)
+		return
+	}
+
+	reviewer := entities.MentorReviewer
+	if isOwner {
+		reviewer = entities.StudentReviewer
+	}
+
+	review, err := s.service.GetSessionReview(req.Context(), sessionID, reviewer)
+	if err != nil {
+		err := errors.Wrap(err, "GetSessionReview failure")
+		slog.Error(err.Error())
+		s.errProcessing(resp, err)
+		return
+	}
+
+	s.writeResponse(resp, http.StatusOK, s.toSessionReviewDTO(review))
+	slog.Info("GetSessionReview completed")
+}
+
+func (s *Server) toSessionReviewDTO(review *entities.SessionReview) dto.SessionReviewDTO {
+	reviewDTO := dto.SessionReviewDTO{
+		SessionID: review.SessionID,
+		UserID:    review.UserID,
+		Mode:      string(review.Mode),
+		Items:     make([]dto.ReviewItemDTO, 0, len(review.Items)),
+	}
+
+	for _, item := range review.Items {
+		correctAnswers := item.CorrectAnswers
+		if correctAnswers == nil {
+			correctAnswers = []string{}
+		}
+
+		reviewDTO.Items = append(reviewDTO.Items, dto.ReviewItemDTO{
+			QuestionID:     item.QuestionID,
+			Topic:          item.Topic,
+			Subject:        item.Subject,
+			Selections:     item.Selections,
+			CorrectAnswers: correctAnswers,
+			IsCorrect:      item.IsCorrect,
+			Credit:         item.Credit,
+			Explanation:    item.Explanation,
+		})
+	}
+
+	return reviewDTO
+}
@@ -34,6 +34,21 @@ func (m *MockAccessor) EXPECT() *MockAccessorMockRecorder {
 	return m.recorder
 }
 
+// CanAccess mocks base method.
+func (m *MockAccessor) CanAccess(ctx context.Context, ownerID string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CanAccess", ctx, ownerID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CanAccess indicates an expected call of CanAccess.
+func (mr *MockAccessorMockRecorder) CanAccess(ctx, ownerID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CanAccess", reflect.TypeOf((*MockAccessor)(nil).CanAccess), ctx, ownerID)
+}
+
 // HasPermission mocks base method.
 func (m *MockAccessor) HasPermission(ctx context.Context, rights []string) (bool, error) {
 	m.ctrl.T.Helper()
@@ -48,3 +63,33 @@ func (mr *MockAccessorMockRecorder) HasPermission(ctx, rights interface{}) *gomo
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HasPermission", reflect.TypeOf((*MockAccessor)(nil).HasPermission), ctx, rights)
 }
+
+// IsAdmin mocks base method.
+func (m *MockAccessor) IsAdmin(ctx context.Context) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsAdmin", ctx)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsAdmin indicates an expected call of IsAdmin.
+func (mr *MockAccessorMockRecorder) IsAdmin(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsAdmin", reflect.TypeOf((*MockAccessor)(nil).IsAdmin), ctx)
+}
+
+// IsOwner mocks base method.
+func (m *MockAccessor) IsOwner(ctx context.Context, ownerID string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsOwner", ctx, ownerID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsOwner indicates an expected call of IsOwner.
+func (mr *MockAccessorMockRecorder) IsOwner(ctx, ownerID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsOwner", reflect.TypeOf((*MockAccessor)(nil).IsOwner), ctx, ownerID)
+}
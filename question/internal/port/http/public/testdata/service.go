@@ -9,6 +9,7 @@ import (
 	reflect "reflect"
 
 	gomock "github.com/golang/mock/gomock"
+	cases "github.com/parta4ok/kvs/question/internal/cases"
 	entities "github.com/parta4ok/kvs/question/internal/entities"
 )
 
@@ -35,6 +36,21 @@ func (m *MockService) EXPECT() *MockServiceMockRecorder {
 	return m.recorder
 }
 
+// CheckAnswer mocks base method.
+func (m *MockService) CheckAnswer(ctx context.Context, sessionID string, answer *entities.UserAnswer) (*entities.AnswerFeedback, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckAnswer", ctx, sessionID, answer)
+	ret0, _ := ret[0].(*entities.AnswerFeedback)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CheckAnswer indicates an expected call of CheckAnswer.
+func (mr *MockServiceMockRecorder) CheckAnswer(ctx, sessionID, answer interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckAnswer", reflect.TypeOf((*MockService)(nil).CheckAnswer), ctx, sessionID, answer)
+}
+
 // CompleteSession mocks base method.
 func (m *MockService) CompleteSession(ctx context.Context, sessionID string, answers []*entities.UserAnswer) (*entities.SessionResult, error) {
 	m.ctrl.T.Helper()
@@ -50,35 +66,140 @@ func (mr *MockServiceMockRecorder) CompleteSession(ctx, sessionID, answers inter
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CompleteSession", reflect.TypeOf((*MockService)(nil).CompleteSession), ctx, sessionID, answers)
 }
 
+// CreateReviewSession mocks base method.
+func (m *MockService) CreateReviewSession(ctx context.Context, userID string, topics []string) (string, []entities.Question, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateReviewSession", ctx, userID, topics)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].([]entities.Question)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// CreateReviewSession indicates an expected call of CreateReviewSession.
+func (mr *MockServiceMockRecorder) CreateReviewSession(ctx, userID, topics interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateReviewSession", reflect.TypeOf((*MockService)(nil).CreateReviewSession), ctx, userID, topics)
+}
+
 // CreateSession mocks base method.
-func (m *MockService) CreateSession(ctx context.Context, userID string, topics []string) (string, map[string]entities.Question, error) {
+func (m *MockService) CreateSession(ctx context.Context, userID string, topics []string, mode entities.SessionMode) (string, []entities.Question, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateSession", ctx, userID, topics)
+	ret := m.ctrl.Call(m, "CreateSession", ctx, userID, topics, mode)
 	ret0, _ := ret[0].(string)
-	ret1, _ := ret[1].(map[string]entities.Question)
+	ret1, _ := ret[1].([]entities.Question)
 	ret2, _ := ret[2].(error)
 	return ret0, ret1, ret2
 }
 
 // CreateSession indicates an expected call of CreateSession.
-func (mr *MockServiceMockRecorder) CreateSession(ctx, userID, topics interface{}) *gomock.Call {
+func (mr *MockServiceMockRecorder) CreateSession(ctx, userID, topics, mode interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSession", reflect.TypeOf((*MockService)(nil).CreateSession), ctx, userID, topics, mode)
+}
+
+// GetCompletedUserSessions mocks base method.
+func (m *MockService) GetCompletedUserSessions(ctx context.Context, userID string, query entities.HistoryQuery) (*entities.HistoryPage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCompletedUserSessions", ctx, userID, query)
+	ret0, _ := ret[0].(*entities.HistoryPage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCompletedUserSessions indicates an expected call of GetCompletedUserSessions.
+func (mr *MockServiceMockRecorder) GetCompletedUserSessions(ctx, userID, query interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCompletedUserSessions", reflect.TypeOf((*MockService)(nil).GetCompletedUserSessions), ctx, userID, query)
+}
+
+// GetDueReviews mocks base method.
+func (m *MockService) GetDueReviews(ctx context.Context, userID string) ([]entities.DueReviews, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDueReviews", ctx, userID)
+	ret0, _ := ret[0].([]entities.DueReviews)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDueReviews indicates an expected call of GetDueReviews.
+func (mr *MockServiceMockRecorder) GetDueReviews(ctx, userID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSession", reflect.TypeOf((*MockService)(nil).CreateSession), ctx, userID, topics)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDueReviews", reflect.TypeOf((*MockService)(nil).GetDueReviews), ctx, userID)
 }
 
-// GetAllCompletedUserSessions mocks base method.
-func (m *MockService) GetAllCompletedUserSessions(ctx context.Context, userID string) ([]*entities.Session, error) {
+// GetSession mocks base method.
+func (m *MockService) GetSession(ctx context.Context, sessionID string) (*entities.Session, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetAllCompletedUserSessions", ctx, userID)
-	ret0, _ := ret[0].([]*entities.Session)
+	ret := m.ctrl.Call(m, "GetSession", ctx, sessionID)
+	ret0, _ := ret[0].(*entities.Session)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetAllCompletedUserSessions indicates an expected call of GetAllCompletedUserSessions.
-func (mr *MockServiceMockRecorder) GetAllCompletedUserSessions(ctx, userID interface{}) *gomock.Call {
+// GetSession indicates an expected call of GetSession.
+func (mr *MockServiceMockRecorder) GetSession(ctx, sessionID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllCompletedUserSessions", reflect.TypeOf((*MockService)(nil).GetAllCompletedUserSessions), ctx, userID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSession", reflect.TypeOf((*MockService)(nil).GetSession), ctx, sessionID)
+}
+
+// GetSessionReview mocks base method.
+func (m *MockService) GetSessionReview(ctx context.Context, sessionID string, reviewer entities.Reviewer) (*entities.SessionReview, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSessionReview", ctx, sessionID, reviewer)
+	ret0, _ := ret[0].(*entities.SessionReview)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSessionReview indicates an expected call of GetSessionReview.
+func (mr *MockServiceMockRecorder) GetSessionReview(ctx, sessionID, reviewer interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSessionReview", reflect.TypeOf((*MockService)(nil).GetSessionReview), ctx, sessionID, reviewer)
+}
+
+// PauseSession mocks base method.
+func (m *MockService) PauseSession(ctx context.Context, sessionID string) (*entities.Session, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PauseSession", ctx, sessionID)
+	ret0, _ := ret[0].(*entities.Session)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PauseSession indicates an expected call of PauseSession.
+func (mr *MockServiceMockRecorder) PauseSession(ctx, sessionID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PauseSession", reflect.TypeOf((*MockService)(nil).PauseSession), ctx, sessionID)
+}
+
+// ResumeSession mocks base method.
+func (m *MockService) ResumeSession(ctx context.Context, sessionID string) (*cases.ResumedSession, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResumeSession", ctx, sessionID)
+	ret0, _ := ret[0].(*cases.ResumedSession)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResumeSession indicates an expected call of ResumeSession.
+func (mr *MockServiceMockRecorder) ResumeSession(ctx, sessionID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResumeSession", reflect.TypeOf((*MockService)(nil).ResumeSession), ctx, sessionID)
+}
+
+// SaveDraftAnswer mocks base method.
+func (m *MockService) SaveDraftAnswer(ctx context.Context, sessionID string, answer *entities.UserAnswer) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveDraftAnswer", ctx, sessionID, answer)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveDraftAnswer indicates an expected call of SaveDraftAnswer.
+func (mr *MockServiceMockRecorder) SaveDraftAnswer(ctx, sessionID, answer interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveDraftAnswer", reflect.TypeOf((*MockService)(nil).SaveDraftAnswer), ctx, sessionID, answer)
 }
 
 // ShowTopics mocks base method.
@@ -95,3 +216,18 @@ func (mr *MockServiceMockRecorder) ShowTopics(ctx interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ShowTopics", reflect.TypeOf((*MockService)(nil).ShowTopics), ctx)
 }
+
+// UnpauseSession mocks base method.
+func (m *MockService) UnpauseSession(ctx context.Context, sessionID string) (*entities.Session, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnpauseSession", ctx, sessionID)
+	ret0, _ := ret[0].(*entities.Session)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UnpauseSession indicates an expected call of UnpauseSession.
+func (mr *MockServiceMockRecorder) UnpauseSession(ctx, sessionID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnpauseSession", reflect.TypeOf((*MockService)(nil).UnpauseSession), ctx, sessionID)
+}
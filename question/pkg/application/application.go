@@ -24,10 +24,15 @@ import (
 )
 
 type App struct {
-	CfgPath      string
-	publicServer *public.Server
-	cancel       context.CancelFunc
-	wg           sync.WaitGroup
+	CfgPath       string
+	publicServer  *public.Server
+	expirySweeper *cases.ExpirySweeper
+	itemAnalysis  *cases.ItemAnalysisJob
+	backfill      *cases.SnapshotBackfill
+	outcomes      *cases.OutcomeBackfill
+	rescore       *cases.RescoreJob
+	cancel        context.CancelFunc
+	wg            sync.WaitGroup
 }
 
 func NewApp(cfgPath string) *App {
@@ -46,18 +51,30 @@ func (app *App) Start() {
 	app.initConfiguredLogger(cfg)
 	slog.Info("Logger configuration completed")
 
-	storage, sessionStorage := app.initStorage(cfg)
+	storage, attemptStorage, questionBankStorage, reportStorage, itemAnalysisStorage,
+		mentorStorage, relations := app.initStorage(cfg)
 	generator := app.initGenerator()
 	authClient := app.initAuthServiceClient(cfg)
-	accessor := app.initAccessor(cfg)
-
-	service := app.initSessionServiceBase(storage, sessionStorage, generator)
+	accessor := app.initAccessor(cfg, relations)
+
+	attempts := app.initAttemptService(cfg, attemptStorage)
+	service := app.initSessionServiceBase(cfg, storage, attempts, generator)
+	questionBank := app.initQuestionBankService(questionBankStorage)
+	reports := app.initReportService(cfg, reportStorage)
+	itemAnalysis := app.initItemAnalysisService(itemAnalysisStorage)
+	mentors := app.initMentorService(mentorStorage)
 	broker := app.initBroker(cfg)
 
 	wrappedService := app.initWrappedSessionService(cfg, service, broker)
 
-	server := app.initPublicPort(cfg, wrappedService, authClient, accessor)
+	server := app.initPublicPort(cfg, wrappedService, questionBank, attempts, reports,
+		itemAnalysis, mentors, authClient, accessor)
 	app.publicServer = server
+	app.expirySweeper = app.initExpirySweeper(cfg, storage, broker)
+	app.itemAnalysis = app.initItemAnalysisJob(cfg, itemAnalysis, itemAnalysisStorage)
+	app.backfill = app.initSnapshotBackfill(storage)
+	app.outcomes = app.initOutcomeBackfill(storage)
+	app.rescore = app.initRescoreJob(cfg, questionBankStorage)
 
 	app.startWithGracefulShutdown()
 }
@@ -124,11 +141,18 @@ func (app *App) initBroker(cfg *config.Config) cases.MessageBroker {
 	return broker
 }
 
-func (app *App) initStorage(cfg *config.Config) (cases.Storage, entities.SessionStorage) {
+func (app *App) initStorage(cfg *config.Config) (cases.Storage, cases.AttemptStorage,
+	cases.QuestionBankStorage, cases.ReportStorage, cases.ItemAnalysisStorage,
+	cases.MentorStorage, accessor.Relations) {
 	slog.Info("init storage started")
 
 	var storage cases.Storage
-	var sessionStorage entities.SessionStorage
+	var attemptStorage cases.AttemptStorage
+	var questionBankStorage cases.QuestionBankStorage
+	var reportStorage cases.ReportStorage
+	var itemAnalysisStorage cases.ItemAnalysisStorage
+	var mentorStorage cases.MentorStorage
+	var relations accessor.Relations
 
 	storageType := cfg.GetServiceStorageType()
 	connStr := cfg.GetStorageConnStr(storageType)
@@ -139,20 +163,26 @@ func (app *App) initStorage(cfg *config.Config) (cases.Storage, entities.Session
 			app.panic(err)
 		}
 		storage = s
-		sessionStorage = s
+		attemptStorage = s
+		questionBankStorage = s
+		reportStorage = s
+		itemAnalysisStorage = s
+		mentorStorage = s
+		relations = s
 	default:
 		err := errors.Wrap(entities.ErrInvalidParam, "invalid storage type")
 		app.panic(err)
 	}
 
-	return storage, sessionStorage
+	return storage, attemptStorage, questionBankStorage, reportStorage, itemAnalysisStorage,
+		mentorStorage, relations
 }
 
-func (app *App) initAccessor(_ *config.Config) public.Accessor {
+func (app *App) initAccessor(_ *config.Config, relations accessor.Relations) public.Accessor {
 	slog.Info("initAccessor started")
 	var acessor public.Accessor
 
-	a, err := accessor.NewRightAccessor()
+	a, err := accessor.NewRightAccessor(accessor.WithRelations(relations))
 	if err != nil {
 		err := errors.Wrap(err, "new right accessor failure")
 		app.panic(err)
@@ -184,14 +214,48 @@ func (app *App) initNatsPub(cfg *config.Config) *publisher.Publisher {
 	return pub
 }
 
-func (app *App) initSessionServiceBase(storage cases.Storage,
-	sessionStorage entities.SessionStorage,
+func (app *App) initSessionServiceBase(cfg *config.Config, storage cases.Storage,
+	attempts cases.AttemptService,
 	generator entities.IDGenerator) cases.SessionService {
 	slog.Info("init session_service started")
 
 	var sessionService cases.SessionService
 
-	serv, err := cases.NewSessionServiceBase(storage, sessionStorage, generator)
+	scoring, err := entities.NewScoringStrategy(cfg.GetScoringStrategy(),
+		cfg.GetNegativeMarkingPenalty())
+	if err != nil {
+		err := errors.Wrap(err, "NewScoringStrategy")
+		app.panic(err)
+	}
+
+	resolution := cfg.GetPassThresholdResolution()
+	if err := entities.ValidateThresholdResolution(resolution); err != nil {
+		err := errors.Wrap(err, "ValidateThresholdResolution")
+		app.panic(err)
+	}
+
+	visibility, err := entities.NewReviewVisibility(cfg.GetReviewVisibility())
+	if err != nil {
+		err := errors.Wrap(err, "NewReviewVisibility")
+		app.panic(err)
+	}
+
+	easy, medium, hard := cfg.GetDifficultyMix()
+	mix := entities.DifficultyMix{Easy: easy, Medium: medium, Hard: hard}
+	if err := mix.Validate(); err != nil {
+		err := errors.Wrap(err, "difficulty mix Validate")
+		app.panic(err)
+	}
+
+	opts := []cases.SessionServiceOption{
+		cases.WithScoringStrategy(scoring), cases.WithPassThresholdResolution(resolution),
+		cases.WithReviewVisibility(visibility),
+		cases.WithQuestionsPerTopic(cfg.GetQuestionsPerTopic()), cases.WithDifficultyMix(mix),
+		cases.WithShuffle(cfg.GetShuffleVariants(), cfg.GetShuffleQuestions()),
+	}
+	opts = append(opts, app.repetitionOptions(cfg)...)
+
+	serv, err := cases.NewSessionServiceBase(storage, attempts, generator, opts...)
 	if err != nil {
 		err := errors.Wrap(err, "NewSessionServiceBase")
 		app.panic(err)
@@ -202,6 +266,122 @@ func (app *App) initSessionServiceBase(storage cases.Storage,
 	return sessionService
 }
 
+func (app *App) repetitionOptions(cfg *config.Config) []cases.SessionServiceOption {
+	opts := []cases.SessionServiceOption{
+		cases.WithReviewSessionSize(cfg.GetReviewSessionSize()),
+	}
+
+	intervals, err := cfg.GetLeitnerIntervals()
+	if err != nil {
+		err := errors.Wrap(err, "GetLeitnerIntervals")
+		app.panic(err)
+	}
+
+	// empty intervals keep the default schedule
+	if len(intervals) > 0 {
+		opts = append(opts, cases.WithLeitnerSchedule(entities.LeitnerSchedule{
+			Intervals: intervals,
+		}))
+	}
+
+	return opts
+}
+
+func (app *App) initAttemptService(cfg *config.Config,
+	storage cases.AttemptStorage) cases.AttemptService {
+	slog.Info("init attempt_service started")
+
+	var attemptService cases.AttemptService
+
+	policy := entities.AttemptPolicy{
+		MaxAttempts:        cfg.GetMaxAttempts(),
+		Window:             cfg.GetAttemptsWindow(),
+		Cooldown:           cfg.GetAttemptsCooldown(),
+		UnlimitedAfterPass: cfg.GetUnlimitedAttemptsAfterPass(),
+
+		TimezoneChangeInterval: cfg.GetTimezoneChangeInterval(),
+	}
+
+	serv, err := cases.NewAttemptServiceBase(storage, cases.WithAttemptPolicy(policy))
+	if err != nil {
+		err := errors.Wrap(err, "NewAttemptServiceBase")
+		app.panic(err)
+	}
+
+	attemptService = serv
+
+	return attemptService
+}
+
+func (app *App) initReportService(cfg *config.Config,
+	storage cases.ReportStorage) cases.ReportService {
+	slog.Info("init report_service started")
+
+	var reportService cases.ReportService
+
+	serv, err := cases.NewReportServiceBase(storage,
+		cases.WithFailedQuestionsLimit(cfg.GetReportFailedQuestionsLimit()),
+		cases.WithTrendDepth(cfg.GetReportTrendDepth()))
+	if err != nil {
+		err := errors.Wrap(err, "NewReportServiceBase")
+		app.panic(err)
+	}
+
+	reportService = serv
+
+	return reportService
+}
+
+func (app *App) initItemAnalysisService(
+	storage cases.ItemAnalysisStorage) cases.ItemAnalysisService {
+	slog.Info("init item_analysis_service started")
+
+	var itemAnalysisService cases.ItemAnalysisService
+
+	serv, err := cases.NewItemAnalysisServiceBase(storage)
+	if err != nil {
+		err := errors.Wrap(err, "NewItemAnalysisServiceBase")
+		app.panic(err)
+	}
+
+	itemAnalysisService = serv
+
+	return itemAnalysisService
+}
+
+func (app *App) initMentorService(storage cases.MentorStorage) cases.MentorService {
+	slog.Info("init mentor_service started")
+
+	var mentorService cases.MentorService
+
+	serv, err := cases.NewMentorServiceBase(storage)
+	if err != nil {
+		err := errors.Wrap(err, "NewMentorServiceBase")
+		app.panic(err)
+	}
+
+	mentorService = serv
+
+	return mentorService
+}
+
+func (app *App) initQuestionBankService(
+	storage cases.QuestionBankStorage) cases.QuestionBankService {
+	slog.Info("init question_bank_service started")
+
+	var questionBankService cases.QuestionBankService
+
+	serv, err := cases.NewQuestionBankServiceBase(storage)
+	if err != nil {
+		err := errors.Wrap(err, "NewQuestionBankServiceBase")
+		app.panic(err)
+	}
+
+	questionBankService = serv
+
+	return questionBankService
+}
+
 func (app *App) initWrappedSessionService(cfg *config.Config, service cases.SessionService,
 	broker cases.MessageBroker) cases.SessionService {
 	slog.Info("init wrapped_session_service started")
@@ -219,6 +399,75 @@ func (app *App) initWrappedSessionService(cfg *config.Config, service cases.Sess
 	return wrappedService
 }
 
+func (app *App) initExpirySweeper(cfg *config.Config, storage cases.Storage,
+	broker cases.MessageBroker) *cases.ExpirySweeper {
+	slog.Info("init expiry_sweeper started")
+
+	sweeper, err := cases.NewExpirySweeper(storage, broker,
+		cases.WithSweepInterval(cfg.GetExpirySweepInterval()),
+		cases.WithSweepBatchSize(cfg.GetExpirySweepBatchSize()),
+		cases.WithSweepEventTimeout(cfg.GetEventTimeout()))
+	if err != nil {
+		err := errors.Wrap(err, "NewExpirySweeper")
+		app.panic(err)
+	}
+
+	return sweeper
+}
+
+func (app *App) initItemAnalysisJob(cfg *config.Config, service cases.ItemAnalysisService,
+	storage cases.ItemAnalysisStorage) *cases.ItemAnalysisJob {
+	slog.Info("init item_analysis_job started")
+
+	job, err := cases.NewItemAnalysisJob(service, storage,
+		cases.WithItemAnalysisInterval(cfg.GetItemAnalysisInterval()))
+	if err != nil {
+		err := errors.Wrap(err, "NewItemAnalysisJob")
+		app.panic(err)
+	}
+
+	return job
+}
+
+func (app *App) initSnapshotBackfill(storage cases.Storage) *cases.SnapshotBackfill {
+	slog.Info("init snapshot_backfill started")
+
+	backfill, err := cases.NewSnapshotBackfill(storage)
+	if err != nil {
+		err := errors.Wrap(err, "NewSnapshotBackfill")
+		app.panic(err)
+	}
+
+	return backfill
+}
+
+func (app *App) initOutcomeBackfill(storage cases.Storage) *cases.OutcomeBackfill {
+	slog.Info("init outcome_backfill started")
+
+	backfill, err := cases.NewOutcomeBackfill(storage)
+	if err != nil {
+		err := errors.Wrap(err, "NewOutcomeBackfill")
+		app.panic(err)
+	}
+
+	return backfill
+}
+
+func (app *App) initRescoreJob(cfg *config.Config,
+	storage cases.QuestionBankStorage) *cases.RescoreJob {
+	slog.Info("init rescore_job started")
+
+	job, err := cases.NewRescoreJob(storage,
+		cases.WithRescoreInterval(cfg.GetRescoreInterval()),
+		cases.WithRescoreBatchSize(cfg.GetRescoreBatchSize()))
+	if err != nil {
+		err := errors.Wrap(err, "NewRescoreJob")
+		app.panic(err)
+	}
+
+	return job
+}
+
 func (app *App) initAuthServiceClient(cfg *config.Config) public.Introspector {
 	slog.Info("init auth service client started")
 
@@ -242,7 +491,10 @@ func (app *App) initAuthServiceClient(cfg *config.Config) public.Introspector {
 }
 
 func (app *App) initPublicPort(cfg *config.Config, sessionServiceBase cases.SessionService,
-	authClient public.Introspector, accessor public.Accessor) *public.Server {
+	questionBank cases.QuestionBankService, attempts cases.AttemptService,
+	reports cases.ReportService, itemAnalysis cases.ItemAnalysisService,
+	mentors cases.MentorService, authClient public.Introspector,
+	accessor public.Accessor) *public.Server {
 	slog.Info("init public port started")
 
 	port := cfg.GetPublicPort()
@@ -250,6 +502,11 @@ func (app *App) initPublicPort(cfg *config.Config, sessionServiceBase cases.Sess
 
 	server, err := public.New(
 		public.WithService(sessionServiceBase),
+		public.WithQuestionBankService(questionBank),
+		public.WithAttemptService(attempts),
+		public.WithReportService(reports),
+		public.WithItemAnalysisService(itemAnalysis),
+		public.WithMentorService(mentors),
 		public.WithIntrospector(authClient),
 		public.WithConfig(&public.ServerCfg{
 			Port:    port,
@@ -278,6 +535,36 @@ func (app *App) startWithGracefulShutdown() {
 		app.publicServer.Start()
 	}()
 
+	app.wg.Add(1)
+	go func() {
+		defer app.wg.Done()
+		app.expirySweeper.Run(ctx)
+	}()
+
+	app.wg.Add(1)
+	go func() {
+		defer app.wg.Done()
+		app.itemAnalysis.Run(ctx)
+	}()
+
+	app.wg.Add(1)
+	go func() {
+		defer app.wg.Done()
+		app.backfill.Run(ctx)
+	}()
+
+	app.wg.Add(1)
+	go func() {
+		defer app.wg.Done()
+		app.outcomes.Run(ctx)
+	}()
+
+	app.wg.Add(1)
+	go func() {
+		defer app.wg.Done()
+		app.rescore.Run(ctx)
+	}()
+
 	select {
 	case sig := <-sigOSChan:
 		slog.Info("Received os shutdown signal", "signal", sig.String())
@@ -300,6 +587,11 @@ func (app *App) shutdown() {
 		app.publicServer.Stop()
 	}
 
+	if app.cancel != nil {
+		slog.Info("Stopping background jobs...")
+		app.cancel()
+	}
+
 	done := make(chan struct{})
 	go func() {
 		app.wg.Wait()
@@ -0,0 +1,18 @@
+package dto
+
+import "time"
+
+// AttemptGrantDTO represents extra attempts of the topic given to the student by a mentor
+// swagger:model AttemptGrant
+type AttemptGrantDTO struct {
+	Topic     string     `json:"topic" example:"Базы данных"`
+	Attempts  int        `json:"attempts" example:"1"`
+	GrantedBy string     `json:"granted_by,omitempty" example:"2"`
+	GrantedAt *time.Time `json:"granted_at,omitempty" example:"2025-08-28T10:00:00Z"`
+}
+
+// TimezoneDTO represents timezone the day boundaries of the user are counted in
+// swagger:model Timezone
+type TimezoneDTO struct {
+	Timezone string `json:"timezone" example:"Europe/Moscow"`
+}
@@ -1,13 +1,18 @@
 package dto
 
 type PayloadDTO struct {
-	UserID      string              `json:"user_id"`
-	Topics      []string            `json:"topics"`
-	Questions   map[string][]string `json:"questions"`
-	UserAnswers map[string][]string `json:"user_answers"`
-	IsExpire    bool                `json:"is_expire"`
-	IsSuccess   bool                `json:"is_success"`
-	Grade       string              `json:"grade"`
+	UserID       string              `json:"user_id"`
+	Topics       []string            `json:"topics"`
+	Questions    map[string][]string `json:"questions"`
+	UserAnswers  map[string][]string `json:"user_answers"`
+	IsExpire     bool                `json:"is_expire"`
+	IsSuccess    bool                `json:"is_success"`
+	Grade        string              `json:"grade"`
+	Score        float64             `json:"score"`
+	CorrectCount int                 `json:"correct_count"`
+	TotalCount   int                 `json:"total_count"`
+	Outcome      string              `json:"outcome"`
+	TopicScores  []TopicScoreDTO     `json:"topic_scores"`
 }
 
 type EventDTO struct {
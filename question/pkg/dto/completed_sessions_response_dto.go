@@ -5,15 +5,47 @@ import "time"
 // CompletedSessionResponseDTO represents completed session
 // swagger:model CompletedSessionResponseDTO
 type CompletedSessionResponseDTO struct {
+	SessionID     string             `json:"session_id" example:"5577006791947779410"`
 	StartedAt     time.Time          `json:"started_at"`
+	CompletedAt   time.Time          `json:"completed_at"`
+	Mode          string             `json:"mode" example:"exam" enums:"exam,practice"`
 	Topics        []string           `json:"topics"`
 	UserAnswers   UserAnswersListDTO `json:"user_answers"`
+	Results       []ResultItemDTO    `json:"results"`
 	IsExpired     bool               `json:"is_expired"`
 	SessionResult SessionResultDTO   `json:"session_result"`
 }
 
-// CompletedSessionsResponseListDTO represents list of completed sessions
+// ResultItemDTO represents graded answer to one question of the completed session
+// swagger:model ResultItemDTO
+type ResultItemDTO struct {
+	QuestionID   string   `json:"question_id" example:"1234"`
+	Version      int      `json:"version" example:"2"`
+	QuestionType string   `json:"question_type" example:"single selection"`
+	Topic        string   `json:"topic" example:"Базы данных"`
+	Subject      string   `json:"subject" example:"Что такое индекс?"`
+	Weight       float64  `json:"weight" example:"1"`
+	Answered     bool     `json:"answered" example:"true"`
+	Selections   []string `json:"selections"`
+	IsCorrect    bool     `json:"is_correct" example:"true"`
+	Credit       float64  `json:"credit" example:"1"`
+}
+
+// HistoryTotalsDTO represents counts of all completed sessions matching the filter
+// swagger:model HistoryTotalsDTO
+type HistoryTotalsDTO struct {
+	Total   int `json:"total" example:"42"`
+	Passed  int `json:"passed" example:"30"`
+	Failed  int `json:"failed" example:"10"`
+	Expired int `json:"expired" example:"2"`
+}
+
+// CompletedSessionsResponseListDTO represents page of completed sessions
 // swagger:model CompletedSessionsResponseListDTO
 type CompletedSessionsResponseListDTO struct {
 	CompletedSessions []CompletedSessionResponseDTO `json:"completed_sessions"`
+	Totals            HistoryTotalsDTO              `json:"totals"`
+	Limit             int                           `json:"limit" example:"20"`
+	// NextCursor is passed as cursor to get the next page, it is omitted on the last page.
+	NextCursor string `json:"next_cursor,omitempty"`
 }
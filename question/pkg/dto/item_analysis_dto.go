@@ -0,0 +1,36 @@
+package dto
+
+import "time"
+
+// DistractorStatsDTO represents selections of one variant of the question
+// swagger:model DistractorStats
+type DistractorStatsDTO struct {
+	Variant    string  `json:"variant" example:"Отменяет транзакцию"`
+	IsCorrect  bool    `json:"is_correct" example:"false"`
+	Selections int     `json:"selections" example:"4"`
+	Share      float64 `json:"share" example:"20"`
+}
+
+// ItemStatisticsDTO represents item analysis of the question computed from exam sessions
+// swagger:model ItemStatistics
+type ItemStatisticsDTO struct {
+	QuestionID     string               `json:"question_id" example:"112441"`
+	Topic          string               `json:"topic" example:"Базы данных"`
+	Subject        string               `json:"subject" example:"Что делает команда COMMIT?"`
+	QuestionType   string               `json:"question_type" example:"single selection"`
+	Responses      int                  `json:"responses" example:"20"`
+	Correct        int                  `json:"correct" example:"15"`
+	Omitted        int                  `json:"omitted" example:"1"`
+	Difficulty     float64              `json:"difficulty" example:"75"`
+	Discrimination float64              `json:"discrimination" example:"0.4"`
+	Distractors    []DistractorStatsDTO `json:"distractors"`
+	Flags          []string             `json:"flags" example:"too_easy"`
+	ComputedAt     time.Time            `json:"computed_at" example:"2025-08-28T03:00:00Z"`
+}
+
+// ItemStatisticsListDTO represents item analysis of questions of the topic
+// swagger:model ItemStatisticsList
+type ItemStatisticsListDTO struct {
+	TopicID string              `json:"topic_id" example:"1"`
+	Items   []ItemStatisticsDTO `json:"items"`
+}
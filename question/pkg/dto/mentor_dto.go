@@ -0,0 +1,8 @@
+package dto
+
+// MentorStudentsDTO represents students linked to the mentor
+// swagger:model MentorStudents
+type MentorStudentsDTO struct {
+	MentorID string   `json:"mentor_id" example:"5"`
+	Students []string `json:"students" example:"1,2"`
+}
@@ -0,0 +1,59 @@
+package dto
+
+import "time"
+
+// TopicDTO represents topic of the question bank
+// swagger:model TopicDTO
+type TopicDTO struct {
+	ID                string   `json:"topic_id,omitempty" example:"1"`
+	Name              string   `json:"name" example:"Базы данных"`
+	PassThreshold     *float64 `json:"pass_threshold,omitempty" example:"60"`
+	MaxPauses         *int     `json:"max_pauses,omitempty" example:"2"`
+	SelectionStrategy string   `json:"selection_strategy,omitempty" example:"mixed" enums:"uniform,mixed,adaptive"`
+}
+
+// QuestionContentDTO represents question data for creating or updating question
+// swagger:model QuestionContentDTO
+type QuestionContentDTO struct {
+	QuestionType   string              `json:"question_type" example:"single selection"`
+	Subject        string              `json:"subject" example:"Что делает команда COMMIT?"`
+	Variants       []string            `json:"variants" example:"Отменяет транзакцию,Сохраняет изменения транзакции"`
+	CorrectAnswers []string            `json:"correct_answers" example:"Сохраняет изменения транзакции"`
+	Options        *QuestionOptionsDTO `json:"options,omitempty"`
+	Weight         float64             `json:"weight,omitempty" example:"1"`
+	Explanation    string              `json:"explanation,omitempty" example:"COMMIT фиксирует изменения транзакции"`
+	Difficulty     string              `json:"difficulty,omitempty" example:"medium" enums:"easy,medium,hard"`
+}
+
+// ManagedQuestionDTO represents question of the question bank including correct answers
+// swagger:model ManagedQuestionDTO
+type ManagedQuestionDTO struct {
+	QuestionDTO
+	CorrectAnswers []string            `json:"correct_answers" example:"Сохраняет изменения транзакции"`
+	Options        *QuestionOptionsDTO `json:"options,omitempty"`
+	Weight         float64             `json:"weight" example:"1"`
+	Explanation    string              `json:"explanation,omitempty" example:"COMMIT фиксирует изменения транзакции"`
+	Difficulty     string              `json:"difficulty" example:"medium"`
+	Version        int                 `json:"version" example:"2"`
+}
+
+// ManagedQuestionsListDTO represents list of questions of the question bank
+// swagger:model ManagedQuestionsListDTO
+type ManagedQuestionsListDTO struct {
+	Questions []ManagedQuestionDTO `json:"questions"`
+}
+
+// RescoreTaskDTO represents progress of grading completed sessions again with the version of the
+// question
+// swagger:model RescoreTaskDTO
+type RescoreTaskDTO struct {
+	QuestionID string    `json:"question_id" example:"1"`
+	Version    int       `json:"version" example:"2"`
+	Status     string    `json:"status" example:"running" enums:"pending,running,completed,failed"`
+	Rescored   int       `json:"rescored_sessions" example:"14"`
+	Failed     int       `json:"failed_sessions" example:"0"`
+	Attempts   int       `json:"attempts" example:"1"`
+	Error      string    `json:"error,omitempty" example:""`
+	CreatedAt  time.Time `json:"created_at" example:"2025-08-28T10:00:00Z"`
+	UpdatedAt  time.Time `json:"updated_at" example:"2025-08-28T10:00:05Z"`
+}
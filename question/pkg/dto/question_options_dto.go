@@ -0,0 +1,25 @@
+package dto
+
+// AnswerNormalizationDTO represents rules of comparing free-text answer with the accepted ones
+// swagger:model AnswerNormalizationDTO
+type AnswerNormalizationDTO struct {
+	FoldCase       bool   `json:"fold_case" example:"true"`
+	TrimSpace      bool   `json:"trim_space" example:"true"`
+	FoldLookalikes bool   `json:"fold_lookalikes" example:"false"`
+	Pattern        string `json:"pattern,omitempty" example:"u?int(8|16|32|64)"`
+}
+
+// QuestionOptionsDTO represents type specific settings of the question
+// swagger:model QuestionOptionsDTO
+type QuestionOptionsDTO struct {
+	Normalization *AnswerNormalizationDTO `json:"normalization,omitempty"`
+	PartialCredit bool                    `json:"partial_credit,omitempty" example:"true"`
+	Tolerance     *ToleranceDTO           `json:"tolerance,omitempty"`
+}
+
+// ToleranceDTO represents acceptable deviation of numeric answer
+// swagger:model ToleranceDTO
+type ToleranceDTO struct {
+	Absolute float64 `json:"absolute,omitempty" example:"0.5"`
+	Relative float64 `json:"relative,omitempty" example:"0.01"`
+}
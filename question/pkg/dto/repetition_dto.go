@@ -0,0 +1,25 @@
+package dto
+
+import "time"
+
+// StartReviewSessionDTO represents request for a review session. Empty topics mean all topics
+// with questions due for repetition.
+// swagger:model StartReviewSession
+type StartReviewSessionDTO struct {
+	Topics []string `json:"topics,omitempty" example:"Базы данных"`
+}
+
+// DueReviewsDTO represents questions of the topic due for repetition
+// swagger:model DueReviews
+type DueReviewsDTO struct {
+	Topic     string     `json:"topic" example:"Базы данных"`
+	Due       int        `json:"due" example:"4"`
+	Failed    int        `json:"failed" example:"3"`
+	NextDueAt *time.Time `json:"next_due_at,omitempty" example:"2025-08-30T10:00:00Z"`
+}
+
+// DueReviewsListDTO represents questions due for repetition by topic
+// swagger:model DueReviewsList
+type DueReviewsListDTO struct {
+	Topics []DueReviewsDTO `json:"topics"`
+}
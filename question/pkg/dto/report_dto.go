@@ -0,0 +1,66 @@
+package dto
+
+import "time"
+
+// SessionStatsDTO represents completed sessions of the user by outcome. Durations are counted
+// for sessions which have not expired.
+// swagger:model SessionStats
+type SessionStatsDTO struct {
+	Total                  int     `json:"total" example:"12"`
+	Exams                  int     `json:"exams" example:"8"`
+	Passed                 int     `json:"passed" example:"5"`
+	Failed                 int     `json:"failed" example:"5"`
+	Expired                int     `json:"expired" example:"2"`
+	AverageDurationSeconds float64 `json:"average_duration_seconds" example:"412.5"`
+	MedianDurationSeconds  float64 `json:"median_duration_seconds" example:"390"`
+	MinDurationSeconds     float64 `json:"min_duration_seconds" example:"120"`
+	MaxDurationSeconds     float64 `json:"max_duration_seconds" example:"600"`
+}
+
+// AccuracyPeriodDTO represents answers to questions of the topic during the week
+// swagger:model AccuracyPeriod
+type AccuracyPeriodDTO struct {
+	PeriodStart time.Time `json:"period_start" example:"2025-08-25T00:00:00Z"`
+	Correct     int       `json:"correct" example:"7"`
+	Total       int       `json:"total" example:"10"`
+	Accuracy    float64   `json:"accuracy" example:"70"`
+}
+
+// TopicAccuracyDTO represents answers to questions of the topic
+// swagger:model TopicAccuracy
+type TopicAccuracyDTO struct {
+	Topic    string              `json:"topic" example:"Базы данных"`
+	Correct  int                 `json:"correct" example:"15"`
+	Total    int                 `json:"total" example:"20"`
+	Accuracy float64             `json:"accuracy" example:"75"`
+	Periods  []AccuracyPeriodDTO `json:"periods"`
+}
+
+// FailedQuestionDTO represents question the user answered wrong
+// swagger:model FailedQuestion
+type FailedQuestionDTO struct {
+	QuestionID string `json:"question_id" example:"112441"`
+	Topic      string `json:"topic" example:"Базы данных"`
+	Subject    string `json:"subject" example:"Что делает команда COMMIT?"`
+	Failures   int    `json:"failures" example:"3"`
+	Answers    int    `json:"answers" example:"4"`
+}
+
+// ScoreTrendDTO represents direction of scores of the recent exam sessions
+// swagger:model ScoreTrend
+type ScoreTrendDTO struct {
+	Direction string  `json:"direction" example:"improving" enums:"improving,declining,stable,insufficient_data"`
+	Sessions  int     `json:"sessions" example:"6"`
+	Slope     float64 `json:"slope" example:"2.5"`
+}
+
+// KnowledgeReportDTO represents knowledge report of the user
+// swagger:model KnowledgeReport
+type KnowledgeReportDTO struct {
+	UserID          string              `json:"user_id" example:"1"`
+	GeneratedAt     time.Time           `json:"generated_at"`
+	Sessions        SessionStatsDTO     `json:"sessions"`
+	Topics          []TopicAccuracyDTO  `json:"topics"`
+	FailedQuestions []FailedQuestionDTO `json:"failed_questions"`
+	Trend           ScoreTrendDTO       `json:"trend"`
+}
@@ -1,26 +1,126 @@
 package dto
 
+import "time"
+
 // QuestionDTO represents question
 // swagger:model Question
 type QuestionDTO struct {
-	ID           string   `json:"question_id" example:"112441"`
-	QuestionType string   `json:"question_type" example:"1"`
-	Topic        string   `json:"topic" example:"Базы данных"`
-	Subject      string   `json:"subject" example:"К какой категории языков относится SQL?"`
-	Variants     []string `json:"variants" example:"Императивный,Декларативный,Смешной,Противный"`
+	ID           string       `json:"question_id" example:"112441"`
+	QuestionType string       `json:"question_type" example:"1"`
+	Topic        string       `json:"topic" example:"Базы данных"`
+	Subject      string       `json:"subject" example:"К какой категории языков относится SQL?"`
+	Variants     []string     `json:"variants,omitempty" example:"Императивный,Декларативный,Смешной,Противный"`
+	FreeInput    bool         `json:"free_input" example:"false"`
+	Ordered      bool         `json:"ordered" example:"false"`
+	Matching     *MatchingDTO `json:"matching,omitempty"`
+}
+
+// MatchingDTO represents columns of matching question. Every left item must be paired with
+// one of the right items.
+// swagger:model MatchingDTO
+type MatchingDTO struct {
+	LeftItems  []string `json:"left_items" example:"int,*int"`
+	RightItems []string `json:"right_items" example:"0,nil,false"`
 }
 
 // SessionDTO represents session
 // swagger:model Session
 type SessionDTO struct {
 	SessionID string        `json:"session_id" example:"12312"`
+	Mode      string        `json:"mode" example:"exam" enums:"exam,practice"`
 	Topics    []string      `json:"topics" example:"Базы данных,Базовые типы в Go"`
 	Questions []QuestionDTO `json:"questions"`
 }
 
+// StartSessionDTO represents request for a new session. Empty mode means exam.
+// swagger:model StartSession
+type StartSessionDTO struct {
+	Topics []string `json:"topics" example:"Базы данных,Go базовые типы"`
+	Mode   string   `json:"mode,omitempty" example:"practice" enums:"exam,practice"`
+}
+
+// AnswerFeedbackDTO represents result of checking one answer of the practice session
+// swagger:model AnswerFeedback
+type AnswerFeedbackDTO struct {
+	QuestionID     string   `json:"question_id" example:"112441"`
+	IsCorrect      bool     `json:"is_correct" example:"false"`
+	Credit         float64  `json:"credit" example:"0.5"`
+	CorrectAnswers []string `json:"correct_answers" example:"Декларативный"`
+}
+
+// ReviewItemDTO represents answer to one question of the completed session with the correct one
+// swagger:model ReviewItem
+type ReviewItemDTO struct {
+	QuestionID     string   `json:"question_id" example:"112441"`
+	Topic          string   `json:"topic" example:"Базы данных"`
+	Subject        string   `json:"subject" example:"Что делает команда COMMIT?"`
+	Selections     []string `json:"selections" example:"Отменяет транзакцию"`
+	CorrectAnswers []string `json:"correct_answers" example:"Сохраняет изменения транзакции"`
+	IsCorrect      bool     `json:"is_correct" example:"false"`
+	Credit         float64  `json:"credit" example:"0"`
+	Explanation    string   `json:"explanation,omitempty" example:"COMMIT фиксирует изменения транзакции"`
+}
+
+// SessionReviewDTO represents review of the completed session
+// swagger:model SessionReview
+type SessionReviewDTO struct {
+	SessionID string          `json:"session_id" example:"13102"`
+	UserID    string          `json:"user_id" example:"1"`
+	Mode      string          `json:"mode" example:"exam" enums:"exam,practice"`
+	Items     []ReviewItemDTO `json:"items"`
+}
+
+// SessionInfoDTO represents current state of the session. Duration, remaining time and pauses
+// left are set for active and paused exam sessions, deadline is set for active exam session only.
+// swagger:model SessionInfo
+type SessionInfoDTO struct {
+	SessionID            string        `json:"session_id" example:"12312"`
+	UserID               string        `json:"user_id" example:"3"`
+	Mode                 string        `json:"mode" example:"exam" enums:"exam,practice"`
+	Status               string        `json:"status" example:"active" enums:"init,active,paused,completed"`
+	Topics               []string      `json:"topics" example:"Базы данных,Базовые типы в Go"`
+	Questions            []QuestionDTO `json:"questions"`
+	StartedAt            *time.Time    `json:"started_at,omitempty"`
+	DurationLimitSeconds *int64        `json:"duration_limit_seconds,omitempty" example:"600"`
+	Deadline             *time.Time    `json:"deadline,omitempty"`
+	RemainingSeconds     *int64        `json:"remaining_seconds,omitempty" example:"420"`
+	PausesLeft           *int          `json:"pauses_left,omitempty" example:"1"`
+	IsExpired            *bool         `json:"is_expired,omitempty" example:"false"`
+	ServerTime           time.Time     `json:"server_time"`
+}
+
+// ResumedSessionDTO represents active session with saved draft answers. Deadline and remaining
+// time are not set for practice session.
+// swagger:model ResumedSession
+type ResumedSessionDTO struct {
+	SessionID        string          `json:"session_id" example:"12312"`
+	Mode             string          `json:"mode" example:"exam" enums:"exam,practice"`
+	Topics           []string        `json:"topics" example:"Базы данных,Базовые типы в Go"`
+	Questions        []QuestionDTO   `json:"questions"`
+	Drafts           []UserAnswerDTO `json:"drafts"`
+	Deadline         *time.Time      `json:"deadline,omitempty"`
+	RemainingSeconds *int64          `json:"remaining_seconds,omitempty" example:"420"`
+}
+
+// TopicScoreDTO represents session result of one topic
+// swagger:model TopicScoreDTO
+type TopicScoreDTO struct {
+	Topic        string  `json:"topic" example:"Базы данных"`
+	Score        float64 `json:"score" example:"90"`
+	CorrectCount int     `json:"correct_count" example:"9"`
+	TotalCount   int     `json:"total_count" example:"10"`
+}
+
 // SessionResultDTO represents session result
 // swagger:model SessionResult
 type SessionResultDTO struct {
-	IsSuccess bool   `json:"is_success" example:"true"`
-	Grade     string `json:"grade" example:"75.00 percents"`
+	IsSuccess    bool            `json:"is_success" example:"true"`
+	Grade        string          `json:"grade" example:"75.00 percents"`
+	Score        float64         `json:"score" example:"75"`
+	CorrectCount int             `json:"correct_count" example:"3"`
+	TotalCount   int             `json:"total_count" example:"4"`
+	Outcome      string          `json:"outcome" example:"passed" enums:"passed,failed,expired"`
+	TopicScores  []TopicScoreDTO `json:"topic_scores"`
+	// PassThreshold is the score the session had to reach to be passed.
+	PassThreshold float64 `json:"pass_threshold" example:"60"`
 }
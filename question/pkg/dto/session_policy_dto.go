@@ -0,0 +1,46 @@
+package dto
+
+// ScoringDTO represents scoring strategy of the session
+// swagger:model ScoringDTO
+type ScoringDTO struct {
+	Strategy string   `json:"strategy" example:"all or nothing"`
+	Penalty  *float64 `json:"penalty,omitempty" example:"0.25"`
+}
+
+// PassThresholdDTO represents pass thresholds of the session topics
+// swagger:model PassThresholdDTO
+type PassThresholdDTO struct {
+	Resolution string             `json:"resolution" example:"strictest"`
+	Topics     map[string]float64 `json:"topics,omitempty"`
+}
+
+// PausePolicyDTO represents how many times the session may be paused
+// swagger:model PausePolicyDTO
+type PausePolicyDTO struct {
+	MaxPauses int `json:"max_pauses" example:"2"`
+}
+
+// ReviewPolicyDTO represents when the student may see review of the completed session
+// swagger:model ReviewPolicyDTO
+type ReviewPolicyDTO struct {
+	Visibility string `json:"visibility,omitempty" example:"immediately"`
+}
+
+// ShufflePolicyDTO represents whether the session shows variants and questions in a random
+// order and the seed of the order
+// swagger:model ShufflePolicyDTO
+type ShufflePolicyDTO struct {
+	Variants  bool   `json:"variants" example:"true"`
+	Questions bool   `json:"questions" example:"false"`
+	Seed      uint64 `json:"seed,string" example:"8424717542190781541"`
+}
+
+// SessionPolicyDTO represents rules the session is graded with
+// swagger:model SessionPolicyDTO
+type SessionPolicyDTO struct {
+	Scoring       ScoringDTO        `json:"scoring"`
+	PassThreshold PassThresholdDTO  `json:"pass_threshold"`
+	Pause         PausePolicyDTO    `json:"pause"`
+	Review        ReviewPolicyDTO   `json:"review"`
+	Shuffle       *ShufflePolicyDTO `json:"shuffle,omitempty"`
+}
@@ -0,0 +1,37 @@
+package dto
+
+import "github.com/parta4ok/kvs/question/internal/entities"
+
+// NewTopicScoresDTO maps per-topic scores of a session result to their transport form.
+func NewTopicScoresDTO(scores []entities.TopicScore) []TopicScoreDTO {
+	scoresDTO := make([]TopicScoreDTO, 0, len(scores))
+	for _, score := range scores {
+		scoresDTO = append(scoresDTO, TopicScoreDTO{
+			Topic:        score.Topic,
+			Score:        score.Score,
+			CorrectCount: score.CorrectCount,
+			TotalCount:   score.TotalCount,
+		})
+	}
+
+	return scoresDTO
+}
+
+// ToTopicScores restores per-topic scores of a session result.
+func ToTopicScores(scoresDTO []TopicScoreDTO) []entities.TopicScore {
+	if len(scoresDTO) == 0 {
+		return nil
+	}
+
+	scores := make([]entities.TopicScore, 0, len(scoresDTO))
+	for _, scoreDTO := range scoresDTO {
+		scores = append(scores, entities.TopicScore{
+			Topic:        scoreDTO.Topic,
+			Score:        scoreDTO.Score,
+			CorrectCount: scoreDTO.CorrectCount,
+			TotalCount:   scoreDTO.TotalCount,
+		})
+	}
+
+	return scores
+}
@@ -3,9 +3,17 @@ package dto
 // UserAnswerDTO represents user answer
 // swagger:model UserAnswerDTO
 type UserAnswerDTO struct {
-	QuestionID      string   `json:"question_id" example:"1234"`
-	QuestionSubject string   `json:"question_subject,omitempty"`
-	Answers         []string `json:"answers" example:"selection1,selection2"`
+	QuestionID      string    `json:"question_id" example:"1234"`
+	QuestionSubject string    `json:"question_subject,omitempty"`
+	Answers         []string  `json:"answers" example:"selection1,selection2"`
+	Pairs           []PairDTO `json:"pairs,omitempty"`
+}
+
+// PairDTO represents pair of items selected for matching question
+// swagger:model PairDTO
+type PairDTO struct {
+	Left  string `json:"left" example:"int"`
+	Right string `json:"right" example:"0"`
 }
 
 // UserAnswersListDTO represents list of user answers
@@ -17,10 +17,23 @@ var (
 	ErrAssertion = errors.New("assertion error")
 )
 
-type RightAccessor struct{}
+type RightAccessor struct {
+	relations   Relations
+	mentorRight string
+	adminRight  string
+}
+
+func NewRightAccessor(opts ...Option) (*RightAccessor, error) {
+	accessor := &RightAccessor{
+		mentorRight: DefaultMentorRight,
+		adminRight:  DefaultAdminRight,
+	}
+
+	for _, opt := range opts {
+		opt(accessor)
+	}
 
-func NewRightAccessor() (*RightAccessor, error) {
-	return &RightAccessor{}, nil
+	return accessor, nil
 }
 
 func (accessor *RightAccessor) HasPermission(ctx context.Context, rights []string) (bool, error) {
@@ -0,0 +1,112 @@
+package accessor
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	DefaultMentorRight = "mentor"
+	DefaultAdminRight  = "admin"
+)
+
+// Relations tells which students the mentor is linked to.
+type Relations interface {
+	IsMentorOf(ctx context.Context, mentorID string, studentID string) (bool, error)
+}
+
+type Option func(*RightAccessor)
+
+// WithRelations sets source of mentor-student links. Without it mentors have access only to
+// their own resources.
+func WithRelations(relations Relations) Option {
+	return func(accessor *RightAccessor) {
+		accessor.relations = relations
+	}
+}
+
+func WithMentorRight(right string) Option {
+	return func(accessor *RightAccessor) {
+		if right != "" {
+			accessor.mentorRight = right
+		}
+	}
+}
+
+func WithAdminRight(right string) Option {
+	return func(accessor *RightAccessor) {
+		if right != "" {
+			accessor.adminRight = right
+		}
+	}
+}
+
+// CanAccess tells whether the caller may access resource of the owner: the caller is the owner,
+// an admin or a mentor linked to the owner.
+func (accessor *RightAccessor) CanAccess(ctx context.Context, ownerID string) (bool, error) {
+	slog.Info("CanAccess started")
+
+	claims, ok := ctx.Value(UserClaims).(*Claims)
+	if !ok {
+		err := errors.Wrap(ErrAssertion, "assert data from context to claims failure")
+		slog.Error(err.Error())
+		return false, err
+	}
+
+	if ownerID != "" && claims.Subject == ownerID {
+		return true, nil
+	}
+
+	if accessor.hasRight(claims, accessor.adminRight) {
+		return true, nil
+	}
+
+	if accessor.relations == nil || !accessor.hasRight(claims, accessor.mentorRight) {
+		return false, nil
+	}
+
+	linked, err := accessor.relations.IsMentorOf(ctx, claims.Subject, ownerID)
+	if err != nil {
+		err := errors.Wrap(err, "IsMentorOf")
+		slog.Error(err.Error())
+		return false, err
+	}
+
+	return linked, nil
+}
+
+// IsOwner tells whether the caller is the owner of the resource.
+func (accessor *RightAccessor) IsOwner(ctx context.Context, ownerID string) (bool, error) {
+	claims, ok := ctx.Value(UserClaims).(*Claims)
+	if !ok {
+		err := errors.Wrap(ErrAssertion, "assert data from context to claims failure")
+		slog.Error(err.Error())
+		return false, err
+	}
+
+	return ownerID != "" && claims.Subject == ownerID, nil
+}
+
+// IsAdmin tells whether the caller has the admin right.
+func (accessor *RightAccessor) IsAdmin(ctx context.Context) (bool, error) {
+	claims, ok := ctx.Value(UserClaims).(*Claims)
+	if !ok {
+		err := errors.Wrap(ErrAssertion, "assert data from context to claims failure")
+		slog.Error(err.Error())
+		return false, err
+	}
+
+	return accessor.hasRight(claims, accessor.adminRight), nil
+}
+
+func (accessor *RightAccessor) hasRight(claims *Claims, right string) bool {
+	for _, claimRight := range claims.Rights {
+		if claimRight == right {
+			return true
+		}
+	}
+
+	return false
+}
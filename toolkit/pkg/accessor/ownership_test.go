@@ -0,0 +1,270 @@
+package accessor_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/parta4ok/kvs/toolkit/pkg/accessor"
+)
+
+type relationsStub struct {
+	links map[string][]string
+	err   error
+	calls int
+}
+
+func (r *relationsStub) IsMentorOf(_ context.Context, mentorID string,
+	studentID string) (bool, error) {
+	r.calls++
+	if r.err != nil {
+		return false, r.err
+	}
+
+	for _, linked := range r.links[mentorID] {
+		if linked == studentID {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+func withClaims(subject string, rights ...string) context.Context {
+	return context.WithValue(context.Background(), accessor.UserClaims, &accessor.Claims{
+		Subject: subject,
+		Rights:  rights,
+	})
+}
+
+func TestRightAccessor_CanAccess(t *testing.T) {
+	t.Parallel()
+
+	errRelations := errors.New("db error")
+
+	testCases := []struct {
+		name          string
+		ctx           context.Context
+		ownerID       string
+		relations     *relationsStub
+		expected      bool
+		expectedCalls int
+		expectedError error
+	}{
+		{
+			name:      "owner",
+			ctx:       withClaims("1", "start_session"),
+			ownerID:   "1",
+			relations: &relationsStub{},
+			expected:  true,
+		},
+		{
+			name:      "another_student",
+			ctx:       withClaims("2", "start_session"),
+			ownerID:   "1",
+			relations: &relationsStub{links: map[string][]string{"2": {"1"}}},
+			expected:  false,
+		},
+		{
+			name:      "empty_owner_is_not_owned",
+			ctx:       withClaims("", "start_session"),
+			ownerID:   "",
+			relations: &relationsStub{},
+			expected:  false,
+		},
+		{
+			name:      "admin",
+			ctx:       withClaims("9", accessor.DefaultAdminRight),
+			ownerID:   "1",
+			relations: &relationsStub{},
+			expected:  true,
+		},
+		{
+			name:          "linked_mentor",
+			ctx:           withClaims("5", accessor.DefaultMentorRight),
+			ownerID:       "1",
+			relations:     &relationsStub{links: map[string][]string{"5": {"1", "2"}}},
+			expected:      true,
+			expectedCalls: 1,
+		},
+		{
+			name:          "unlinked_mentor",
+			ctx:           withClaims("5", accessor.DefaultMentorRight),
+			ownerID:       "3",
+			relations:     &relationsStub{links: map[string][]string{"5": {"1", "2"}}},
+			expected:      false,
+			expectedCalls: 1,
+		},
+		{
+			name:      "mentor_without_relations",
+			ctx:       withClaims("5", accessor.DefaultMentorRight),
+			ownerID:   "1",
+			relations: nil,
+			expected:  false,
+		},
+		{
+			name:          "missing_claims",
+			ctx:           context.Background(),
+			ownerID:       "1",
+			relations:     &relationsStub{},
+			expectedError: accessor.ErrAssertion,
+		},
+		{
+			name:          "relations_error",
+			ctx:           withClaims("5", accessor.DefaultMentorRight),
+			ownerID:       "1",
+			relations:     &relationsStub{err: errRelations},
+			expectedCalls: 1,
+			expectedError: errRelations,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			opts := make([]accessor.Option, 0, 1)
+			if tc.relations != nil {
+				opts = append(opts, accessor.WithRelations(tc.relations))
+			}
+
+			rightAccessor, err := accessor.NewRightAccessor(opts...)
+			require.NoError(t, err)
+
+			canAccess, err := rightAccessor.CanAccess(tc.ctx, tc.ownerID)
+			if tc.expectedError != nil {
+				require.ErrorIs(t, err, tc.expectedError)
+			} else {
+				require.NoError(t, err)
+			}
+			require.Equal(t, tc.expected, canAccess)
+
+			if tc.relations != nil {
+				require.Equal(t, tc.expectedCalls, tc.relations.calls)
+			}
+		})
+	}
+}
+
+func TestRightAccessor_CanAccess_CustomRights(t *testing.T) {
+	t.Parallel()
+
+	relations := &relationsStub{links: map[string][]string{"5": {"1"}}}
+	rightAccessor, err := accessor.NewRightAccessor(accessor.WithRelations(relations),
+		accessor.WithMentorRight("tutor"), accessor.WithAdminRight("root"))
+	require.NoError(t, err)
+
+	canAccess, err := rightAccessor.CanAccess(withClaims("5", "tutor"), "1")
+	require.NoError(t, err)
+	require.True(t, canAccess)
+
+	// default rights are not recognized once replaced
+	canAccess, err = rightAccessor.CanAccess(withClaims("5", accessor.DefaultMentorRight), "1")
+	require.NoError(t, err)
+	require.False(t, canAccess)
+
+	canAccess, err = rightAccessor.CanAccess(withClaims("9", "root"), "1")
+	require.NoError(t, err)
+	require.True(t, canAccess)
+
+	canAccess, err = rightAccessor.CanAccess(withClaims("9", accessor.DefaultAdminRight), "1")
+	require.NoError(t, err)
+	require.False(t, canAccess)
+}
+
+func TestRightAccessor_IsOwner(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name          string
+		ctx           context.Context
+		ownerID       string
+		expected      bool
+		expectedError error
+	}{
+		{name: "owner", ctx: withClaims("1"), ownerID: "1", expected: true},
+		{name: "another_user", ctx: withClaims("2"), ownerID: "1", expected: false},
+		{name: "admin_is_not_owner", ctx: withClaims("9", accessor.DefaultAdminRight),
+			ownerID: "1", expected: false},
+		{name: "empty_owner", ctx: withClaims(""), ownerID: "", expected: false},
+		{name: "missing_claims", ctx: context.Background(), ownerID: "1",
+			expectedError: accessor.ErrAssertion},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			rightAccessor, err := accessor.NewRightAccessor()
+			require.NoError(t, err)
+
+			isOwner, err := rightAccessor.IsOwner(tc.ctx, tc.ownerID)
+			if tc.expectedError != nil {
+				require.ErrorIs(t, err, tc.expectedError)
+			} else {
+				require.NoError(t, err)
+			}
+			require.Equal(t, tc.expected, isOwner)
+		})
+	}
+}
+
+func TestRightAccessor_IsAdmin(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name          string
+		ctx           context.Context
+		expected      bool
+		expectedError error
+	}{
+		{name: "admin", ctx: withClaims("9", "view_topic_list", accessor.DefaultAdminRight),
+			expected: true},
+		{name: "mentor", ctx: withClaims("5", accessor.DefaultMentorRight), expected: false},
+		{name: "no_rights", ctx: withClaims("1"), expected: false},
+		{name: "missing_claims", ctx: context.Background(), expectedError: accessor.ErrAssertion},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			rightAccessor, err := accessor.NewRightAccessor()
+			require.NoError(t, err)
+
+			isAdmin, err := rightAccessor.IsAdmin(tc.ctx)
+			if tc.expectedError != nil {
+				require.ErrorIs(t, err, tc.expectedError)
+			} else {
+				require.NoError(t, err)
+			}
+			require.Equal(t, tc.expected, isAdmin)
+		})
+	}
+}
+
+func TestRightAccessor_HasPermission(t *testing.T) {
+	t.Parallel()
+
+	rightAccessor, err := accessor.NewRightAccessor()
+	require.NoError(t, err)
+
+	ctx := withClaims("1", "start_session", "complete_session")
+
+	hasPermission, err := rightAccessor.HasPermission(ctx, []string{"start_session"})
+	require.NoError(t, err)
+	require.True(t, hasPermission)
+
+	hasPermission, err = rightAccessor.HasPermission(ctx,
+		[]string{"start_session", "manage_questions"})
+	require.NoError(t, err)
+	require.False(t, hasPermission)
+
+	_, err = rightAccessor.HasPermission(context.Background(), []string{"start_session"})
+	require.ErrorIs(t, err, accessor.ErrAssertion)
+}